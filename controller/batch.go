@@ -1,6 +1,14 @@
 package controller
 
-import "github.com/kandoo/beehive-netctrl/nom"
+import (
+	"encoding/gob"
+	"fmt"
+	"time"
+
+	bh "github.com/kandoo/beehive"
+	"github.com/kandoo/beehive-netctrl/nom"
+	"github.com/kandoo/beehive/Godeps/_workspace/src/github.com/golang/glog"
+)
 
 // Batch represents a collection of flow entries to be added to and removed from
 // the network. The controller ensures that these changes are either all applied
@@ -9,3 +17,524 @@ type Batch struct {
 	Adds []nom.AddFlowEntry // The flows to be added.
 	Dels []nom.DelFlowEntry // The flows to be removed.
 }
+
+// nodeBatch is the part of a batch that is applied on a node. In the messages
+// exchanged between the coordinator and the nodes, ID is the key of the batch
+// returned by batchKey.
+type nodeBatch struct {
+	ID    string
+	Node  nom.UID
+	Batch Batch
+}
+
+// nodeBatchResult is emitted when a node has applied, or has failed to apply,
+// its part of a batch.
+type nodeBatchResult struct {
+	ID     string
+	Node   nom.UID
+	Failed bool
+	Err    nom.DriverError
+}
+
+// nodeBatchDone instructs a node to either commit or roll back its part of a
+// batch.
+type nodeBatchDone struct {
+	ID       string
+	Node     nom.UID
+	Rollback bool
+}
+
+// expireBatches is emitted periodically to fail the batches that are timed
+// out.
+type expireBatches struct{}
+
+// batchState is the state of a batch in its coordinator.
+type batchState struct {
+	Batch   nom.ApplyBatch
+	Nodes   []nom.UID
+	Results []nodeBatchResult
+	Started time.Time
+}
+
+// batchKey returns the key of b in the controller. Batch IDs are only unique
+// for their subscriber, and the key is prefixed by the subscriber.
+func batchKey(b nom.ApplyBatch) string {
+	s := b.Subscriber
+	return fmt.Sprintf("%v$$%v$$%v$$%v", s.App, s.Dict, s.Key, b.ID)
+}
+
+// stagedBatch is the state of the part of a batch applied on a node.
+type stagedBatch struct {
+	ID       string
+	Added    []nom.FlowEntry    // Flows added by this batch.
+	Dels     []nom.DelFlowEntry // Deletions of this batch.
+	Deleted  []flow             // Flows deleted or replaced by this batch.
+	Failed   bool
+	Err      nom.DriverError
+	Reported bool // Whether the result is reported to the coordinator.
+}
+
+type nodeStagedBatches struct {
+	Node    nom.UID
+	Batches []stagedBatch
+}
+
+func (nb nodeStagedBatches) batchIndex(id string) int {
+	for i := range nb.Batches {
+		if nb.Batches[i].ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// isBatchRequest returns whether req is sent to the node for sb.
+func (sb stagedBatch) isBatchRequest(req interface{}) bool {
+	switch r := req.(type) {
+	case nom.AddFlowEntry:
+		for _, f := range sb.Added {
+			if f.Equals(r.Flow) {
+				return true
+			}
+		}
+	case nom.DelFlowEntry:
+		for _, d := range sb.Dels {
+			if d.Exact == r.Exact && d.Priority == r.Priority &&
+				d.Cookie == r.Cookie && d.Match.Equals(r.Match) {
+
+				return true
+			}
+		}
+	case nom.Barrier:
+		return r.ID == sb.ID
+	}
+	return false
+}
+
+// batchHandler coordinates a batch among the nodes.
+type batchHandler struct{}
+
+func (h batchHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
+	switch data := msg.Data().(type) {
+	case nom.ApplyBatch:
+		return h.apply(data, ctx)
+	case nodeBatchResult:
+		return h.result(data, ctx)
+	}
+	return fmt.Errorf("batchHandler: unsupported message %v", msg.Type())
+}
+
+func (h batchHandler) apply(b nom.ApplyBatch, ctx bh.RcvContext) error {
+	fail := func(code nom.DriverErrorCode, err string) error {
+		sendToSubscriber(nom.BatchFailed{
+			ID: b.ID,
+			Err: nom.DriverError{
+				Code: code,
+				Err:  err,
+			},
+		}, b.Subscriber, ctx)
+		return nil
+	}
+
+	dict := ctx.Dict(batchDict)
+	k := batchKey(b)
+	if _, err := dict.Get(k); err == nil {
+		return fail(nom.DriverErrBadRequest, "batch is already being applied")
+	}
+
+	for _, d := range b.Dels {
+		if d.Node == "" {
+			return fail(nom.DriverErrBadRequest,
+				"deletions in a batch must have a node")
+		}
+	}
+	for i := range b.Adds {
+		m := b.Adds[i].Flow.Match
+		if err := m.Validate(); err != nil {
+			return fail(nom.DriverErrBadMatch, err.Error())
+		}
+		b.Adds[i].Flow.Match = m.Normalize()
+	}
+
+	nodes := b.Nodes()
+	if len(nodes) == 0 {
+		sendToSubscriber(nom.BatchApplied{ID: b.ID}, b.Subscriber, ctx)
+		return nil
+	}
+
+	parts := make(map[nom.UID]*Batch, len(nodes))
+	for _, n := range nodes {
+		parts[n] = &Batch{}
+	}
+	for _, a := range b.Adds {
+		p := parts[a.Flow.Node]
+		p.Adds = append(p.Adds, a)
+	}
+	for _, d := range b.Dels {
+		p := parts[d.Node]
+		p.Dels = append(p.Dels, d)
+	}
+	for _, n := range nodes {
+		ctx.Emit(nodeBatch{
+			ID:    k,
+			Node:  n,
+			Batch: *parts[n],
+		})
+	}
+
+	return dict.Put(k, batchState{
+		Batch:   b,
+		Nodes:   nodes,
+		Started: time.Now(),
+	})
+}
+
+func (h batchHandler) result(res nodeBatchResult, ctx bh.RcvContext) error {
+	dict := ctx.Dict(batchDict)
+	v, err := dict.Get(res.ID)
+	if err != nil {
+		// The batch is timed out.
+		glog.V(2).Infof("controller: result of unknown batch %v", res.ID)
+		return nil
+	}
+	bs := v.(batchState)
+	bs.Results = append(bs.Results, res)
+	if len(bs.Results) < len(bs.Nodes) {
+		return dict.Put(res.ID, bs)
+	}
+
+	var failed *nodeBatchResult
+	for i := range bs.Results {
+		if bs.Results[i].Failed {
+			failed = &bs.Results[i]
+			break
+		}
+	}
+	if failed != nil {
+		finishBatch(res.ID, bs, &failed.Err, ctx)
+	} else {
+		finishBatch(res.ID, bs, nil, ctx)
+	}
+	return dict.Del(res.ID)
+}
+
+func (h batchHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
+	var k string
+	switch data := msg.Data().(type) {
+	case nom.ApplyBatch:
+		k = batchKey(data)
+	case nodeBatchResult:
+		k = data.ID
+	}
+	return bh.MappedCells{{batchDict, k}}
+}
+
+// finishBatch instructs the nodes of the batch to commit or, if derr is not
+// nil, to roll back their changes, and notifies the subscriber of the batch.
+func finishBatch(k string, bs batchState, derr *nom.DriverError,
+	ctx bh.RcvContext) {
+
+	for _, n := range bs.Nodes {
+		ctx.Emit(nodeBatchDone{
+			ID:       k,
+			Node:     n,
+			Rollback: derr != nil,
+		})
+	}
+
+	if derr != nil {
+		sendToSubscriber(nom.BatchFailed{
+			ID:  bs.Batch.ID,
+			Err: *derr,
+		}, bs.Batch.Subscriber, ctx)
+		return
+	}
+	sendToSubscriber(nom.BatchApplied{ID: bs.Batch.ID}, bs.Batch.Subscriber,
+		ctx)
+}
+
+// batchTimeoutHandler fails and rolls back the batches that are not applied on
+// all their nodes in time.
+type batchTimeoutHandler struct {
+	timeout time.Duration
+}
+
+func (h batchTimeoutHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
+	dict := ctx.Dict(batchDict)
+	now := time.Now()
+	var expired []string
+	dict.ForEach(func(k string, v interface{}) bool {
+		if v.(batchState).Started.Add(h.timeout).Before(now) {
+			expired = append(expired, k)
+		}
+		return true
+	})
+	for _, k := range expired {
+		v, err := dict.Get(k)
+		if err != nil {
+			continue
+		}
+		bs := v.(batchState)
+		glog.Warningf("controller: batch %v timed out", bs.Batch.ID)
+		finishBatch(k, bs, &nom.DriverError{
+			Code: nom.DriverErrTimeout,
+			Err:  fmt.Sprintf("batch is not applied in %v", h.timeout),
+		}, ctx)
+		if err := dict.Del(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h batchTimeoutHandler) Map(msg bh.Msg,
+	ctx bh.MapContext) bh.MappedCells {
+
+	return bh.MappedCells{}
+}
+
+// nodeBatchHandler applies the part of a batch on a node, and commits or rolls
+// it back as instructed by the coordinator.
+type nodeBatchHandler struct {
+	policy ConflictPolicy
+}
+
+func (h nodeBatchHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
+	switch data := msg.Data().(type) {
+	case nodeBatch:
+		return h.stage(data, ctx)
+	case nom.BarrierReply:
+		return h.barrier(data, ctx)
+	case nodeBatchDone:
+		return h.done(data, ctx)
+	}
+	return fmt.Errorf("nodeBatchHandler: unsupported message %v", msg.Type())
+}
+
+func (h nodeBatchHandler) stage(b nodeBatch, ctx bh.RcvContext) error {
+	if _, err := ctx.Dict(driversDict).Get(string(b.Node)); err != nil {
+		ctx.Emit(nodeBatchResult{
+			ID:     b.ID,
+			Node:   b.Node,
+			Failed: true,
+			Err: nom.DriverError{
+				Node: b.Node,
+				Code: nom.DriverErrDisconnected,
+				Err:  "node not found",
+			},
+		})
+		return nil
+	}
+
+	var nf nodeFlows
+	if v, err := ctx.Dict(flowsDict).Get(string(b.Node)); err == nil {
+		nf = v.(nodeFlows)
+	}
+
+	var nb nodeStagedBatches
+	if v, err := ctx.Dict(stagedDict).Get(string(b.Node)); err == nil {
+		nb = v.(nodeStagedBatches)
+	}
+	if nb.batchIndex(b.ID) >= 0 {
+		return nil
+	}
+
+	sb := stagedBatch{
+		ID: b.ID,
+	}
+	// Whatever is applied before an error is recorded in sb, so that the
+	// coordinator can roll it back.
+	fail := func(code nom.DriverErrorCode, err error) {
+		sb.Failed = true
+		sb.Err = nom.DriverError{
+			Node: b.Node,
+			Code: code,
+			Err:  err.Error(),
+		}
+	}
+	for _, del := range b.Batch.Dels {
+		del, ok := nf.cookieDel(del)
+		if !ok {
+			continue
+		}
+		sb.Dels = append(sb.Dels, del)
+		if err := sendToMaster(del, b.Node, ctx); err != nil {
+			fail(nom.DriverErrDisconnected, err)
+			break
+		}
+		deleted := nf.delFlows(del)
+		for _, f := range deleted {
+			notifyFlowDeleted(f, nom.FlowRemovedDeleted, ctx)
+		}
+		sb.Deleted = append(sb.Deleted, deleted...)
+	}
+	for _, add := range b.Batch.Adds {
+		if sb.Failed {
+			break
+		}
+		if err := h.policy.check(add.Flow, nf); err != nil {
+			fail(nom.DriverErrOverlap, err)
+			break
+		}
+		flow, isNew, replaced, err := addFlow(add, &nf, ctx)
+		if isNew {
+			sb.Added = append(sb.Added, flow)
+		}
+		sb.Deleted = append(sb.Deleted, replaced...)
+		if err != nil {
+			fail(nom.DriverErrDisconnected, err)
+		}
+	}
+	if !sb.Failed {
+		err := sendToMaster(nom.Barrier{Node: b.Node, ID: b.ID}, b.Node, ctx)
+		if err != nil {
+			fail(nom.DriverErrDisconnected, err)
+		}
+	}
+	if sb.Failed {
+		reportStagedBatch(b.Node, &sb, ctx)
+	}
+
+	nb.Node = b.Node
+	nb.Batches = append(nb.Batches, sb)
+	if err := ctx.Dict(stagedDict).Put(string(b.Node), nb); err != nil {
+		return err
+	}
+	return ctx.Dict(flowsDict).Put(string(b.Node), nf)
+}
+
+func (h nodeBatchHandler) barrier(r nom.BarrierReply, ctx bh.RcvContext) error {
+	var nb nodeStagedBatches
+	if v, err := ctx.Dict(stagedDict).Get(string(r.Node)); err == nil {
+		nb = v.(nodeStagedBatches)
+	}
+	i := nb.batchIndex(r.ID)
+	if i < 0 || nb.Batches[i].Reported {
+		return nil
+	}
+	reportStagedBatch(r.Node, &nb.Batches[i], ctx)
+	return ctx.Dict(stagedDict).Put(string(r.Node), nb)
+}
+
+func (h nodeBatchHandler) done(d nodeBatchDone, ctx bh.RcvContext) error {
+	var nb nodeStagedBatches
+	if v, err := ctx.Dict(stagedDict).Get(string(d.Node)); err == nil {
+		nb = v.(nodeStagedBatches)
+	}
+	i := nb.batchIndex(d.ID)
+	if i < 0 {
+		return nil
+	}
+	sb := nb.Batches[i]
+	nb.Batches = append(nb.Batches[:i], nb.Batches[i+1:]...)
+	if err := ctx.Dict(stagedDict).Put(string(d.Node), nb); err != nil {
+		return err
+	}
+	if !d.Rollback {
+		return nil
+	}
+	return rollbackStagedBatch(d.Node, sb, ctx)
+}
+
+func (h nodeBatchHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
+	switch data := msg.Data().(type) {
+	case nodeBatch:
+		return nodeDriversMap(data.Node)
+	case nom.BarrierReply:
+		return nodeDriversMap(data.Node)
+	case nodeBatchDone:
+		return nodeDriversMap(data.Node)
+	}
+	return nil
+}
+
+func reportStagedBatch(node nom.UID, sb *stagedBatch, ctx bh.RcvContext) {
+	sb.Reported = true
+	ctx.Emit(nodeBatchResult{
+		ID:     sb.ID,
+		Node:   node,
+		Failed: sb.Failed,
+		Err:    sb.Err,
+	})
+}
+
+// rollbackStagedBatch removes the flows added by sb and reinstalls the flows
+// deleted by sb.
+func rollbackStagedBatch(node nom.UID, sb stagedBatch,
+	ctx bh.RcvContext) error {
+
+	var nf nodeFlows
+	if v, err := ctx.Dict(flowsDict).Get(string(node)); err == nil {
+		nf = v.(nodeFlows)
+	}
+	for _, added := range sb.Added {
+		c, ok := nf.flowCookie(added)
+		if !ok {
+			continue
+		}
+		f := nf.Flows[c]
+		nf.delFlow(c)
+		notifyFlowDeleted(f, nom.FlowRemovedDeleted, ctx)
+		sendToMaster(nom.DelFlowEntry{
+			Node:     node,
+			Match:    added.Match,
+			Priority: added.Priority,
+			Exact:    true,
+		}, node, ctx)
+	}
+	for _, f := range sb.Deleted {
+		if f.isForeign() {
+			// The actions of foreign flows are not known.
+			continue
+		}
+		if _, ok := nf.flowCookie(f.FlowEntry); ok {
+			continue
+		}
+		f.Installed = false
+		nf.putFlow(f)
+		sendToMaster(nom.AddFlowEntry{Flow: f.FlowEntry}, node, ctx)
+	}
+	return ctx.Dict(flowsDict).Put(string(node), nf)
+}
+
+// failStagedBatch marks the staged batch that has sent the request of derr as
+// failed.
+func failStagedBatch(derr nom.DriverError, ctx bh.RcvContext) error {
+	var nb nodeStagedBatches
+	if v, err := ctx.Dict(stagedDict).Get(string(derr.Node)); err == nil {
+		nb = v.(nodeStagedBatches)
+	}
+	for i := range nb.Batches {
+		sb := &nb.Batches[i]
+		if sb.Reported || !sb.isBatchRequest(derr.Request) {
+			continue
+		}
+		if !sb.Failed {
+			sb.Failed = true
+			sb.Err = derr
+		}
+		if _, ok := derr.Request.(nom.Barrier); ok {
+			reportStagedBatch(derr.Node, sb, ctx)
+		}
+		return ctx.Dict(stagedDict).Put(string(derr.Node), nb)
+	}
+	return nil
+}
+
+func sendToSubscriber(msg interface{}, sub bh.AppCellKey, ctx bh.RcvContext) {
+	if sub.IsNil() {
+		return
+	}
+	ctx.SendToCell(msg, sub.App, sub.Cell())
+}
+
+func init() {
+	gob.Register(Batch{})
+	gob.Register(batchState{})
+	gob.Register(expireBatches{})
+	gob.Register(nodeBatch{})
+	gob.Register(nodeBatchDone{})
+	gob.Register(nodeBatchResult{})
+	gob.Register(nodeStagedBatches{})
+	gob.Register(stagedBatch{})
+}
@@ -0,0 +1,311 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	bh "github.com/kandoo/beehive"
+	"github.com/kandoo/beehive-netctrl/nom"
+)
+
+// applyBatchForTest applies b through the coordinator and stages its parts on
+// the nodes using h. The messages of the coordinator are removed from ctx.
+func applyBatchForTest(b nom.ApplyBatch, h nodeBatchHandler,
+	ctx *bh.MockRcvContext, t *testing.T) {
+
+	if err := (batchHandler{}).Rcv(&bh.MockMsg{MsgData: b}, ctx); err != nil {
+		t.Fatalf("cannot apply batch: %v", err)
+	}
+	nbs := msgsForTest(ctx, nodeBatch{})
+	if len(nbs) != len(b.Nodes()) {
+		t.Fatalf("invalid number of node batches: actual=%v want=%v", len(nbs),
+			len(b.Nodes()))
+	}
+	ctx.CtxMsgs = nil
+	for _, nb := range nbs {
+		if err := h.Rcv(nb, ctx); err != nil {
+			t.Fatalf("cannot stage batch: %v", err)
+		}
+	}
+}
+
+// rcvForTest passes the messages in msgs to h.
+func rcvForTest(h bh.Handler, msgs []bh.Msg, ctx *bh.MockRcvContext,
+	t *testing.T) {
+
+	for _, msg := range msgs {
+		if err := h.Rcv(msg, ctx); err != nil {
+			t.Fatalf("cannot handle %v: %v", msg.Data(), err)
+		}
+	}
+}
+
+func barriersForTest(ctx *bh.MockRcvContext) []bh.Msg {
+	var replies []bh.Msg
+	for _, msg := range msgsForTest(ctx, nom.Barrier{}) {
+		b := msg.Data().(nom.Barrier)
+		replies = append(replies, &bh.MockMsg{
+			MsgData: nom.BarrierReply{Node: b.Node, ID: b.ID},
+		})
+	}
+	return replies
+}
+
+func TestBatchApplied(t *testing.T) {
+	ctx := connectNodesForTest("n1", "n2")
+	f := addFlowForTest(ethDstFlowForTest("n1", "n1$$2"), ctx, t)
+	b := nom.ApplyBatch{
+		ID: "b",
+		Adds: []nom.AddFlowEntry{
+			{Flow: flowForTest("n1", "n1$$2"), Subscriber: subForTest},
+			{Flow: flowForTest("n2", "n2$$2"), Subscriber: subForTest},
+		},
+		Dels: []nom.DelFlowEntry{
+			{Node: "n1", Cookie: f.Cookie},
+		},
+		Subscriber: subForTest,
+	}
+	applyBatchForTest(b, nodeBatchHandler{}, ctx, t)
+
+	if adds := msgsForTest(ctx, nom.AddFlowEntry{}); len(adds) != 2 {
+		t.Errorf("invalid number of flows sent: actual=%v want=2", len(adds))
+	}
+	dels := msgsForTest(ctx, nom.DelFlowEntry{})
+	if len(dels) != 1 || !dels[0].Data().(nom.DelFlowEntry).Match.Equals(
+		f.Match) {
+
+		t.Errorf("deletion by cookie is not sent to the node: %v", dels)
+	}
+	replies := barriersForTest(ctx)
+	if len(replies) != 2 {
+		t.Fatalf("invalid number of barriers: actual=%v want=2", len(replies))
+	}
+
+	ctx.CtxMsgs = nil
+	rcvForTest(nodeBatchHandler{}, replies, ctx, t)
+	results := msgsForTest(ctx, nodeBatchResult{})
+	ctx.CtxMsgs = nil
+	rcvForTest(batchHandler{}, results, ctx, t)
+	if applied := msgsForTest(ctx, nom.BatchApplied{}); len(applied) != 1 {
+		t.Errorf("batch is not applied")
+	}
+	dones := msgsForTest(ctx, nodeBatchDone{})
+	for _, d := range dones {
+		if d.Data().(nodeBatchDone).Rollback {
+			t.Errorf("applied batch is rolled back")
+		}
+	}
+
+	ctx.CtxMsgs = nil
+	rcvForTest(nodeBatchHandler{}, dones, ctx, t)
+	if len(ctx.CtxMsgs) != 0 {
+		t.Errorf("committing the batch sends messages: %v", ctx.CtxMsgs)
+	}
+	if _, err := ctx.Dict(batchDict).Get(batchKey(b)); err == nil {
+		t.Errorf("applied batch is not removed")
+	}
+	if nf := nodeFlowsForTest("n1", ctx); len(nf.Flows) != 1 {
+		t.Errorf("invalid number of flows: actual=%v want=1", len(nf.Flows))
+	}
+}
+
+func TestBatchRollback(t *testing.T) {
+	ctx := connectNodesForTest("n1", "n2")
+	old := addFlowForTest(flowForTest("n1", "n1$$2"), ctx, t)
+	b := nom.ApplyBatch{
+		ID: "b",
+		Adds: []nom.AddFlowEntry{
+			{Flow: flowForTest("n1", "n1$$3"), Subscriber: subForTest},
+			{Flow: flowForTest("n2", "n2$$2"), Subscriber: subForTest},
+		},
+		Subscriber: subForTest,
+	}
+	applyBatchForTest(b, nodeBatchHandler{}, ctx, t)
+
+	var failedAdd nom.AddFlowEntry
+	for _, msg := range msgsForTest(ctx, nom.AddFlowEntry{}) {
+		if msg.To() == 2 {
+			failedAdd = msg.Data().(nom.AddFlowEntry)
+		}
+	}
+	replies := barriersForTest(ctx)
+	ctx.CtxMsgs = nil
+	derr := &bh.MockMsg{
+		MsgData: nom.DriverError{
+			Node:    "n2",
+			Code:    nom.DriverErrBadRequest,
+			Request: failedAdd,
+		},
+		MsgFrom: 2,
+	}
+	if err := (driverErrorHandler{}).Rcv(derr, ctx); err != nil {
+		t.Fatalf("cannot handle driver error: %v", err)
+	}
+
+	ctx.CtxMsgs = nil
+	rcvForTest(nodeBatchHandler{}, replies, ctx, t)
+	results := msgsForTest(ctx, nodeBatchResult{})
+	if len(results) != 2 {
+		t.Fatalf("invalid number of results: actual=%v want=2", len(results))
+	}
+	for _, r := range results {
+		res := r.Data().(nodeBatchResult)
+		if res.Failed != (res.Node == "n2") {
+			t.Errorf("invalid result for %v: failed=%v", res.Node, res.Failed)
+		}
+	}
+
+	ctx.CtxMsgs = nil
+	rcvForTest(batchHandler{}, results, ctx, t)
+	failed := msgsForTest(ctx, nom.BatchFailed{})
+	if len(failed) != 1 {
+		t.Fatalf("batch is not failed")
+	}
+	if err := failed[0].Data().(nom.BatchFailed).Err; err.Code !=
+		nom.DriverErrBadRequest || err.Node != "n2" {
+
+		t.Errorf("invalid batch error: %v", err)
+	}
+	dones := msgsForTest(ctx, nodeBatchDone{})
+	if len(dones) != 2 {
+		t.Fatalf("invalid number of dones: actual=%v want=2", len(dones))
+	}
+	for _, d := range dones {
+		if !d.Data().(nodeBatchDone).Rollback {
+			t.Errorf("failed batch is not rolled back")
+		}
+	}
+
+	ctx.CtxMsgs = nil
+	rcvForTest(nodeBatchHandler{}, dones, ctx, t)
+	dels := msgsForTest(ctx, nom.DelFlowEntry{})
+	if len(dels) != 1 || dels[0].To() != 1 {
+		t.Fatalf("flow added by the batch is not removed from n1")
+	}
+	adds := msgsForTest(ctx, nom.AddFlowEntry{})
+	if len(adds) != 1 || adds[0].To() != 1 ||
+		!adds[0].Data().(nom.AddFlowEntry).Flow.Equals(old) {
+
+		t.Fatalf("flow replaced by the batch is not reinstalled on n1")
+	}
+
+	nf := nodeFlowsForTest("n1", ctx)
+	if len(nf.Flows) != 1 {
+		t.Fatalf("invalid number of flows: actual=%v want=1", len(nf.Flows))
+	}
+	if f, ok := nf.Flows[old.Cookie]; !ok || f.Installed {
+		t.Errorf("replaced flow is not restored")
+	}
+	if nf := nodeFlowsForTest("n2", ctx); len(nf.Flows) != 0 {
+		t.Errorf("failed flow is not removed from n2")
+	}
+}
+
+func TestBatchNodeDisconnected(t *testing.T) {
+	ctx := connectNodesForTest("n1")
+	b := nom.ApplyBatch{
+		ID: "b",
+		Adds: []nom.AddFlowEntry{
+			{Flow: flowForTest("n1", "n1$$2"), Subscriber: subForTest},
+			{Flow: flowForTest("n2", "n2$$2"), Subscriber: subForTest},
+		},
+		Subscriber: subForTest,
+	}
+	applyBatchForTest(b, nodeBatchHandler{}, ctx, t)
+	results := msgsForTest(ctx, nodeBatchResult{})
+	if len(results) != 1 {
+		t.Fatalf("invalid number of results: actual=%v want=1", len(results))
+	}
+	res := results[0].Data().(nodeBatchResult)
+	if !res.Failed || res.Node != "n2" ||
+		res.Err.Code != nom.DriverErrDisconnected {
+
+		t.Errorf("invalid result for a disconnected node: %v", res)
+	}
+}
+
+func TestBatchConflict(t *testing.T) {
+	for _, p := range []ConflictPolicy{ConflictWarn, ConflictReject} {
+		ctx := connectNodesForTest("n1")
+		addFlowForTest(flowForTest("n1", "n1$$2"), ctx, t)
+		b := nom.ApplyBatch{
+			ID: "b",
+			Adds: []nom.AddFlowEntry{
+				{
+					Flow:       ethDstFlowForTest("n1", "n1$$3"),
+					Subscriber: subForTest,
+				},
+			},
+			Subscriber: subForTest,
+		}
+		applyBatchForTest(b, nodeBatchHandler{policy: p}, ctx, t)
+
+		adds := msgsForTest(ctx, nom.AddFlowEntry{})
+		results := msgsForTest(ctx, nodeBatchResult{})
+		if p == ConflictWarn {
+			if len(adds) != 1 || len(results) != 0 {
+				t.Errorf("conflicting flow is not applied with ConflictWarn")
+			}
+			continue
+		}
+
+		if len(adds) != 0 {
+			t.Errorf("conflicting flow is applied with ConflictReject")
+		}
+		if len(results) != 1 {
+			t.Fatalf("invalid number of results: actual=%v want=1",
+				len(results))
+		}
+		res := results[0].Data().(nodeBatchResult)
+		if !res.Failed || res.Err.Code != nom.DriverErrOverlap {
+			t.Errorf("invalid result for a conflicting batch: %v", res)
+		}
+	}
+}
+
+func TestBatchTimeout(t *testing.T) {
+	ctx := connectNodesForTest("n1")
+	b := nom.ApplyBatch{
+		ID: "b",
+		Adds: []nom.AddFlowEntry{
+			{Flow: flowForTest("n1", "n1$$2"), Subscriber: subForTest},
+		},
+		Subscriber: subForTest,
+	}
+	applyBatchForTest(b, nodeBatchHandler{}, ctx, t)
+
+	h := batchTimeoutHandler{timeout: time.Minute}
+	expire := &bh.MockMsg{MsgData: expireBatches{}}
+	ctx.CtxMsgs = nil
+	if err := h.Rcv(expire, ctx); err != nil {
+		t.Fatalf("cannot expire batches: %v", err)
+	}
+	if len(ctx.CtxMsgs) != 0 {
+		t.Errorf("batch is expired before its timeout")
+	}
+
+	k := batchKey(b)
+	v, err := ctx.Dict(batchDict).Get(k)
+	if err != nil {
+		t.Fatalf("cannot find batch %v: %v", k, err)
+	}
+	bs := v.(batchState)
+	bs.Started = bs.Started.Add(-2 * time.Minute)
+	ctx.Dict(batchDict).Put(k, bs)
+	if err := h.Rcv(expire, ctx); err != nil {
+		t.Fatalf("cannot expire batches: %v", err)
+	}
+	failed := msgsForTest(ctx, nom.BatchFailed{})
+	if len(failed) != 1 ||
+		failed[0].Data().(nom.BatchFailed).Err.Code != nom.DriverErrTimeout {
+
+		t.Errorf("timed out batch is not failed")
+	}
+	dones := msgsForTest(ctx, nodeBatchDone{})
+	if len(dones) != 1 || !dones[0].Data().(nodeBatchDone).Rollback {
+		t.Errorf("timed out batch is not rolled back")
+	}
+	if _, err := ctx.Dict(batchDict).Get(k); err == nil {
+		t.Errorf("timed out batch is not removed")
+	}
+}
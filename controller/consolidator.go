@@ -1,6 +1,8 @@
 package controller
 
 import (
+	"time"
+
 	"github.com/kandoo/beehive/Godeps/_workspace/src/github.com/golang/glog"
 
 	bh "github.com/kandoo/beehive"
@@ -23,24 +25,27 @@ func (c Consolidator) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
 	if v, err := ctx.Dict(triggersDict).Get(string(res.Node)); err == nil {
 		nt = v.(nodeTriggers)
 	}
-	found := false
-	matchedFlows := make(map[int]struct{})
-	for _, stat := range res.Stats {
-		for i := range nf.Flows {
-			if nf.Flows[i].FlowEntry.Match.Equals(stat.Match) {
-				found = true
-				matchedFlows[i] = struct{}{}
-				nf.Flows[i].updateStats(stat)
-			}
-		}
-		if !found {
-			nf.Flows = append(nf.Flows, flow{
+	stats := res.Stats
+	if isReconciling(res, ctx) {
+		stats = reconcileFlows(res, &nf, ctx)
+	}
+	for _, stat := range stats {
+		if c, ok := nf.statsCookie(stat); ok {
+			f := nf.Flows[c]
+			f.updateStats(stat)
+			nf.Flows[c] = f
+		} else {
+			nf.putForeign(flow{
 				FlowEntry: nom.FlowEntry{
-					Match: stat.Match,
+					Node:     res.Node,
+					Cookie:   stat.Cookie,
+					Match:    stat.Match,
+					Priority: stat.Priority,
 				},
-				Duration: stat.Duration,
-				Packets:  stat.Packets,
-				Bytes:    stat.Bytes,
+				Installed: true,
+				Duration:  stat.Duration,
+				Packets:   stat.Packets,
+				Bytes:     stat.Bytes,
 			})
 			// TODO(soheil): emit flow entry here.
 		}
@@ -56,26 +61,6 @@ func (c Consolidator) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
 		}
 	}
 
-	count := 0
-	for i, f := range nf.Flows {
-		if _, ok := matchedFlows[i]; ok {
-			continue
-		}
-
-		i -= count
-		nf.Flows = append(nf.Flows[:i], nf.Flows[i+1:]...)
-		count++
-		del := nom.FlowEntryDeleted{
-			Flow: f.FlowEntry,
-		}
-		ctx.Emit(del)
-		for _, sub := range f.FlowSubscribers {
-			if !sub.IsNil() {
-				ctx.SendToCell(del, sub.App, sub.Cell())
-			}
-		}
-	}
-
 	return ctx.Dict(flowsDict).Put(string(res.Node), nf)
 }
 
@@ -83,6 +68,29 @@ func (c Consolidator) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
 	return nodeDriversMap(msg.Data().(nom.FlowStatsQueryResult).Node)
 }
 
+// PortStatsConsolidator keeps a rolling history of port statistics for each
+// port in the ports dictionary.
+type PortStatsConsolidator struct{}
+
+func (c PortStatsConsolidator) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
+	res := msg.Data().(nom.PortStatsQueryResult)
+	np := nodePortStats{Node: res.Node}
+	if v, err := ctx.Dict(portsDict).Get(string(res.Node)); err == nil {
+		np = v.(nodePortStats)
+	}
+	now := time.Now()
+	for _, stat := range res.Stats {
+		np.addStats(now, stat)
+	}
+	return ctx.Dict(portsDict).Put(string(res.Node), np)
+}
+
+func (c PortStatsConsolidator) Map(msg bh.Msg,
+	ctx bh.MapContext) bh.MappedCells {
+
+	return nodeDriversMap(msg.Data().(nom.PortStatsQueryResult).Node)
+}
+
 type poll struct{}
 
 type Poller struct{}
@@ -97,6 +105,7 @@ func (p Poller) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
 			Node: node,
 		}
 		sendToMaster(query, node, ctx)
+		sendToMaster(nom.PortStatsQuery{Node: node}, node, ctx)
 
 		nd := v.(nodeDrivers)
 		updated := false
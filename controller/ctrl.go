@@ -1,32 +1,111 @@
 package controller
 
 import (
+	"flag"
 	"time"
 
 	bh "github.com/kandoo/beehive"
 	"github.com/kandoo/beehive-netctrl/nom"
 )
 
-func RegisterNOMController(h bh.Hive) {
+var rejectConflicts = flag.Bool("ctrl.rejectconflicts", false,
+	"whether to reject flows conflicting with the installed flows of a node")
+
+// ConflictPolicy specifies what the controller does with a flow entry that has
+// the same priority as an existing flow entry of the node and an overlapping
+// match, but different actions.
+type ConflictPolicy int
+
+// Valid values for ConflictPolicy.
+const (
+	ConflictWarn   ConflictPolicy = iota // Install the flow and log a warning.
+	ConflictReject                       // Reject the flow.
+)
+
+// DefaultBatchTimeout is the default duration after which a batch that is not
+// applied on all its nodes is failed.
+const DefaultBatchTimeout = 10 * time.Second
+
+type config struct {
+	conflictPolicy ConflictPolicy
+	batchTimeout   time.Duration
+}
+
+// Option represents a NOM controller option.
+type Option func(c *config)
+
+// OnConflict returns a controller option that sets the policy for flow entries
+// that conflict with the existing flow entries of a node.
+func OnConflict(p ConflictPolicy) Option {
+	return func(c *config) {
+		c.conflictPolicy = p
+	}
+}
+
+// BatchTimeout returns a controller option that sets the duration after which
+// a batch that is not applied on all its nodes is failed and rolled back.
+func BatchTimeout(d time.Duration) Option {
+	return func(c *config) {
+		c.batchTimeout = d
+	}
+}
+
+// RegisterNOMController registers the NOM controller on the given hive using
+// the default configuration that can be set through command line arguments.
+func RegisterNOMController(h bh.Hive, options ...Option) {
+	c := config{
+		conflictPolicy: ConflictWarn,
+		batchTimeout:   DefaultBatchTimeout,
+	}
+	if *rejectConflicts {
+		c.conflictPolicy = ConflictReject
+	}
+	for _, opt := range options {
+		opt(&c)
+	}
+	if c.batchTimeout <= 0 {
+		c.batchTimeout = DefaultBatchTimeout
+	}
+
 	app := h.NewApp("NOMController", bh.Persistent(3))
 
 	app.Handle(nom.NodeConnected{}, nodeConnectedHandler{})
 	app.Handle(nom.NodeDisconnected{}, nodeDisconnectedHandler{})
 	app.Handle(nom.PortStatusChanged{}, portStatusHandler{})
+	app.Handle(nom.ModifyPort{}, modifyPortHandler{})
+	app.Handle(nom.DriverError{}, driverErrorHandler{})
 
-	app.Handle(nom.AddFlowEntry{}, addFlowHandler{})
+	app.Handle(nom.AddFlowEntry{}, addFlowHandler{policy: c.conflictPolicy})
 	app.Handle(nom.DelFlowEntry{}, delFlowHandler{})
+	app.Handle(nom.FlowEntryInstalled{}, flowInstalledHandler{})
+	app.Handle(nom.FlowEntryRemoved{}, flowRemovedHandler{})
+
+	app.Handle(nom.FlowOwnership{}, ownershipHandler{})
+	app.Handle(reconcileNode{}, ownershipHandler{})
+	app.Handle(nodeOwners{}, nodeOwnersHandler{})
+
+	app.Handle(nom.ApplyBatch{}, batchHandler{})
+	app.Handle(nodeBatchResult{}, batchHandler{})
+	app.Handle(nodeBatch{}, nodeBatchHandler{policy: c.conflictPolicy})
+	app.Handle(nodeBatchDone{}, nodeBatchHandler{policy: c.conflictPolicy})
+	app.Handle(nom.BarrierReply{}, nodeBatchHandler{policy: c.conflictPolicy})
+	app.Handle(expireBatches{}, batchTimeoutHandler{timeout: c.batchTimeout})
 
 	app.Handle(nom.FlowStatsQuery{}, queryHandler{})
+	app.Handle(nom.PortStatsQuery{}, queryHandler{})
 
 	app.Handle(nom.PacketOut{}, pktOutHandler{})
 
 	app.Handle(nom.AddTrigger{}, addTriggerHandler{})
 
 	app.Handle(nom.FlowStatsQueryResult{}, Consolidator{})
+	app.Handle(nom.PortStatsQueryResult{}, PortStatsConsolidator{})
 	app.Handle(nom.Pong{}, HealthChecker{})
 	app.Handle(poll{}, Poller{})
 	app.Detached(bh.NewTimer(1*time.Second, func() {
 		h.Emit(poll{})
 	}))
+	app.Detached(bh.NewTimer(c.batchTimeout/2, func() {
+		h.Emit(expireBatches{})
+	}))
 }
@@ -16,6 +16,17 @@ const (
 	genDict      = "GD"
 	triggersDict = "TD"
 	flowsDict    = "FD"
+	portsDict    = "PD"
+	batchDict    = "BD"
+	stagedDict   = "SD"
+	ownersDict   = "OD"
+	reconDict    = "RD"
+)
+
+const (
+	// MaxPortStatsHistory is the number of port statistics samples kept for
+	// each port.
+	MaxPortStatsHistory = 16
 )
 
 type driverInfo struct {
@@ -137,6 +148,7 @@ func newTriggered(t nom.Trigger, d time.Duration,
 type flow struct {
 	FlowEntry       nom.FlowEntry
 	FlowSubscribers []bh.AppCellKey
+	Installed       bool // Whether the node has confirmed the flow.
 	Duration        time.Duration
 	Packets         uint64
 	Bytes           uint64
@@ -152,6 +164,18 @@ func (f *flow) updateStats(stats nom.FlowStats) {
 	f.Packets = stats.Packets
 }
 
+// matchesStats returns whether the stats belong to this flow.
+func (f flow) matchesStats(stats nom.FlowStats) bool {
+	return f.FlowEntry.Priority == stats.Priority &&
+		f.FlowEntry.Match.Equals(stats.Match)
+}
+
+// isForeign returns whether the flow is found on the node but is not installed
+// through the controller. Such flows have no subscribers and no actions.
+func (f flow) isForeign() bool {
+	return len(f.FlowSubscribers) == 0
+}
+
 func (f flow) hasFlowSubscriber(sub bh.AppCellKey) bool {
 	for _, s := range f.FlowSubscribers {
 		if s == sub {
@@ -170,30 +194,212 @@ func (f *flow) maybeAddFlowSubscriber(sub bh.AppCellKey) bool {
 }
 
 type nodeFlows struct {
-	Node  nom.Node
-	Flows []flow
+	Node       nom.Node
+	Flows      map[uint64]flow // Flows keyed by their cookies.
+	LastCookie uint64          // The last cookie assigned to a flow.
 }
 
-func (nf *nodeFlows) flowIndex(flow nom.FlowEntry) int {
-	for i := range nf.Flows {
-		if flow.Equals(nf.Flows[i].FlowEntry) {
-			return i
+// flowCookie returns the cookie of the flow that is equal to flow, and whether
+// such a flow exists. Foreign flows are never equal to a flow.
+func (nf *nodeFlows) flowCookie(flow nom.FlowEntry) (uint64, bool) {
+	if f, ok := nf.Flows[flow.Cookie]; ok && !f.isForeign() &&
+		flow.Equals(f.FlowEntry) {
+
+		return flow.Cookie, true
+	}
+	for c, f := range nf.Flows {
+		if !f.isForeign() && flow.Equals(f.FlowEntry) {
+			return c, true
 		}
 	}
-	return -1
+	return 0, false
 }
 
-func (nf *nodeFlows) maybeAddFlow(add nom.AddFlowEntry) bool {
-	i := nf.flowIndex(add.Flow)
-	if i < 0 {
-		f := flow{
+// statsCookie returns the cookie of the flow that the stats belong to, and
+// whether such a flow exists. Foreign flows are not keyed by the cookie on
+// the node, and are looked up by their match and priority.
+func (nf *nodeFlows) statsCookie(stats nom.FlowStats) (uint64, bool) {
+	if f, ok := nf.Flows[stats.Cookie]; ok && !f.isForeign() &&
+		f.matchesStats(stats) {
+
+		return stats.Cookie, true
+	}
+	for c, f := range nf.Flows {
+		if f.isForeign() && f.FlowEntry.Cookie == stats.Cookie &&
+			f.matchesStats(stats) {
+
+			return c, true
+		}
+	}
+	return 0, false
+}
+
+// newCookie returns a cookie that is not used by any flow of the node and has
+// app as its high bits. The low bits are never all zero.
+func (nf *nodeFlows) newCookie(app uint64) uint64 {
+	for {
+		nf.LastCookie = (nf.LastCookie + 1) &^ nom.CookieAppMask
+		c := app | nf.LastCookie
+		if _, ok := nf.Flows[c]; !ok && nf.LastCookie != 0 {
+			return c
+		}
+	}
+}
+
+// putForeign stores a flow found on the node that is not installed through the
+// controller. Since foreign flows may share a cookie, the flow is keyed by a
+// new cookie while its flow entry keeps the cookie on the node.
+func (nf *nodeFlows) putForeign(f flow) {
+	if nf.Flows == nil {
+		nf.Flows = make(map[uint64]flow)
+	}
+	nf.Flows[nf.newCookie(0)] = f
+}
+
+// putFlow stores f using the cookie of its flow entry.
+func (nf *nodeFlows) putFlow(f flow) {
+	if nf.Flows == nil {
+		nf.Flows = make(map[uint64]flow)
+	}
+	nf.Flows[f.FlowEntry.Cookie] = f
+}
+
+// maybeAddFlow adds the flow of add if it does not exist and returns the
+// cookie of the flow and whether it is newly added. New flows are assigned a
+// new cookie, with the AppCookie of the subscriber as its high bits, and
+// replace the flows with the same match and priority, as they do on the node.
+// The replaced flows are removed and returned. If the flow exists, the
+// subscriber of add is added to the flow.
+func (nf *nodeFlows) maybeAddFlow(add nom.AddFlowEntry) (uint64, bool,
+	[]flow) {
+
+	c, ok := nf.flowCookie(add.Flow)
+	if !ok {
+		replaced := nf.delFlows(nom.DelFlowEntry{
+			Match:    add.Flow.Match,
+			Priority: add.Flow.Priority,
+			Exact:    true,
+		})
+		var app uint64
+		if !add.Subscriber.IsNil() {
+			app = nom.AppCookie(add.Subscriber.App)
+		}
+		add.Flow.Cookie = nf.newCookie(app)
+		nf.putFlow(flow{
 			FlowEntry:       add.Flow,
 			FlowSubscribers: []bh.AppCellKey{add.Subscriber},
+		})
+		return add.Flow.Cookie, true, replaced
+	}
+	f := nf.Flows[c]
+	if f.maybeAddFlowSubscriber(add.Subscriber) {
+		nf.Flows[c] = f
+	}
+	return c, false, nil
+}
+
+// conflicts returns the flows of the node that have the same priority as fe
+// and a match overlapping with fe's match, but different actions. OpenFlow
+// leaves undefined which of such flows processes a packet. Flows with the same
+// match are replaced by fe, and foreign flows are not known to the controller,
+// so neither is a conflict.
+func (nf *nodeFlows) conflicts(fe nom.FlowEntry) []flow {
+	var res []flow
+	for _, f := range nf.Flows {
+		if f.isForeign() || f.FlowEntry.Priority != fe.Priority ||
+			sameActions(f.FlowEntry.Actions, fe.Actions) ||
+			f.FlowEntry.Match.Equals(fe.Match) ||
+			!f.FlowEntry.Match.Overlaps(fe.Match) {
+
+			continue
 		}
-		nf.Flows = append(nf.Flows, f)
-		return true
+		res = append(res, f)
 	}
-	return nf.Flows[i].maybeAddFlowSubscriber(add.Subscriber)
+	return res
+}
+
+// delFlow removes the flow with the given cookie.
+func (nf *nodeFlows) delFlow(cookie uint64) {
+	delete(nf.Flows, cookie)
+}
+
+// cookieDel returns the deletion of the flow with the cookie of del, if del
+// has a cookie. The match and the priority of the flow are set in the deletion,
+// since the nodes that cannot filter flow entries by their cookies remove the
+// flow entry with the same match and priority, which is the same entry. It
+// returns false if there is no such flow installed through the controller.
+func (nf *nodeFlows) cookieDel(del nom.DelFlowEntry) (nom.DelFlowEntry, bool) {
+	if del.Cookie == 0 {
+		return del, true
+	}
+	f, ok := nf.Flows[del.Cookie]
+	if !ok || f.isForeign() {
+		return del, false
+	}
+	del.Match = f.FlowEntry.Match
+	del.Priority = f.FlowEntry.Priority
+	del.Exact = true
+	return del, true
+}
+
+// delFlows removes the flows that match del and returns the removed flows.
+func (nf *nodeFlows) delFlows(del nom.DelFlowEntry) []flow {
+	var deleted []flow
+	for c, f := range nf.Flows {
+		if del.Cookie != 0 && c != del.Cookie {
+			continue
+		}
+		if del.Exact {
+			if f.FlowEntry.Priority != del.Priority ||
+				!f.FlowEntry.Match.Equals(del.Match) {
+
+				continue
+			}
+		} else if !del.Match.Subsumes(f.FlowEntry.Match) {
+			continue
+		}
+		deleted = append(deleted, f)
+		delete(nf.Flows, c)
+	}
+	return deleted
+}
+
+// portStatsSample is the statistics of a port at a specific time.
+type portStatsSample struct {
+	Time  time.Time
+	Stats nom.PortStats
+}
+
+type portStatsHistory struct {
+	Port    nom.UID
+	Samples []portStatsSample // Ordered from the oldest to the newest.
+}
+
+// addSample appends s to the history and drops the oldest samples if there
+// are more than MaxPortStatsHistory samples.
+func (h *portStatsHistory) addSample(s portStatsSample) {
+	h.Samples = append(h.Samples, s)
+	if len(h.Samples) > MaxPortStatsHistory {
+		h.Samples = h.Samples[len(h.Samples)-MaxPortStatsHistory:]
+	}
+}
+
+type nodePortStats struct {
+	Node  nom.UID
+	Ports []portStatsHistory
+}
+
+func (np *nodePortStats) addStats(t time.Time, stats nom.PortStats) {
+	sample := portStatsSample{Time: t, Stats: stats}
+	for i := range np.Ports {
+		if np.Ports[i].Port == stats.Port {
+			np.Ports[i].addSample(sample)
+			return
+		}
+	}
+	h := portStatsHistory{Port: stats.Port}
+	h.addSample(sample)
+	np.Ports = append(np.Ports, h)
 }
 
 func init() {
@@ -201,5 +407,20 @@ func init() {
 	gob.Register(flow{})
 	gob.Register(nodeDrivers{})
 	gob.Register(nodeFlows{})
+	gob.Register(nodePortStats{})
 	gob.Register(nodeTriggers{})
+	gob.Register(portStatsHistory{})
+	gob.Register(portStatsSample{})
+}
+
+func sameActions(a1, a2 []nom.Action) bool {
+	if len(a1) != len(a2) {
+		return false
+	}
+	for i := range a1 {
+		if !a1[i].Equals(a2[i]) {
+			return false
+		}
+	}
+	return true
 }
@@ -0,0 +1,79 @@
+package controller
+
+import (
+	bh "github.com/kandoo/beehive"
+	"github.com/kandoo/beehive-netctrl/nom"
+	"github.com/kandoo/beehive/Godeps/_workspace/src/github.com/golang/glog"
+)
+
+// driverErrorHandler handles the errors of the messages that the controller
+// has sent to the drivers, and forwards them to the applications.
+type driverErrorHandler struct{}
+
+func (h driverErrorHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
+	derr := msg.Data().(nom.DriverError)
+	if err := failStagedBatch(derr, ctx); err != nil {
+		return err
+	}
+	switch req := derr.Request.(type) {
+	case nom.AddFlowEntry:
+		return failFlow(req.Flow, derr, ctx)
+	case nom.PacketOut:
+		failed := nom.PacketOutFailed{Packet: req, Err: derr}
+		if req.Issuer == 0 {
+			ctx.Emit(failed)
+			return nil
+		}
+		ctx.SendToBee(failed, req.Issuer)
+	case nom.ChangeDriverRole:
+		return retryRole(req, derr, msg.From(), ctx)
+	default:
+		glog.Errorf("controller: %v for %#v", derr, derr.Request)
+	}
+	return nil
+}
+
+func (h driverErrorHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
+	return nodeDriversMap(msg.Data().(nom.DriverError).Node)
+}
+
+// retryRole handles the error of a role request sent to driver. A stale role
+// request is resent with a newer generation, as long as the driver is still
+// connected to the node.
+func retryRole(req nom.ChangeDriverRole, derr nom.DriverError, driver uint64,
+	ctx bh.RcvContext) error {
+
+	if derr.Code != nom.DriverErrStaleRole {
+		glog.Errorf("controller: cannot change the role of driver %v of %v: %v",
+			driver, req.Node, derr)
+		return nil
+	}
+
+	v, err := ctx.Dict(driversDict).Get(string(req.Node))
+	if err != nil {
+		return nil
+	}
+	d, ok := v.(nodeDrivers).driver(nom.Driver{BeeID: driver})
+	if !ok {
+		return nil
+	}
+
+	gdict := ctx.Dict(genDict)
+	gen := req.Generation
+	if v, err := gdict.Get("gen"); err == nil && v.(uint64) > gen {
+		gen = v.(uint64)
+	}
+	gen++
+	if err := gdict.Put("gen", gen); err != nil {
+		return err
+	}
+
+	glog.V(2).Infof("controller: retries role %v of driver %v of %v with "+
+		"generation %v", d.Role, driver, req.Node, gen)
+	ctx.SendToBee(nom.ChangeDriverRole{
+		Node:       req.Node,
+		Role:       d.Role,
+		Generation: gen,
+	}, driver)
+	return nil
+}
@@ -1,25 +1,35 @@
 package controller
 
 import (
+	"fmt"
+
 	bh "github.com/kandoo/beehive"
 	"github.com/kandoo/beehive-netctrl/nom"
+	"github.com/kandoo/beehive/Godeps/_workspace/src/github.com/golang/glog"
 )
 
-type addFlowHandler struct{}
+type addFlowHandler struct {
+	policy ConflictPolicy
+}
 
 func (h addFlowHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
 	add := msg.Data().(nom.AddFlowEntry)
+	if err := add.Flow.Match.Validate(); err != nil {
+		rejectFlow(add, nom.DriverErrBadMatch, err, ctx)
+		return nil
+	}
+	add.Flow.Match = add.Flow.Match.Normalize()
+
 	var nf nodeFlows
 	if v, err := ctx.Dict(flowsDict).Get(string(add.Flow.Node)); err == nil {
 		nf = v.(nodeFlows)
 	}
-	added := nom.FlowEntryAdded{Flow: add.Flow}
-	if nf.maybeAddFlow(add) {
-		ctx.Emit(added)
-		sendToMaster(add, add.Flow.Node, ctx)
+	if err := h.policy.check(add.Flow, nf); err != nil {
+		rejectFlow(add, nom.DriverErrOverlap, err, ctx)
+		return nil
 	}
-	if !add.Subscriber.IsNil() {
-		ctx.SendToCell(added, add.Subscriber.App, add.Subscriber.Cell())
+	if _, _, _, err := addFlow(add, &nf, ctx); err != nil {
+		return err
 	}
 	return ctx.Dict(flowsDict).Put(string(add.Flow.Node), nf)
 }
@@ -28,12 +38,212 @@ func (h addFlowHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
 	return nodeDriversMap(msg.Data().(nom.AddFlowEntry).Flow.Node)
 }
 
+// check returns an error if fe conflicts with the flows of nf and p rejects
+// conflicting flows. Otherwise, it logs the conflict.
+func (p ConflictPolicy) check(fe nom.FlowEntry, nf nodeFlows) error {
+	c := nf.conflicts(fe)
+	if len(c) == 0 {
+		return nil
+	}
+	err := fmt.Errorf("%v conflicts with %v", fe, c[0].FlowEntry)
+	if p == ConflictReject {
+		return err
+	}
+	glog.Warningf("%v: behavior of the node is undefined", err)
+	return nil
+}
+
+type flowInstalledHandler struct{}
+
+func (h flowInstalledHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
+	inst := msg.Data().(nom.FlowEntryInstalled)
+	var nf nodeFlows
+	if v, err := ctx.Dict(flowsDict).Get(string(inst.Flow.Node)); err == nil {
+		nf = v.(nodeFlows)
+	}
+	c, ok := nf.flowCookie(inst.Flow)
+	if !ok || nf.Flows[c].Installed {
+		return nil
+	}
+	f := nf.Flows[c]
+	f.Installed = true
+	nf.Flows[c] = f
+	added := nom.FlowEntryAdded{Flow: f.FlowEntry}
+	ctx.Emit(added)
+	for _, sub := range f.FlowSubscribers {
+		if !sub.IsNil() {
+			ctx.SendToCell(added, sub.App, sub.Cell())
+		}
+	}
+	return ctx.Dict(flowsDict).Put(string(inst.Flow.Node), nf)
+}
+
+func (h flowInstalledHandler) Map(msg bh.Msg,
+	ctx bh.MapContext) bh.MappedCells {
+
+	return nodeDriversMap(msg.Data().(nom.FlowEntryInstalled).Flow.Node)
+}
+
 type delFlowHandler struct{}
 
 func (h delFlowHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
-	return nil
+	del := msg.Data().(nom.DelFlowEntry)
+	if del.Node == "" {
+		// Broadcast the deletion to all the nodes.
+		ctx.Dict(driversDict).ForEach(func(k string, v interface{}) bool {
+			nodeDel := del
+			nodeDel.Node = nom.UID(k)
+			ctx.Emit(nodeDel)
+			return true
+		})
+		return nil
+	}
+
+	var nf nodeFlows
+	if v, err := ctx.Dict(flowsDict).Get(string(del.Node)); err == nil {
+		nf = v.(nodeFlows)
+	}
+	del, ok := nf.cookieDel(del)
+	if !ok {
+		return nil
+	}
+
+	if err := sendToMaster(del, del.Node, ctx); err != nil {
+		return err
+	}
+
+	for _, f := range nf.delFlows(del) {
+		notifyFlowDeleted(f, nom.FlowRemovedDeleted, ctx)
+	}
+	return ctx.Dict(flowsDict).Put(string(del.Node), nf)
 }
 
 func (h delFlowHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
-	return nil
+	del := msg.Data().(nom.DelFlowEntry)
+	if del.Node == "" {
+		return bh.MappedCells{}
+	}
+	return nodeDriversMap(del.Node)
+}
+
+type flowRemovedHandler struct{}
+
+func (h flowRemovedHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
+	rem := msg.Data().(nom.FlowEntryRemoved)
+	var nf nodeFlows
+	if v, err := ctx.Dict(flowsDict).Get(string(rem.Flow.Node)); err == nil {
+		nf = v.(nodeFlows)
+	}
+	stats := nom.FlowStats{
+		Match:    rem.Flow.Match,
+		Priority: rem.Flow.Priority,
+		Cookie:   rem.Flow.Cookie,
+		Duration: rem.Duration,
+		Packets:  rem.Packets,
+		Bytes:    rem.Bytes,
+	}
+	c, ok := nf.statsCookie(stats)
+	if !ok {
+		return nil
+	}
+	f := nf.Flows[c]
+	nf.delFlow(c)
+	f.updateStats(stats)
+	notifyFlowDeleted(f, rem.Reason, ctx)
+	return ctx.Dict(flowsDict).Put(string(rem.Flow.Node), nf)
+}
+
+func (h flowRemovedHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
+	return nodeDriversMap(msg.Data().(nom.FlowEntryRemoved).Flow.Node)
+}
+
+// addFlow adds the flow to nf and sends it to the master driver if it is a new
+// flow. FlowEntryAdded is sent to the subscribers when the node confirms the
+// flow entry. It returns the flow entry with its cookie, whether the flow is
+// new, and the flows replaced by the new flow.
+func addFlow(add nom.AddFlowEntry, nf *nodeFlows,
+	ctx bh.RcvContext) (nom.FlowEntry, bool, []flow, error) {
+
+	c, isNew, replaced := nf.maybeAddFlow(add)
+	f := nf.Flows[c]
+	if isNew {
+		for _, r := range replaced {
+			notifyFlowDeleted(r, nom.FlowRemovedDeleted, ctx)
+		}
+		add.Flow = f.FlowEntry
+		err := sendToMaster(add, add.Flow.Node, ctx)
+		return f.FlowEntry, true, replaced, err
+	}
+	if f.Installed && !add.Subscriber.IsNil() {
+		added := nom.FlowEntryAdded{Flow: f.FlowEntry}
+		ctx.SendToCell(added, add.Subscriber.App, add.Subscriber.Cell())
+	}
+	return f.FlowEntry, false, nil, nil
+}
+
+// failFlow removes the flow that the node has failed to install, and notifies
+// the subscribers of the flow using FlowEntryFailed.
+func failFlow(flow nom.FlowEntry, derr nom.DriverError,
+	ctx bh.RcvContext) error {
+
+	var nf nodeFlows
+	if v, err := ctx.Dict(flowsDict).Get(string(flow.Node)); err == nil {
+		nf = v.(nodeFlows)
+	}
+	c, ok := nf.flowCookie(flow)
+	if !ok {
+		return nil
+	}
+	failed := nom.FlowEntryFailed{
+		Flow: flow,
+		Err:  derr,
+	}
+	ctx.Emit(failed)
+	for _, sub := range nf.Flows[c].FlowSubscribers {
+		if !sub.IsNil() {
+			ctx.SendToCell(failed, sub.App, sub.Cell())
+		}
+	}
+	nf.delFlow(c)
+	return ctx.Dict(flowsDict).Put(string(flow.Node), nf)
+}
+
+// rejectFlow notifies the subscriber of add that the flow is rejected using
+// FlowEntryFailed with the given error code.
+func rejectFlow(add nom.AddFlowEntry, code nom.DriverErrorCode, err error,
+	ctx bh.RcvContext) {
+
+	failed := nom.FlowEntryFailed{
+		Flow: add.Flow,
+		Err: nom.DriverError{
+			Node:    add.Flow.Node,
+			Code:    code,
+			Err:     err.Error(),
+			Request: add,
+		},
+	}
+	ctx.Emit(failed)
+	if !add.Subscriber.IsNil() {
+		ctx.SendToCell(failed, add.Subscriber.App, add.Subscriber.Cell())
+	}
+}
+
+// notifyFlowDeleted emits a FlowEntryDeleted for f, carrying the reason and
+// the last statistics of f, and sends it to all the subscribers of f.
+func notifyFlowDeleted(f flow, reason nom.FlowRemovedReason,
+	ctx bh.RcvContext) {
+
+	del := nom.FlowEntryDeleted{
+		Flow:     f.FlowEntry,
+		Reason:   reason,
+		Duration: f.Duration,
+		Packets:  f.Packets,
+		Bytes:    f.Bytes,
+	}
+	ctx.Emit(del)
+	for _, sub := range f.FlowSubscribers {
+		if !sub.IsNil() {
+			ctx.SendToCell(del, sub.App, sub.Cell())
+		}
+	}
 }
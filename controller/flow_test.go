@@ -0,0 +1,448 @@
+package controller
+
+import (
+	"testing"
+
+	bh "github.com/kandoo/beehive"
+	"github.com/kandoo/beehive-netctrl/nom"
+)
+
+// connectNodesForTest returns a context in which each node is connected to a
+// master driver. The bee ID of the driver of nodes[i] is i+1.
+func connectNodesForTest(nodes ...nom.UID) *bh.MockRcvContext {
+	ctx := &bh.MockRcvContext{}
+	for i, n := range nodes {
+		ctx.Dict(driversDict).Put(string(n), nodeDrivers{
+			Node: nom.Node{ID: nom.NodeID(n)},
+			Drivers: []driverInfo{
+				{
+					Driver: nom.Driver{
+						BeeID: uint64(i + 1),
+						Role:  nom.DriverRoleMaster,
+					},
+				},
+			},
+		})
+	}
+	return ctx
+}
+
+func nodeFlowsForTest(node nom.UID, ctx bh.RcvContext) nodeFlows {
+	v, err := ctx.Dict(flowsDict).Get(string(node))
+	if err != nil {
+		return nodeFlows{}
+	}
+	return v.(nodeFlows)
+}
+
+// msgsForTest returns the messages in ctx that have the same type as typ.
+func msgsForTest(ctx *bh.MockRcvContext, typ interface{}) []bh.Msg {
+	var res []bh.Msg
+	for _, msg := range ctx.CtxMsgs {
+		if (&bh.MockMsg{MsgData: typ}).Type() == msg.Type() {
+			res = append(res, msg)
+		}
+	}
+	return res
+}
+
+func flowForTest(node nom.UID, port nom.UID) nom.FlowEntry {
+	return nom.FlowEntry{
+		Node: node,
+		Match: nom.Match{
+			Fields: []nom.Field{
+				nom.InPort(node + "$$1"),
+			},
+		},
+		Actions: []nom.Action{
+			nom.ActionForward{
+				Ports: []nom.UID{port},
+			},
+		},
+		Priority: 1,
+	}
+}
+
+func ethDstFlowForTest(node nom.UID, port nom.UID) nom.FlowEntry {
+	return nom.FlowEntry{
+		Node: node,
+		Match: nom.Match{
+			Fields: []nom.Field{
+				nom.EthDst{
+					Addr: [6]byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06},
+					Mask: nom.MaskNoneMAC,
+				},
+			},
+		},
+		Actions: []nom.Action{
+			nom.ActionForward{
+				Ports: []nom.UID{port},
+			},
+		},
+		Priority: 1,
+	}
+}
+
+var subForTest = bh.AppCellKey{App: "app", Dict: "d", Key: "k"}
+
+// addFlowForTest adds flow through the controller and confirms it. It returns
+// the flow entry with its cookie.
+func addFlowForTest(flow nom.FlowEntry, ctx *bh.MockRcvContext,
+	t *testing.T) nom.FlowEntry {
+
+	msg := &bh.MockMsg{
+		MsgData: nom.AddFlowEntry{
+			Flow:       flow,
+			Subscriber: subForTest,
+		},
+	}
+	if err := (addFlowHandler{}).Rcv(msg, ctx); err != nil {
+		t.Fatalf("cannot add flow: %v", err)
+	}
+	adds := msgsForTest(ctx, nom.AddFlowEntry{})
+	if len(adds) != 1 {
+		t.Fatalf("invalid number of flows sent: actual=%v want=1", len(adds))
+	}
+	flow = adds[0].Data().(nom.AddFlowEntry).Flow
+	msg = &bh.MockMsg{
+		MsgData: nom.FlowEntryInstalled{Flow: flow},
+	}
+	if err := (flowInstalledHandler{}).Rcv(msg, ctx); err != nil {
+		t.Fatalf("cannot confirm flow: %v", err)
+	}
+	ctx.CtxMsgs = nil
+	return flow
+}
+
+func TestAddFlow(t *testing.T) {
+	ctx := connectNodesForTest("n1")
+	msg := &bh.MockMsg{
+		MsgData: nom.AddFlowEntry{
+			Flow:       flowForTest("n1", "n1$$2"),
+			Subscriber: subForTest,
+		},
+	}
+	if err := (addFlowHandler{}).Rcv(msg, ctx); err != nil {
+		t.Fatalf("cannot add flow: %v", err)
+	}
+	adds := msgsForTest(ctx, nom.AddFlowEntry{})
+	if len(adds) != 1 {
+		t.Fatalf("invalid number of flows sent: actual=%v want=1", len(adds))
+	}
+	if adds[0].To() != 1 {
+		t.Errorf("flow is not sent to the master: actual=%v want=1",
+			adds[0].To())
+	}
+	flow := adds[0].Data().(nom.AddFlowEntry).Flow
+	if flow.Cookie&nom.CookieAppMask != nom.AppCookie(subForTest.App) {
+		t.Errorf("invalid app bits in cookie %x: want=%x", flow.Cookie,
+			nom.AppCookie(subForTest.App))
+	}
+	if flow.Cookie&^nom.CookieAppMask == 0 {
+		t.Errorf("cookie %x has no flow bits", flow.Cookie)
+	}
+
+	ctx.CtxMsgs = nil
+	if err := (addFlowHandler{}).Rcv(msg, ctx); err != nil {
+		t.Fatalf("cannot add flow: %v", err)
+	}
+	if adds := msgsForTest(ctx, nom.AddFlowEntry{}); len(adds) != 0 {
+		t.Errorf("existing flow is resent to the node")
+	}
+
+	msg = &bh.MockMsg{
+		MsgData: nom.FlowEntryInstalled{Flow: flow},
+	}
+	ctx.CtxMsgs = nil
+	if err := (flowInstalledHandler{}).Rcv(msg, ctx); err != nil {
+		t.Fatalf("cannot confirm flow: %v", err)
+	}
+	// One emitted and one sent to the subscriber.
+	if added := msgsForTest(ctx, nom.FlowEntryAdded{}); len(added) != 2 {
+		t.Errorf("invalid number of flow added messages: actual=%v want=2",
+			len(added))
+	}
+	nf := nodeFlowsForTest("n1", ctx)
+	if !nf.Flows[flow.Cookie].Installed {
+		t.Errorf("flow is not marked as installed")
+	}
+
+	ctx.CtxMsgs = nil
+	if err := (flowInstalledHandler{}).Rcv(msg, ctx); err != nil {
+		t.Fatalf("cannot confirm flow: %v", err)
+	}
+	if added := msgsForTest(ctx, nom.FlowEntryAdded{}); len(added) != 0 {
+		t.Errorf("flow added is notified twice")
+	}
+}
+
+func TestAddFlowReplace(t *testing.T) {
+	ctx := connectNodesForTest("n1")
+	old := addFlowForTest(flowForTest("n1", "n1$$2"), ctx, t)
+
+	msg := &bh.MockMsg{
+		MsgData: nom.AddFlowEntry{
+			Flow:       flowForTest("n1", "n1$$3"),
+			Subscriber: subForTest,
+		},
+	}
+	if err := (addFlowHandler{}).Rcv(msg, ctx); err != nil {
+		t.Fatalf("cannot add flow: %v", err)
+	}
+	deleted := msgsForTest(ctx, nom.FlowEntryDeleted{})
+	if len(deleted) != 2 {
+		t.Fatalf("invalid number of flow deleted messages: actual=%v want=2",
+			len(deleted))
+	}
+	if del := deleted[0].Data().(nom.FlowEntryDeleted); del.Flow.Cookie !=
+		old.Cookie {
+
+		t.Errorf("invalid deleted flow: actual=%v want=%v", del.Flow, old)
+	}
+
+	nf := nodeFlowsForTest("n1", ctx)
+	if len(nf.Flows) != 1 {
+		t.Fatalf("invalid number of flows: actual=%v want=1", len(nf.Flows))
+	}
+	if _, ok := nf.Flows[old.Cookie]; ok {
+		t.Errorf("replaced flow is not removed")
+	}
+	for _, f := range nf.Flows {
+		if !f.FlowEntry.Equals(flowForTest("n1", "n1$$3")) {
+			t.Errorf("invalid flow: actual=%v want=%v", f.FlowEntry,
+				flowForTest("n1", "n1$$3"))
+		}
+	}
+}
+
+func TestAddFlowConflict(t *testing.T) {
+	for _, p := range []ConflictPolicy{ConflictWarn, ConflictReject} {
+		ctx := connectNodesForTest("n1")
+		addFlowForTest(flowForTest("n1", "n1$$2"), ctx, t)
+
+		msg := &bh.MockMsg{
+			MsgData: nom.AddFlowEntry{
+				Flow:       ethDstFlowForTest("n1", "n1$$3"),
+				Subscriber: subForTest,
+			},
+		}
+		if err := (addFlowHandler{policy: p}).Rcv(msg, ctx); err != nil {
+			t.Fatalf("cannot add flow: %v", err)
+		}
+		adds := msgsForTest(ctx, nom.AddFlowEntry{})
+		failed := msgsForTest(ctx, nom.FlowEntryFailed{})
+		nf := nodeFlowsForTest("n1", ctx)
+		if p == ConflictWarn {
+			if len(adds) != 1 || len(failed) != 0 || len(nf.Flows) != 2 {
+				t.Errorf("conflicting flow is not installed with ConflictWarn")
+			}
+			continue
+		}
+
+		if len(adds) != 0 || len(nf.Flows) != 1 {
+			t.Errorf("conflicting flow is installed with ConflictReject")
+		}
+		// One emitted and one sent to the subscriber.
+		if len(failed) != 2 {
+			t.Fatalf("invalid number of flow failed messages: actual=%v want=2",
+				len(failed))
+		}
+		err := failed[0].Data().(nom.FlowEntryFailed).Err
+		if err.Code != nom.DriverErrOverlap {
+			t.Errorf("invalid error code: actual=%v want=%v", err.Code,
+				nom.DriverErrOverlap)
+		}
+	}
+}
+
+func TestConflictPolicy(t *testing.T) {
+	ctx := connectNodesForTest("n1")
+	installed := addFlowForTest(flowForTest("n1", "n1$$2"), ctx, t)
+	nf := nodeFlowsForTest("n1", ctx)
+
+	sameMatch := flowForTest("n1", "n1$$3")
+	sameActs := ethDstFlowForTest("n1", "n1$$2")
+	otherPrio := ethDstFlowForTest("n1", "n1$$3")
+	otherPrio.Priority = 2
+	for _, fe := range []nom.FlowEntry{sameMatch, sameActs, otherPrio} {
+		if err := ConflictReject.check(fe, nf); err != nil {
+			t.Errorf("%v does not conflict with %v: %v", fe, installed, err)
+		}
+	}
+
+	conflicting := ethDstFlowForTest("n1", "n1$$3")
+	if err := ConflictReject.check(conflicting, nf); err == nil {
+		t.Errorf("%v conflicts with %v", conflicting, installed)
+	}
+	if err := ConflictWarn.check(conflicting, nf); err != nil {
+		t.Errorf("conflict is rejected with ConflictWarn: %v", err)
+	}
+
+	nf.putForeign(flow{
+		FlowEntry: nom.FlowEntry{
+			Node:     "n1",
+			Match:    ethDstFlowForTest("n1", "").Match,
+			Priority: 2,
+		},
+	})
+	if err := ConflictReject.check(otherPrio, nf); err != nil {
+		t.Errorf("foreign flows are considered in conflicts: %v", err)
+	}
+}
+
+func TestDelFlowBroadcast(t *testing.T) {
+	ctx := connectNodesForTest("n1", "n2")
+	msg := &bh.MockMsg{
+		MsgData: nom.DelFlowEntry{
+			Match: flowForTest("n1", "").Match,
+		},
+	}
+	if err := (delFlowHandler{}).Rcv(msg, ctx); err != nil {
+		t.Fatalf("cannot delete flows: %v", err)
+	}
+	dels := msgsForTest(ctx, nom.DelFlowEntry{})
+	if len(dels) != 2 {
+		t.Fatalf("invalid number of deletions: actual=%v want=2", len(dels))
+	}
+	nodes := make(map[nom.UID]bool)
+	for _, d := range dels {
+		nodes[d.Data().(nom.DelFlowEntry).Node] = true
+	}
+	if !nodes["n1"] || !nodes["n2"] {
+		t.Errorf("deletion is not broadcast to all the nodes: %v", nodes)
+	}
+}
+
+func TestDelFlow(t *testing.T) {
+	ctx := connectNodesForTest("n1")
+	f1 := addFlowForTest(flowForTest("n1", "n1$$2"), ctx, t)
+	f2 := addFlowForTest(ethDstFlowForTest("n1", "n1$$2"), ctx, t)
+
+	msg := &bh.MockMsg{
+		MsgData: nom.DelFlowEntry{
+			Node:  "n1",
+			Match: f1.Match,
+		},
+	}
+	if err := (delFlowHandler{}).Rcv(msg, ctx); err != nil {
+		t.Fatalf("cannot delete flows: %v", err)
+	}
+	if dels := msgsForTest(ctx, nom.DelFlowEntry{}); len(dels) != 1 ||
+		dels[0].To() != 1 {
+
+		t.Errorf("deletion is not sent to the master")
+	}
+	nf := nodeFlowsForTest("n1", ctx)
+	if _, ok := nf.Flows[f1.Cookie]; ok {
+		t.Errorf("deleted flow %v is not removed", f1)
+	}
+	if _, ok := nf.Flows[f2.Cookie]; !ok {
+		t.Errorf("flow %v is removed", f2)
+	}
+}
+
+func TestDelFlowByCookie(t *testing.T) {
+	ctx := connectNodesForTest("n1")
+	f1 := addFlowForTest(flowForTest("n1", "n1$$2"), ctx, t)
+	f2 := addFlowForTest(ethDstFlowForTest("n1", "n1$$2"), ctx, t)
+
+	msg := &bh.MockMsg{
+		MsgData: nom.DelFlowEntry{
+			Node:   "n1",
+			Cookie: f2.Cookie,
+		},
+	}
+	if err := (delFlowHandler{}).Rcv(msg, ctx); err != nil {
+		t.Fatalf("cannot delete flows: %v", err)
+	}
+	dels := msgsForTest(ctx, nom.DelFlowEntry{})
+	if len(dels) != 1 {
+		t.Fatalf("invalid number of deletions: actual=%v want=1", len(dels))
+	}
+	del := dels[0].Data().(nom.DelFlowEntry)
+	if !del.Exact || del.Priority != f2.Priority ||
+		!del.Match.Equals(f2.Match) {
+
+		t.Errorf("invalid deletion for cookie %x: %v", f2.Cookie, del)
+	}
+	nf := nodeFlowsForTest("n1", ctx)
+	if _, ok := nf.Flows[f2.Cookie]; ok {
+		t.Errorf("deleted flow %v is not removed", f2)
+	}
+	if _, ok := nf.Flows[f1.Cookie]; !ok {
+		t.Errorf("flow %v is removed", f1)
+	}
+
+	ctx.CtxMsgs = nil
+	if err := (delFlowHandler{}).Rcv(msg, ctx); err != nil {
+		t.Fatalf("cannot delete flows: %v", err)
+	}
+	if dels := msgsForTest(ctx, nom.DelFlowEntry{}); len(dels) != 0 {
+		t.Errorf("deletion of an unknown cookie is sent to the node")
+	}
+}
+
+func TestFlowRemoved(t *testing.T) {
+	ctx := connectNodesForTest("n1")
+	flow := addFlowForTest(flowForTest("n1", "n1$$2"), ctx, t)
+
+	msg := &bh.MockMsg{
+		MsgData: nom.FlowEntryRemoved{
+			Flow:    flow,
+			Reason:  nom.FlowRemovedIdleTimeout,
+			Packets: 10,
+			Bytes:   1000,
+		},
+	}
+	if err := (flowRemovedHandler{}).Rcv(msg, ctx); err != nil {
+		t.Fatalf("cannot remove flow: %v", err)
+	}
+	deleted := msgsForTest(ctx, nom.FlowEntryDeleted{})
+	if len(deleted) != 2 {
+		t.Fatalf("invalid number of flow deleted messages: actual=%v want=2",
+			len(deleted))
+	}
+	del := deleted[0].Data().(nom.FlowEntryDeleted)
+	if del.Reason != nom.FlowRemovedIdleTimeout || del.Packets != 10 ||
+		del.Bytes != 1000 {
+
+		t.Errorf("invalid flow deleted message: %v", del)
+	}
+	if nf := nodeFlowsForTest("n1", ctx); len(nf.Flows) != 0 {
+		t.Errorf("removed flow is not deleted")
+	}
+}
+
+func TestFailFlow(t *testing.T) {
+	ctx := connectNodesForTest("n1")
+	flow := flowForTest("n1", "n1$$2")
+	msg := &bh.MockMsg{
+		MsgData: nom.AddFlowEntry{
+			Flow:       flow,
+			Subscriber: subForTest,
+		},
+	}
+	if err := (addFlowHandler{}).Rcv(msg, ctx); err != nil {
+		t.Fatalf("cannot add flow: %v", err)
+	}
+	add := msgsForTest(ctx, nom.AddFlowEntry{})[0].Data().(nom.AddFlowEntry)
+
+	msg = &bh.MockMsg{
+		MsgData: nom.DriverError{
+			Node:    "n1",
+			Code:    nom.DriverErrBadRequest,
+			Request: add,
+		},
+		MsgFrom: 1,
+	}
+	if err := (driverErrorHandler{}).Rcv(msg, ctx); err != nil {
+		t.Fatalf("cannot handle the driver error: %v", err)
+	}
+	if failed := msgsForTest(ctx, nom.FlowEntryFailed{}); len(failed) != 2 {
+		t.Errorf("invalid number of flow failed messages: actual=%v want=2",
+			len(failed))
+	}
+	if nf := nodeFlowsForTest("n1", ctx); len(nf.Flows) != 0 {
+		t.Errorf("failed flow is not removed")
+	}
+}
@@ -56,7 +56,13 @@ func (h nodeConnectedHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
 	}, db)
 
 	gdict.Put("gen", gen)
-	return ddict.Put(k, n)
+	if err := ddict.Put(k, n); err != nil {
+		return err
+	}
+	if nc.Driver.Role != nom.DriverRoleMaster {
+		return nil
+	}
+	return startReconciliation(nc.Node.UID(), ctx)
 }
 
 func (h nodeConnectedHandler) Map(msg bh.Msg,
@@ -106,6 +112,11 @@ func (h nodeDisconnectedHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
 			Role:       nom.DriverRoleMaster,
 			Generation: gen,
 		}, nd.master().BeeID)
+
+		if err := d.Put(k, nd); err != nil {
+			return err
+		}
+		return startReconciliation(nd.Node.UID(), ctx)
 	}
 
 	return d.Put(k, nd)
@@ -9,6 +9,9 @@ type pktOutHandler struct{}
 
 func (h pktOutHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
 	pkt := msg.Data().(nom.PacketOut)
+	if pkt.Issuer == 0 {
+		pkt.Issuer = msg.From()
+	}
 	return sendToMaster(pkt, pkt.Node, ctx)
 }
 
@@ -31,6 +31,17 @@ func (h portStatusHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
 		return nil
 	}
 
+	if data.Reason == nom.PortStatusDeleted {
+		if !n.Ports.DelPort(data.Port) {
+			return fmt.Errorf("NOMController: %v is not found", data.Port)
+		}
+
+		ctx.Emit(nom.PortRemoved(data.Port))
+		reinstallFloodFlows(n.Node.UID(), ctx)
+		return dict.Put(k, n)
+	}
+
+	flood := true
 	if p, ok := n.Ports.GetPort(data.Port.UID()); ok {
 		if p == data.Port {
 			return fmt.Errorf("NOMController: duplicate port status change for %v",
@@ -38,11 +49,41 @@ func (h portStatusHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
 		}
 
 		n.Ports.DelPort(p)
+		flood = (p.Config^data.Port.Config)&nom.PortConfigNoFlood != 0
 	}
 
 	n.Ports.AddPort(data.Port)
 	ctx.Emit(nom.PortUpdated(data.Port))
-	return nil
+	if flood {
+		reinstallFloodFlows(n.Node.UID(), ctx)
+	}
+	return dict.Put(k, n)
+}
+
+// reinstallFloodFlows sends the flows of the node that flood packets to its
+// master driver again. Drivers that emulate PortConfigNoFlood expand flooding
+// into the ports of the node when a flow is installed, and the flows must be
+// updated when the ports that packets are flooded to change.
+func reinstallFloodFlows(node nom.UID, ctx bh.RcvContext) {
+	v, err := ctx.Dict(flowsDict).Get(string(node))
+	if err != nil {
+		return
+	}
+	for _, f := range v.(nodeFlows).Flows {
+		if f.isForeign() || !hasFlood(f.FlowEntry.Actions) {
+			continue
+		}
+		sendToMaster(nom.AddFlowEntry{Flow: f.FlowEntry}, node, ctx)
+	}
+}
+
+func hasFlood(actions []nom.Action) bool {
+	for _, a := range actions {
+		if _, ok := a.(nom.ActionFlood); ok {
+			return true
+		}
+	}
+	return false
 }
 
 func (h portStatusHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
@@ -50,3 +91,25 @@ func (h portStatusHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
 		{driversDict, string(msg.Data().(nom.PortStatusChanged).Port.Node)},
 	}
 }
+
+// modifyPortHandler sends port configuration changes to the master driver of
+// the node. The driver confirms the change with a PortStatusChanged, that is
+// emitted as a PortUpdated.
+type modifyPortHandler struct{}
+
+func (h modifyPortHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
+	mod := msg.Data().(nom.ModifyPort)
+	node := nom.NodeFromPortUID(mod.Port)
+	v, err := ctx.Dict(driversDict).Get(string(node))
+	if err != nil {
+		return fmt.Errorf("NOMController: node %v not found", node)
+	}
+	if _, ok := v.(nodeDrivers).Ports.GetPort(mod.Port); !ok {
+		return fmt.Errorf("NOMController: port %v not found", mod.Port)
+	}
+	return sendToMaster(mod, node, ctx)
+}
+
+func (h modifyPortHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
+	return nodeDriversMap(nom.NodeFromPortUID(msg.Data().(nom.ModifyPort).Port))
+}
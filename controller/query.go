@@ -8,10 +8,21 @@ import (
 type queryHandler struct{}
 
 func (h queryHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
-	query := msg.Data().(nom.FlowStatsQuery)
-	return sendToMaster(query, query.Node, ctx)
+	switch query := msg.Data().(type) {
+	case nom.FlowStatsQuery:
+		return sendToMaster(query, query.Node, ctx)
+	case nom.PortStatsQuery:
+		return sendToMaster(query, query.Node, ctx)
+	}
+	return nil
 }
 
 func (h queryHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
-	return nodeDriversMap(msg.Data().(nom.FlowStatsQuery).Node)
+	switch query := msg.Data().(type) {
+	case nom.FlowStatsQuery:
+		return nodeDriversMap(query.Node)
+	case nom.PortStatsQuery:
+		return nodeDriversMap(query.Node)
+	}
+	return nil
 }
@@ -0,0 +1,180 @@
+package controller
+
+import (
+	"encoding/gob"
+
+	bh "github.com/kandoo/beehive"
+	"github.com/kandoo/beehive-netctrl/nom"
+	"github.com/kandoo/beehive/Godeps/_workspace/src/github.com/golang/glog"
+)
+
+// ownersMap is the cell that stores the flow ownership policies of all
+// applications.
+var ownersMap = bh.MappedCells{{Dict: ownersDict, Key: "0"}}
+
+// reconcileNode is emitted to fetch the flow ownership policies for the
+// reconciliation of a node.
+type reconcileNode struct {
+	Node nom.UID
+}
+
+// nodeOwners carries the flow ownership policies into the cell of a node that
+// is being reconciled.
+type nodeOwners struct {
+	Node   nom.UID
+	Owners []nom.FlowOwnership
+}
+
+// ownershipHandler stores the flow ownership policies of applications, and
+// sends them to the nodes that are being reconciled.
+type ownershipHandler struct{}
+
+func (h ownershipHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
+	switch data := msg.Data().(type) {
+	case nom.FlowOwnership:
+		return ctx.Dict(ownersDict).Put(data.App, data)
+	case reconcileNode:
+		no := nodeOwners{Node: data.Node}
+		ctx.Dict(ownersDict).ForEach(func(k string, v interface{}) bool {
+			no.Owners = append(no.Owners, v.(nom.FlowOwnership))
+			return true
+		})
+		ctx.Emit(no)
+	}
+	return nil
+}
+
+func (h ownershipHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
+	return ownersMap
+}
+
+// reconciliation is the state of the reconciliation of a node.
+type reconciliation struct {
+	Query   uint64              // ID of the last flow query of reconciliation.
+	Pending bool                // Whether the node is waiting for the query.
+	Owners  []nom.FlowOwnership // Ownership policies of the applications.
+}
+
+// nodeOwnersHandler marks the node for reconciliation, storing the ownership
+// policies in the cell of the node, and queries the flows of the node. The
+// query has a new ID, so that the flows are reconciled only using its result
+// and not the results of the other queries, such as the ones of the poller.
+type nodeOwnersHandler struct{}
+
+func (h nodeOwnersHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
+	no := msg.Data().(nodeOwners)
+	var r reconciliation
+	if v, err := ctx.Dict(reconDict).Get(string(no.Node)); err == nil {
+		r = v.(reconciliation)
+	}
+	r.Query++
+	r.Pending = true
+	r.Owners = no.Owners
+	if err := ctx.Dict(reconDict).Put(string(no.Node), r); err != nil {
+		return err
+	}
+	query := nom.FlowStatsQuery{ID: r.Query, Node: no.Node}
+	return sendToMaster(query, no.Node, ctx)
+}
+
+func (h nodeOwnersHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
+	return nodeDriversMap(msg.Data().(nodeOwners).Node)
+}
+
+// startReconciliation fetches the ownership policies for the node. The node is
+// marked for reconciliation when the policies are received, and its flows are
+// reconciled when the consolidator receives the flows of the node.
+func startReconciliation(node nom.UID, ctx bh.RcvContext) error {
+	ctx.Emit(reconcileNode{Node: node})
+	return nil
+}
+
+// isReconciling returns whether res is the result of the query of a node that
+// is waiting for reconciliation.
+func isReconciling(res nom.FlowStatsQueryResult, ctx bh.RcvContext) bool {
+	v, err := ctx.Dict(reconDict).Get(string(res.Node))
+	if err != nil {
+		return false
+	}
+	r := v.(reconciliation)
+	return r.Pending && r.Query == res.ID
+}
+
+// reconcileFlows compares the flows on the node with the flows installed
+// through the controller in nf. It reinstalls the missing flows and removes the
+// unknown flows that are owned by an application asking for their deletion.
+// It returns the flow stats that are not deleted.
+func reconcileFlows(res nom.FlowStatsQueryResult, nf *nodeFlows,
+	ctx bh.RcvContext) []nom.FlowStats {
+
+	var r reconciliation
+	if v, err := ctx.Dict(reconDict).Get(string(res.Node)); err == nil {
+		r = v.(reconciliation)
+	}
+	rec := nom.FlowsReconciled{Node: res.Node}
+
+	found := make(map[uint64]bool)
+	var kept []nom.FlowStats
+	for _, stat := range res.Stats {
+		if c, ok := nf.statsCookie(stat); ok {
+			found[c] = true
+			kept = append(kept, stat)
+			continue
+		}
+
+		if deleteUnknownFlow(stat, r.Owners) {
+			sendToMaster(nom.DelFlowEntry{
+				Node:     res.Node,
+				Match:    stat.Match,
+				Priority: stat.Priority,
+				Exact:    true,
+			}, res.Node, ctx)
+			rec.Deleted = append(rec.Deleted, stat)
+			continue
+		}
+		rec.Unknown = append(rec.Unknown, stat)
+		kept = append(kept, stat)
+	}
+
+	for c, f := range nf.Flows {
+		if found[c] {
+			continue
+		}
+		if f.isForeign() {
+			// The flow was not installed through the controller and has been
+			// removed from the node.
+			nf.delFlow(c)
+			continue
+		}
+		f.Installed = false
+		nf.Flows[c] = f
+		sendToMaster(nom.AddFlowEntry{Flow: f.FlowEntry}, res.Node, ctx)
+		rec.Reinstalled = append(rec.Reinstalled, f.FlowEntry)
+	}
+
+	glog.V(2).Infof("reconciled flows of %v: %d reinstalled, %d deleted, "+
+		"%d unknown", res.Node, len(rec.Reinstalled), len(rec.Deleted),
+		len(rec.Unknown))
+	ctx.Emit(rec)
+	r.Pending = false
+	r.Owners = nil
+	ctx.Dict(reconDict).Put(string(res.Node), r)
+	return kept
+}
+
+// deleteUnknownFlow returns whether an unknown flow should be deleted based on
+// the ownership policies.
+func deleteUnknownFlow(stat nom.FlowStats, owners []nom.FlowOwnership) bool {
+	for _, o := range owners {
+		if o.DeleteUnknown && o.Owns(stat.Cookie) {
+			return true
+		}
+	}
+	return false
+}
+
+func init() {
+	gob.Register(nodeOwners{})
+	gob.Register(reconcileNode{})
+	gob.Register(reconciliation{})
+}
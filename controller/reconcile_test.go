@@ -0,0 +1,131 @@
+package controller
+
+import (
+	"testing"
+
+	bh "github.com/kandoo/beehive"
+	"github.com/kandoo/beehive-netctrl/nom"
+)
+
+func flowStatsForTest(f nom.FlowEntry) nom.FlowStats {
+	return nom.FlowStats{
+		Match:    f.Match,
+		Priority: f.Priority,
+		Cookie:   f.Cookie,
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	ctx := connectNodesForTest("n1")
+	installed := addFlowForTest(flowForTest("n1", "n1$$2"), ctx, t)
+	missing := addFlowForTest(ethDstFlowForTest("n1", "n1$$2"), ctx, t)
+
+	owned := flowStatsForTest(flowForTest("n1", ""))
+	owned.Priority = 2
+	owned.Cookie = nom.AppCookie("owner") | 1
+	unknown := flowStatsForTest(flowForTest("n1", ""))
+	unknown.Priority = 3
+	unknown.Cookie = 1
+
+	msg := &bh.MockMsg{
+		MsgData: nodeOwners{
+			Node: "n1",
+			Owners: []nom.FlowOwnership{
+				{
+					App:           "owner",
+					Cookie:        nom.AppCookie("owner"),
+					CookieMask:    nom.CookieAppMask,
+					DeleteUnknown: true,
+				},
+			},
+		},
+	}
+	if err := (nodeOwnersHandler{}).Rcv(msg, ctx); err != nil {
+		t.Fatalf("cannot start reconciliation: %v", err)
+	}
+	queries := msgsForTest(ctx, nom.FlowStatsQuery{})
+	if len(queries) != 1 || queries[0].To() != 1 {
+		t.Fatalf("flows of the node are not queried")
+	}
+	q := queries[0].Data().(nom.FlowStatsQuery)
+	if q.ID == 0 {
+		t.Errorf("reconciliation query has no ID")
+	}
+
+	// The result of a query of the poller does not reconcile the flows.
+	ctx.CtxMsgs = nil
+	msg = &bh.MockMsg{
+		MsgData: nom.FlowStatsQueryResult{
+			Node:  "n1",
+			Stats: []nom.FlowStats{flowStatsForTest(installed)},
+		},
+	}
+	if err := (Consolidator{}).Rcv(msg, ctx); err != nil {
+		t.Fatalf("cannot consolidate flows: %v", err)
+	}
+	if len(ctx.CtxMsgs) != 0 {
+		t.Errorf("flows are reconciled on a poller query: %v", ctx.CtxMsgs)
+	}
+
+	// The driver sends the result of all the fragments of the reply to the
+	// reconciliation query in one message.
+	msg = &bh.MockMsg{
+		MsgData: nom.FlowStatsQueryResult{
+			ID:   q.ID,
+			Node: "n1",
+			Stats: []nom.FlowStats{
+				flowStatsForTest(installed),
+				owned,
+				unknown,
+			},
+		},
+	}
+	if err := (Consolidator{}).Rcv(msg, ctx); err != nil {
+		t.Fatalf("cannot consolidate flows: %v", err)
+	}
+	recs := msgsForTest(ctx, nom.FlowsReconciled{})
+	if len(recs) != 1 {
+		t.Fatalf("flows are not reconciled")
+	}
+	rec := recs[0].Data().(nom.FlowsReconciled)
+	if len(rec.Reinstalled) != 1 ||
+		rec.Reinstalled[0].Cookie != missing.Cookie {
+
+		t.Errorf("invalid reinstalled flows: actual=%v want=%v",
+			rec.Reinstalled, missing)
+	}
+	if len(rec.Deleted) != 1 || rec.Deleted[0].Cookie != owned.Cookie {
+		t.Errorf("invalid deleted flows: actual=%v want=%v", rec.Deleted, owned)
+	}
+	if len(rec.Unknown) != 1 || rec.Unknown[0].Cookie != unknown.Cookie {
+		t.Errorf("invalid unknown flows: actual=%v want=%v", rec.Unknown,
+			unknown)
+	}
+	adds := msgsForTest(ctx, nom.AddFlowEntry{})
+	if len(adds) != 1 || adds[0].To() != 1 {
+		t.Errorf("missing flow is not reinstalled")
+	}
+	dels := msgsForTest(ctx, nom.DelFlowEntry{})
+	if len(dels) != 1 || dels[0].Data().(nom.DelFlowEntry).Priority !=
+		owned.Priority {
+
+		t.Errorf("owned unknown flow is not deleted")
+	}
+
+	nf := nodeFlowsForTest("n1", ctx)
+	if len(nf.Flows) != 3 {
+		t.Errorf("invalid number of flows: actual=%v want=3", len(nf.Flows))
+	}
+	if nf.Flows[missing.Cookie].Installed {
+		t.Errorf("reinstalled flow is marked as installed")
+	}
+
+	// Reconciliation is done only once for each query.
+	ctx.CtxMsgs = nil
+	if err := (Consolidator{}).Rcv(msg, ctx); err != nil {
+		t.Fatalf("cannot consolidate flows: %v", err)
+	}
+	if recs := msgsForTest(ctx, nom.FlowsReconciled{}); len(recs) != 0 {
+		t.Errorf("flows are reconciled twice for the same query")
+	}
+}
@@ -18,6 +18,17 @@ type nodePortsAndLinks struct {
 	N nom.Node
 	P []nom.Port
 	L []nom.Link
+	// LastSeen is the last time each link was detected, keyed by the link's
+	// UID.
+	LastSeen map[nom.UID]time.Time
+	// Probes is the LLDP probe schedule of each port, keyed by the port's UID.
+	Probes map[nom.UID]portProbe
+}
+
+// portProbe is the LLDP probe schedule of a port.
+type portProbe struct {
+	Next time.Time // When the port should be probed next.
+	Fast int       // Number of remaining fast probes.
 }
 
 func (np *nodePortsAndLinks) hasPort(port nom.Port) bool {
@@ -33,6 +44,7 @@ func (np *nodePortsAndLinks) removePort(port nom.Port) bool {
 	for i, p := range np.P {
 		if p.ID == port.ID {
 			np.P = append(np.P[:i], np.P[i+1:]...)
+			delete(np.Probes, p.UID())
 			return true
 		}
 	}
@@ -67,12 +79,77 @@ func (np *nodePortsAndLinks) removeLink(link nom.Link) bool {
 	for i, l := range np.L {
 		if l.From == link.From {
 			np.L = append(np.L[:i], np.L[i+1:]...)
+			delete(np.LastSeen, l.UID())
 			return true
 		}
 	}
 	return false
 }
 
+func (np *nodePortsAndLinks) seeLink(link nom.Link, t time.Time) {
+	if np.LastSeen == nil {
+		np.LastSeen = make(map[nom.UID]time.Time)
+	}
+	np.LastSeen[link.UID()] = t
+}
+
+// expireLinks removes and returns the links that are not seen since before.
+func (np *nodePortsAndLinks) expireLinks(before time.Time) []nom.Link {
+	var alive, expired []nom.Link
+	for _, l := range np.L {
+		if np.LastSeen[l.UID()].Before(before) {
+			expired = append(expired, l)
+			delete(np.LastSeen, l.UID())
+			continue
+		}
+		alive = append(alive, l)
+	}
+	np.L = alive
+	return expired
+}
+
+func (np *nodePortsAndLinks) setProbe(port nom.UID, pp portProbe) {
+	if np.Probes == nil {
+		np.Probes = make(map[nom.UID]portProbe)
+	}
+	np.Probes[port] = pp
+}
+
+// stopFastProbe stops probing the port using the fast-probe interval.
+func (np *nodePortsAndLinks) stopFastProbe(port nom.UID) {
+	if pp, ok := np.Probes[port]; ok && pp.Fast != 0 {
+		pp.Fast = 0
+		np.Probes[port] = pp
+	}
+}
+
+// probePorts sends LLDP packets out of the ports that are due at now. It sends
+// at most cfg.budget packets, and the ports that are not probed because of the
+// budget remain due for the next round.
+func (np *nodePortsAndLinks) probePorts(now time.Time, cfg config,
+	ctx bh.RcvContext) {
+
+	sent := 0
+	for _, p := range np.P {
+		if cfg.budget > 0 && sent == cfg.budget {
+			return
+		}
+		pp := np.Probes[p.UID()]
+		if now.Before(pp.Next) {
+			continue
+		}
+		sendLLDPPacket(np.N, p, ctx)
+		sent++
+		if pp.Fast > 0 {
+			pp.Fast--
+			pp.Next = now.Add(cfg.fastProbe)
+		} else {
+			pp.Next = cfg.nextProbe(now)
+		}
+		np.setProbe(p.UID(), pp)
+	}
+}
+
 type nodeJoinedHandler struct{}
 
 func (h *nodeJoinedHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
@@ -103,9 +180,19 @@ func (h *nodeLeftHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
 	n := nom.Node(msg.Data().(nom.NodeLeft))
 	d := ctx.Dict(nodeDict)
 	k := string(n.UID())
-	if _, err := d.Get(k); err != nil {
+	v, err := d.Get(k)
+	if err != nil {
 		return fmt.Errorf("%v is not joined", n)
 	}
+	// The links of the node, and the links of its peers towards the node, are
+	// gone with the node.
+	for _, l := range v.(nodePortsAndLinks).L {
+		ctx.Emit(nom.LinkDeleted(l))
+		ctx.Emit(delLinksTo{
+			Node: nom.NodeFromPortUID(l.To),
+			Port: l.From,
+		})
+	}
 	d.Del(k)
 	return nil
 }
@@ -116,7 +203,15 @@ func (h *nodeLeftHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
 	}
 }
 
-type portUpdateHandler struct{}
+// isPortUp returns whether the port can carry LLDP packets.
+func isPortUp(p nom.Port) bool {
+	return p.State != nom.PortStateDown && p.State != nom.PortStateBlocked &&
+		p.Config&nom.PortConfigDown == 0
+}
+
+type portUpdateHandler struct {
+	cfg config
+}
 
 func (h *portUpdateHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
 	p := nom.Port(msg.Data().(nom.PortUpdated))
@@ -130,12 +225,27 @@ func (h *portUpdateHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
 	}
 
 	np := v.(nodePortsAndLinks)
-	if np.hasPort(p) {
-		glog.Warningf("%v readded")
-		np.removePort(p)
+	if !isPortUp(p) {
+		if np.removePort(p) {
+			glog.V(2).Infof("%v is down", p)
+			removePortLinks(&np, p, ctx)
+		}
+		return d.Put(k, np)
+	}
+
+	if pp, ok := np.Probes[p.UID()]; ok && np.removePort(p) {
+		// The port was already up and only its attributes have changed.
+		np.P = append(np.P, p)
+		np.setProbe(p.UID(), pp)
+		return d.Put(k, np)
 	}
 
+	// Probe the port immediately and then using the fast-probe interval.
 	sendLLDPPacket(np.N, p, ctx)
+	np.setProbe(p.UID(), portProbe{
+		Next: time.Now().Add(h.cfg.fastProbe),
+		Fast: fastProbes - 1,
+	})
 
 	np.P = append(np.P, p)
 	return d.Put(k, np)
@@ -147,19 +257,111 @@ func (h *portUpdateHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
 	}
 }
 
+type portRemovedHandler struct{}
+
+func (h *portRemovedHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
+	p := nom.Port(msg.Data().(nom.PortRemoved))
+	d := ctx.Dict(nodeDict)
+	k := string(p.Node)
+	v, err := d.Get(k)
+	if err != nil {
+		return nil
+	}
+
+	np := v.(nodePortsAndLinks)
+	np.removePort(p)
+	removePortLinks(&np, p, ctx)
+	return d.Put(k, np)
+}
+
+func (h *portRemovedHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
+	return bh.MappedCells{
+		{nodeDict, string(msg.Data().(nom.PortRemoved).Node)},
+	}
+}
+
+// removePortLinks removes the outgoing links of port from np and emits
+// LinkDeleted for them. The incoming links of the port are stored in the
+// peer nodes, and are removed by emitting delLinksTo.
+func removePortLinks(np *nodePortsAndLinks, port nom.Port,
+	ctx bh.RcvContext) {
+
+	l, ok := np.linkFrom(port.UID())
+	if !ok {
+		return
+	}
+	np.removeLink(l)
+	ctx.Emit(nom.LinkDeleted(l))
+	ctx.Emit(delLinksTo{
+		Node: nom.NodeFromPortUID(l.To),
+		Port: port.UID(),
+	})
+}
+
+// delLinksTo is emitted to remove the links of Node towards Port.
+type delLinksTo struct {
+	Node nom.UID
+	Port nom.UID
+}
+
+type delLinksToHandler struct{}
+
+func (h *delLinksToHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
+	del := msg.Data().(delLinksTo)
+	d := ctx.Dict(nodeDict)
+	k := string(del.Node)
+	v, err := d.Get(k)
+	if err != nil {
+		return nil
+	}
+
+	np := v.(nodePortsAndLinks)
+	var links []nom.Link
+	for _, l := range np.L {
+		if l.To == del.Port {
+			links = append(links, l)
+		}
+	}
+	if len(links) == 0 {
+		return nil
+	}
+	for _, l := range links {
+		np.removeLink(l)
+		ctx.Emit(nom.LinkDeleted(l))
+	}
+	return d.Put(k, np)
+}
+
+func (h *delLinksToHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
+	return bh.MappedCells{{nodeDict, string(msg.Data().(delLinksTo).Node)}}
+}
+
 type lldpTimeout struct{}
 
-type timeoutHandler struct{}
+type timeoutHandler struct {
+	cfg config
+}
 
 func (h *timeoutHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
 	d := ctx.Dict(nodeDict)
+	now := time.Now()
+	before := now.Add(-h.cfg.linkTimeout())
+	updated := make(map[string]nodePortsAndLinks)
 	d.ForEach(func(k string, v interface{}) bool {
 		np := v.(nodePortsAndLinks)
-		for _, p := range np.P {
-			sendLLDPPacket(np.N, p, ctx)
+		for _, l := range np.expireLinks(before) {
+			glog.V(2).Infof("Link expired %v", l)
+			ctx.Emit(nom.LinkDeleted(l))
 		}
+		np.probePorts(now, h.cfg, ctx)
+		updated[k] = np
 		return true
 	})
+	for k, np := range updated {
+		if err := d.Put(k, np); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -227,9 +429,11 @@ func (h *newLinkHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
 	}
 	np := v.(nodePortsAndLinks)
 
+	np.stopFastProbe(l.From)
 	if oldl, ok := np.linkFrom(l.From); ok {
 		if oldl.UID() == l.UID() {
-			return nil
+			np.seeLink(l, time.Now())
+			return d.Put(k, np)
 		}
 		np.removeLink(oldl)
 		ctx.Emit(nom.LinkDeleted(oldl))
@@ -238,6 +442,7 @@ func (h *newLinkHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
 	glog.V(2).Infof("Link detected %v", l)
 	ctx.Emit(nom.LinkAdded(l))
 	np.L = append(np.L, l)
+	np.seeLink(l, time.Now())
 	return d.Put(k, np)
 }
 
@@ -247,19 +452,18 @@ func (h *newLinkHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
 }
 
 // RegisterDiscovery registers the handlers for topology discovery on the hive.
-func RegisterDiscovery(h bh.Hive) {
+func RegisterDiscovery(h bh.Hive, options ...Option) {
+	cfg := newConfig(options...)
 	a := h.NewApp("discovery")
 	a.Handle(nom.NodeJoined{}, &nodeJoinedHandler{})
 	a.Handle(nom.NodeLeft{}, &nodeLeftHandler{})
-	a.Handle(nom.PortUpdated{}, &portUpdateHandler{})
-	// TODO(soheil): Handle PortRemoved.
+	a.Handle(nom.PortUpdated{}, &portUpdateHandler{cfg: cfg})
+	a.Handle(nom.PortRemoved{}, &portRemovedHandler{})
+	a.Handle(delLinksTo{}, &delLinksToHandler{})
 	a.Handle(nom.PacketIn{}, &pktInHandler{})
 	a.Handle(NewLink{}, &newLinkHandler{})
-	a.Handle(lldpTimeout{}, &timeoutHandler{})
-	go func() {
-		for {
-			h.Emit(lldpTimeout{})
-			time.Sleep(60 * time.Second)
-		}
-	}()
+	a.Handle(lldpTimeout{}, &timeoutHandler{cfg: cfg})
+	a.Detached(bh.NewTimer(cfg.tick(), func() {
+		h.Emit(lldpTimeout{})
+	}))
 }
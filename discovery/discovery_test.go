@@ -0,0 +1,191 @@
+package discovery
+
+import (
+	"testing"
+	"time"
+
+	bh "github.com/kandoo/beehive"
+	"github.com/kandoo/beehive-netctrl/nom"
+)
+
+func TestLinkExpiry(t *testing.T) {
+	cfg := newConfig()
+	ctx := &bh.MockRcvContext{}
+	n := nom.Node{ID: "n1"}
+	fresh := nom.Link{ID: "n2$$1", From: "n1$$1", To: "n2$$1"}
+	stale := nom.Link{ID: "n3$$1", From: "n1$$2", To: "n3$$1"}
+	np := nodePortsAndLinks{
+		N: n,
+		L: []nom.Link{fresh, stale},
+	}
+	np.seeLink(fresh, time.Now())
+	np.seeLink(stale, time.Now().Add(-2*cfg.linkTimeout()))
+	ctx.Dict(nodeDict).Put(string(n.UID()), np)
+
+	h := &timeoutHandler{cfg: cfg}
+	if err := h.Rcv(&bh.MockMsg{MsgData: lldpTimeout{}}, ctx); err != nil {
+		t.Fatalf("error in timeout handler: %v", err)
+	}
+
+	if len(ctx.CtxMsgs) != 1 {
+		t.Fatalf("invalid number of emitted messages: actual=%d want=1",
+			len(ctx.CtxMsgs))
+	}
+	del, ok := ctx.CtxMsgs[0].Data().(nom.LinkDeleted)
+	if !ok || nom.Link(del) != stale {
+		t.Errorf("invalid message: actual=%v want=%v", ctx.CtxMsgs[0].Data(),
+			nom.LinkDeleted(stale))
+	}
+
+	v, _ := ctx.Dict(nodeDict).Get(string(n.UID()))
+	np = v.(nodePortsAndLinks)
+	if len(np.L) != 1 || np.L[0] != fresh {
+		t.Errorf("invalid links after expiry: actual=%v want=%v", np.L,
+			[]nom.Link{fresh})
+	}
+}
+
+func TestConfigDefaults(t *testing.T) {
+	c := newConfig(ProbeInterval(0), FastProbeInterval(-time.Second),
+		ProbeJitter(-time.Second), NodeProbeBudget(-1))
+	if c.interval != defaultInterval || c.fastProbe != defaultFastProbe {
+		t.Errorf("invalid intervals: actual=%v,%v want=%v,%v", c.interval,
+			c.fastProbe, defaultInterval, defaultFastProbe)
+	}
+	if c.jitter != 0 || c.budget != 0 {
+		t.Errorf("invalid jitter or budget: %v, %v", c.jitter, c.budget)
+	}
+	if c.tick() <= 0 {
+		t.Errorf("invalid tick: %v", c.tick())
+	}
+	c.nextProbe(time.Now())
+}
+
+func TestProbeBudget(t *testing.T) {
+	cfg := newConfig(NodeProbeBudget(2), ProbeJitter(0))
+	ctx := &bh.MockRcvContext{}
+	n := nom.Node{ID: "n1"}
+	np := nodePortsAndLinks{N: n}
+	for _, id := range []nom.PortID{"1", "2", "3"} {
+		np.P = append(np.P, nom.Port{ID: id, Node: n.UID()})
+	}
+	ctx.Dict(nodeDict).Put(string(n.UID()), np)
+
+	h := &timeoutHandler{cfg: cfg}
+	for i, want := range []int{2, 1, 0} {
+		ctx.CtxMsgs = nil
+		if err := h.Rcv(&bh.MockMsg{MsgData: lldpTimeout{}}, ctx); err != nil {
+			t.Fatalf("error in timeout handler: %v", err)
+		}
+		if len(ctx.CtxMsgs) != want {
+			t.Errorf("invalid number of probes in round %d: actual=%d want=%d", i,
+				len(ctx.CtxMsgs), want)
+		}
+	}
+}
+
+func testLinkedNodes() (ctx *bh.MockRcvContext, n1, n2 nom.Node, p1 nom.Port,
+	out, in nom.Link) {
+
+	ctx = &bh.MockRcvContext{}
+	n1 = nom.Node{ID: "n1"}
+	n2 = nom.Node{ID: "n2"}
+	p1 = nom.Port{ID: "1", Node: n1.UID(), State: nom.PortStateUp}
+	p2 := nom.Port{ID: "1", Node: n2.UID(), State: nom.PortStateUp}
+	out = nom.Link{ID: nom.LinkID(p2.UID()), From: p1.UID(), To: p2.UID()}
+	in = nom.Link{ID: nom.LinkID(p1.UID()), From: p2.UID(), To: p1.UID()}
+	ctx.Dict(nodeDict).Put(string(n1.UID()), nodePortsAndLinks{
+		N: n1,
+		P: []nom.Port{p1},
+		L: []nom.Link{out},
+	})
+	ctx.Dict(nodeDict).Put(string(n2.UID()), nodePortsAndLinks{
+		N: n2,
+		P: []nom.Port{p2},
+		L: []nom.Link{in},
+	})
+	return
+}
+
+func TestPortDown(t *testing.T) {
+	ctx, n1, _, p1, out, in := testLinkedNodes()
+
+	down := p1
+	down.State = nom.PortStateDown
+	h := &portUpdateHandler{cfg: newConfig()}
+	msg := &bh.MockMsg{MsgData: nom.PortUpdated(down)}
+	if err := h.Rcv(msg, ctx); err != nil {
+		t.Fatalf("error in port update handler: %v", err)
+	}
+	if len(ctx.CtxMsgs) != 2 {
+		t.Fatalf("invalid number of emitted messages: actual=%d want=2",
+			len(ctx.CtxMsgs))
+	}
+	d, ok := ctx.CtxMsgs[0].Data().(nom.LinkDeleted)
+	if !ok || nom.Link(d) != out {
+		t.Errorf("invalid message: actual=%v want=%v", ctx.CtxMsgs[0].Data(),
+			nom.LinkDeleted(out))
+	}
+	dh := &delLinksToHandler{}
+	if err := dh.Rcv(ctx.CtxMsgs[1], ctx); err != nil {
+		t.Fatalf("error in link deletion handler: %v", err)
+	}
+	d, ok = ctx.CtxMsgs[2].Data().(nom.LinkDeleted)
+	if !ok || nom.Link(d) != in {
+		t.Errorf("invalid message: actual=%v want=%v", ctx.CtxMsgs[2].Data(),
+			nom.LinkDeleted(in))
+	}
+
+	v, _ := ctx.Dict(nodeDict).Get(string(n1.UID()))
+	if np := v.(nodePortsAndLinks); len(np.P) != 0 || len(np.L) != 0 {
+		t.Errorf("port or links are not removed: %v", np)
+	}
+
+	ctx.CtxMsgs = nil
+	msg = &bh.MockMsg{MsgData: nom.PortUpdated(p1)}
+	if err := h.Rcv(msg, ctx); err != nil {
+		t.Fatalf("error in port update handler: %v", err)
+	}
+	if len(ctx.CtxMsgs) != 1 {
+		t.Fatalf("no probe sent when the port is up: %v", ctx.CtxMsgs)
+	}
+	if _, ok := ctx.CtxMsgs[0].Data().(nom.PacketOut); !ok {
+		t.Errorf("invalid message: actual=%v want=%v", ctx.CtxMsgs[0].Data(),
+			"packet out")
+	}
+}
+
+func TestNodeLeft(t *testing.T) {
+	ctx, n1, n2, _, out, in := testLinkedNodes()
+
+	h := &nodeLeftHandler{}
+	if err := h.Rcv(&bh.MockMsg{MsgData: nom.NodeLeft(n1)}, ctx); err != nil {
+		t.Fatalf("error in node left handler: %v", err)
+	}
+	if len(ctx.CtxMsgs) != 2 {
+		t.Fatalf("invalid number of emitted messages: actual=%d want=2",
+			len(ctx.CtxMsgs))
+	}
+	d, ok := ctx.CtxMsgs[0].Data().(nom.LinkDeleted)
+	if !ok || nom.Link(d) != out {
+		t.Errorf("invalid message: actual=%v want=%v", ctx.CtxMsgs[0].Data(),
+			nom.LinkDeleted(out))
+	}
+	if _, err := ctx.Dict(nodeDict).Get(string(n1.UID())); err == nil {
+		t.Errorf("%v is not removed", n1)
+	}
+
+	dh := &delLinksToHandler{}
+	if err := dh.Rcv(ctx.CtxMsgs[1], ctx); err != nil {
+		t.Fatalf("error in link deletion handler: %v", err)
+	}
+	d, ok = ctx.CtxMsgs[2].Data().(nom.LinkDeleted)
+	if !ok || nom.Link(d) != in {
+		t.Errorf("invalid message: actual=%v want=%v", ctx.CtxMsgs[2].Data(),
+			nom.LinkDeleted(in))
+	}
+	v, _ := ctx.Dict(nodeDict).Get(string(n2.UID()))
+	if np := v.(nodePortsAndLinks); len(np.L) != 0 {
+		t.Errorf("links towards %v are not removed: %v", n1, np)
+	}
+}
@@ -21,11 +21,13 @@ type GraphBuilderCentralized struct{}
 func (b GraphBuilderCentralized) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
 	dict := ctx.Dict(GraphDict)
 	var link nom.Link
+	del := false
 	switch dm := msg.Data().(type) {
 	case nom.LinkAdded:
 		link = nom.Link(dm)
 	case nom.LinkDeleted:
 		link = nom.Link(dm)
+		del = true
 	default:
 		return fmt.Errorf("GraphBuilderCentralized: unsupported message type %v",
 			msg.Type())
@@ -43,8 +45,28 @@ func (b GraphBuilderCentralized) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
 	if v, err := dict.Get(k); err == nil {
 		links = v.(map[nom.UID][]nom.Link)
 	}
-	links[nt.UID()] = append(links[nt.UID()], link)
-	return dict.Put(k, links)
+	// Remove the previous version of the link, if any.
+	id := link.UID()
+	tolinks := links[nt.UID()]
+	for i, l := range tolinks {
+		if l.UID() == id {
+			tolinks = append(tolinks[:i:i], tolinks[i+1:]...)
+			break
+		}
+	}
+	if !del {
+		tolinks = append(tolinks, link)
+	}
+
+	if len(tolinks) != 0 {
+		links[nt.UID()] = tolinks
+		return dict.Put(k, links)
+	}
+	delete(links, nt.UID())
+	if len(links) != 0 {
+		return dict.Put(k, links)
+	}
+	return dict.Del(k)
 }
 
 func (b GraphBuilderCentralized) Map(msg bh.Msg,
@@ -41,3 +41,36 @@ func TestGraphBuilderCentralizedSinglePath(t *testing.T) {
 		}
 	}
 }
+
+func TestGraphBuilderCentralizedDelete(t *testing.T) {
+	links := []nom.Link{
+		{From: "n1$$1", To: "n2$$1"},
+		{From: "n2$$1", To: "n1$$1"},
+		{From: "n2$$2", To: "n3$$1"},
+	}
+	b := GraphBuilderCentralized{}
+	ctx := &bh.MockRcvContext{}
+	for _, l := range links {
+		b.Rcv(&bh.MockMsg{MsgData: nom.LinkAdded(l)}, ctx)
+	}
+	// Adding the same link twice should not duplicate it.
+	b.Rcv(&bh.MockMsg{MsgData: nom.LinkAdded(links[0])}, ctx)
+	if l := LinksCentralized("n1", ctx); len(l) != 1 {
+		t.Errorf("invalid links of n1: actual=%v want=%v", l, links[:1])
+	}
+
+	b.Rcv(&bh.MockMsg{MsgData: nom.LinkDeleted(links[2])}, ctx)
+	if _, l := ShortestPathCentralized("n1", "n3", ctx); l != -1 {
+		t.Errorf("path found from n1 to n3 after deleting %v", links[2])
+	}
+	if l := LinksCentralized("n2", ctx); len(l) != 1 || l[0] != links[1] {
+		t.Errorf("invalid links of n2: actual=%v want=%v", l, links[1:2])
+	}
+
+	b.Rcv(&bh.MockMsg{MsgData: nom.LinkDeleted(links[0])}, ctx)
+	for _, n := range NodesCentralized(ctx) {
+		if n == "n1" {
+			t.Error("n1 has no links but is still in the graph")
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package discovery
+
+import (
+	"math/rand"
+	"time"
+)
+
+const (
+	defaultInterval  = 60 * time.Second
+	defaultJitter    = 5 * time.Second
+	defaultFastProbe = 1 * time.Second
+	defaultBudget    = 64
+
+	// fastProbes is the number of probes sent to a port that has just come up
+	// using the fast-probe interval.
+	fastProbes = 3
+	// maxMissedLLDPs is the number of LLDP rounds after which a link that is
+	// not seen is expired.
+	maxMissedLLDPs = 3
+)
+
+type config struct {
+	interval  time.Duration
+	jitter    time.Duration
+	fastProbe time.Duration
+	budget    int
+}
+
+func newConfig(options ...Option) config {
+	c := config{
+		interval:  defaultInterval,
+		jitter:    defaultJitter,
+		fastProbe: defaultFastProbe,
+		budget:    defaultBudget,
+	}
+	for _, opt := range options {
+		opt(&c)
+	}
+	if c.interval <= 0 {
+		c.interval = defaultInterval
+	}
+	if c.fastProbe <= 0 {
+		c.fastProbe = defaultFastProbe
+	}
+	if c.jitter < 0 {
+		c.jitter = 0
+	}
+	if c.budget < 0 {
+		c.budget = 0
+	}
+	return c
+}
+
+// tick returns the period of the probe timer.
+func (c config) tick() time.Duration {
+	if c.fastProbe < c.interval {
+		return c.fastProbe
+	}
+	return c.interval
+}
+
+// nextProbe returns the time of the next regular probe of a port probed at
+// now.
+func (c config) nextProbe(now time.Time) time.Time {
+	next := now.Add(c.interval)
+	if c.jitter > 0 {
+		next = next.Add(time.Duration(rand.Int63n(int64(c.jitter))))
+	}
+	return next
+}
+
+// linkTimeout returns the duration after which a link that is not seen is
+// expired.
+func (c config) linkTimeout() time.Duration {
+	return maxMissedLLDPs * (c.interval + c.jitter)
+}
+
+// Option represents a discovery option.
+type Option func(c *config)
+
+// ProbeInterval returns a discovery option that sets the interval between two
+// LLDP probes of a port. The default interval is used if d is not positive.
+func ProbeInterval(d time.Duration) Option {
+	return func(c *config) {
+		c.interval = d
+	}
+}
+
+// ProbeJitter returns a discovery option that sets the maximum random delay
+// added to the probe interval of each port, so that the ports of a node are
+// not probed at once. A non-positive jitter disables the random delay.
+func ProbeJitter(d time.Duration) Option {
+	return func(c *config) {
+		c.jitter = d
+	}
+}
+
+// FastProbeInterval returns a discovery option that sets the probe interval
+// of the ports that have just come up. The default interval is used if d is
+// not positive.
+func FastProbeInterval(d time.Duration) Option {
+	return func(c *config) {
+		c.fastProbe = d
+	}
+}
+
+// NodeProbeBudget returns a discovery option that sets the maximum number of
+// LLDP packets sent to a node in each tick of the probe timer. Ports that are
+// not probed because of the budget are probed in the next ticks. A budget of
+// zero or less means no limit.
+func NodeProbeBudget(n int) Option {
+	return func(c *config) {
+		c.budget = n
+	}
+}
@@ -0,0 +1,354 @@
+// Package firewall implements a stateless firewall using ordered access
+// control rules. The rules are installed on all the nodes as high priority
+// flow entries, where the first rule has the highest priority.
+//
+// Every rule is installed, so that each rule has a hit counter on every node.
+// A flow entry cannot pass packets to the lower priority entries of the same
+// table. As a result, denied packets are dropped in the nodes, whereas the
+// packets matching an allow rule are processed by the normal pipeline of the
+// node. Nodes that do not support the normal pipeline reject such flows, and
+// the firewall falls back to sending the allowed packets to the controller,
+// where they are forwarded by the forwarding applications.
+//
+// The counters of the rules are collected from the flow statistics of the
+// nodes, and are reset when the rules are updated or the node leaves.
+package firewall
+
+import (
+	"encoding/gob"
+	"fmt"
+
+	bh "github.com/kandoo/beehive"
+	"github.com/kandoo/beehive-netctrl/nom"
+	"github.com/kandoo/beehive/Godeps/_workspace/src/github.com/golang/glog"
+)
+
+const (
+	centralizedD = "D"
+	centralizedK = "0"
+
+	ruleDict     = "RuleDict"
+	nodeDict     = "NodeDict"
+	statsDict    = "StatsDict"
+	fallbackDict = "FallbackDict"
+
+	rulesKey = "rules"
+
+	// MaxPriority is the priority of the flows of the first rule. The flows of
+	// rule i have a priority of MaxPriority-i.
+	MaxPriority = 0xFF00
+	// MaxRules is the maximum number of rules.
+	MaxRules = 0x1000
+)
+
+var centralizedMap = bh.MappedCells{{Dict: centralizedD, Key: centralizedK}}
+
+// RegisterFirewall registers the firewall on the hive with the given initial
+// rules. The rules can be replaced using an UpdateACL message.
+func RegisterFirewall(h bh.Hive, rules []nom.ACLRule) error {
+	if err := validateRules(rules); err != nil {
+		return err
+	}
+
+	app := h.NewApp("Firewall")
+	app.Handle(nom.NodeJoined{}, nodeHandler{initial: rules})
+	app.Handle(nom.NodeLeft{}, nodeHandler{initial: rules})
+	app.Handle(nom.UpdateACL{}, updateHandler{initial: rules})
+	app.Handle(nom.FlowStatsQueryResult{}, statsHandler{initial: rules})
+	app.Handle(nom.ACLStatsQuery{}, queryHandler{initial: rules})
+	app.Handle(nom.FlowEntryAdded{}, flowHandler{initial: rules})
+	app.Handle(nom.FlowEntryFailed{}, flowHandler{initial: rules})
+	app.Handle(nom.FlowEntryDeleted{}, flowHandler{initial: rules})
+	return nil
+}
+
+func validateRules(rules []nom.ACLRule) error {
+	if len(rules) > MaxRules {
+		return fmt.Errorf("firewall: too many rules (%d > %d)", len(rules),
+			MaxRules)
+	}
+	for i, r := range rules {
+		if err := r.Match.Validate(); err != nil {
+			return fmt.Errorf("firewall: invalid rule %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+// currentRules returns the rules stored in the dictionary, or the initial rules
+// if no UpdateACL is received yet.
+func currentRules(initial []nom.ACLRule, ctx bh.RcvContext) []nom.ACLRule {
+	if v, err := ctx.Dict(ruleDict).Get(rulesKey); err == nil {
+		return v.([]nom.ACLRule)
+	}
+	return initial
+}
+
+func rulePriority(i int) uint16 {
+	return uint16(MaxPriority - i)
+}
+
+// ruleFlows returns the flow entries of the rules on node. If normal is true,
+// the packets of the allow rules are processed by the normal pipeline of the
+// node. Otherwise, they are sent to the controller.
+func ruleFlows(node nom.UID, rules []nom.ACLRule,
+	normal bool) []nom.FlowEntry {
+
+	var flows []nom.FlowEntry
+	for i, r := range rules {
+		var actions []nom.Action
+		switch r.Action {
+		case nom.ACLDeny:
+			actions = []nom.Action{nom.ActionDrop{}}
+		case nom.ACLAllow:
+			if normal {
+				actions = []nom.Action{nom.ActionNormal{}}
+			} else {
+				actions = []nom.Action{nom.ActionSendToController{}}
+			}
+		}
+		flows = append(flows, nom.FlowEntry{
+			Node:     node,
+			Match:    r.Match.Normalize(),
+			Actions:  actions,
+			Priority: rulePriority(i),
+		})
+	}
+	return flows
+}
+
+func hasFlow(flows []nom.FlowEntry, f nom.FlowEntry) bool {
+	for _, thatf := range flows {
+		if thatf.Priority == f.Priority && thatf.Match.Equals(f.Match) &&
+			sameActions(thatf.Actions, f.Actions) {
+
+			return true
+		}
+	}
+	return false
+}
+
+func sameActions(a1, a2 []nom.Action) bool {
+	if len(a1) != len(a2) {
+		return false
+	}
+	for i := range a1 {
+		if !a1[i].Equals(a2[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// hasNormal returns whether the node supports the normal pipeline, i.e., it
+// has not rejected a flow entry that uses the normal pipeline.
+func hasNormal(node nom.UID, ctx bh.RcvContext) bool {
+	_, err := ctx.Dict(fallbackDict).Get(string(node))
+	return err != nil
+}
+
+// installRules emits the flow entries in newRules that are not in oldRules,
+// and deletes the flow entries in oldRules that are not in newRules.
+func installRules(node nom.UID, oldRules, newRules []nom.ACLRule,
+	ctx bh.RcvContext) {
+
+	normal := hasNormal(node, ctx)
+	installFlows(ruleFlows(node, oldRules, normal),
+		ruleFlows(node, newRules, normal), ctx)
+}
+
+// installFlows emits the flow entries in newFlows that are not in oldFlows,
+// and deletes the flow entries in oldFlows that are not in newFlows.
+func installFlows(oldFlows, newFlows []nom.FlowEntry, ctx bh.RcvContext) {
+	for _, f := range oldFlows {
+		if hasFlow(newFlows, f) {
+			continue
+		}
+		ctx.Emit(nom.DelFlowEntry{
+			Node:     f.Node,
+			Match:    f.Match,
+			Priority: f.Priority,
+			Exact:    true,
+		})
+	}
+	for _, f := range newFlows {
+		if hasFlow(oldFlows, f) {
+			continue
+		}
+		ctx.Emit(nom.AddFlowEntry{
+			Subscriber: bh.AppCellKey{
+				App:  ctx.App(),
+				Dict: centralizedD,
+				Key:  centralizedK,
+			},
+			Flow: f,
+		})
+	}
+}
+
+type nodeHandler struct {
+	initial []nom.ACLRule
+}
+
+func (h nodeHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
+	switch n := msg.Data().(type) {
+	case nom.NodeJoined:
+		id := nom.Node(n).UID()
+		// The node may have kept the flows of a previous connection, that are
+		// replaced.
+		installRules(id, nil, currentRules(h.initial, ctx), ctx)
+		return ctx.Dict(nodeDict).Put(string(id), true)
+	case nom.NodeLeft:
+		id := string(nom.Node(n).UID())
+		ctx.Dict(statsDict).Del(id)
+		ctx.Dict(fallbackDict).Del(id)
+		return ctx.Dict(nodeDict).Del(id)
+	}
+	return nil
+}
+
+func (h nodeHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
+	return centralizedMap
+}
+
+type updateHandler struct {
+	initial []nom.ACLRule
+}
+
+func (h updateHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
+	rules := msg.Data().(nom.UpdateACL).Rules
+	if err := validateRules(rules); err != nil {
+		return err
+	}
+
+	old := currentRules(h.initial, ctx)
+	ctx.Dict(nodeDict).ForEach(func(k string, v interface{}) bool {
+		installRules(nom.UID(k), old, rules, ctx)
+		return true
+	})
+	glog.V(2).Infof("firewall rules are updated: %v", rules)
+
+	// Counters of the previous rules are no longer valid.
+	var nodes []string
+	ctx.Dict(statsDict).ForEach(func(k string, v interface{}) bool {
+		nodes = append(nodes, k)
+		return true
+	})
+	for _, n := range nodes {
+		ctx.Dict(statsDict).Del(n)
+	}
+	return ctx.Dict(ruleDict).Put(rulesKey, rules)
+}
+
+func (h updateHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
+	return centralizedMap
+}
+
+// flowHandler falls back to sending the packets of allow rules to the
+// controller on the nodes that reject the normal pipeline.
+type flowHandler struct {
+	initial []nom.ACLRule
+}
+
+func (h flowHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
+	failed, ok := msg.Data().(nom.FlowEntryFailed)
+	if !ok || !hasAction(failed.Flow.Actions, nom.ActionNormal{}) {
+		return nil
+	}
+	node := failed.Flow.Node
+	if _, err := ctx.Dict(nodeDict).Get(string(node)); err != nil ||
+		!hasNormal(node, ctx) {
+
+		return nil
+	}
+
+	glog.Warningf("firewall: %v rejects the normal pipeline (%v), allowed "+
+		"packets are sent to the controller", node, failed.Err)
+	if err := ctx.Dict(fallbackDict).Put(string(node), true); err != nil {
+		return err
+	}
+	rules := currentRules(h.initial, ctx)
+	installFlows(ruleFlows(node, rules, true), ruleFlows(node, rules, false),
+		ctx)
+	return nil
+}
+
+func (h flowHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
+	return centralizedMap
+}
+
+func hasAction(actions []nom.Action, a nom.Action) bool {
+	for _, thata := range actions {
+		if thata.Equals(a) {
+			return true
+		}
+	}
+	return false
+}
+
+// ruleCounter is the counter of a rule on a node.
+type ruleCounter struct {
+	Packets uint64
+	Bytes   uint64
+}
+
+type statsHandler struct {
+	initial []nom.ACLRule
+}
+
+func (h statsHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
+	res := msg.Data().(nom.FlowStatsQueryResult)
+	if _, err := ctx.Dict(nodeDict).Get(string(res.Node)); err != nil {
+		return nil
+	}
+
+	// The result may not have the flows of all the rules, and the counters of
+	// the missing rules are kept.
+	rules := currentRules(h.initial, ctx)
+	counters := make(map[int]ruleCounter)
+	if v, err := ctx.Dict(statsDict).Get(string(res.Node)); err == nil {
+		counters = v.(map[int]ruleCounter)
+	}
+	for _, stat := range res.Stats {
+		i := MaxPriority - int(stat.Priority)
+		if i < 0 || i >= len(rules) || !rules[i].Match.Equals(stat.Match) {
+			continue
+		}
+		counters[i] = ruleCounter{Packets: stat.Packets, Bytes: stat.Bytes}
+	}
+	return ctx.Dict(statsDict).Put(string(res.Node), counters)
+}
+
+func (h statsHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
+	return centralizedMap
+}
+
+type queryHandler struct {
+	initial []nom.ACLRule
+}
+
+func (h queryHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
+	rules := currentRules(h.initial, ctx)
+	res := nom.ACLStatsQueryResult{Stats: make([]nom.ACLRuleStats, len(rules))}
+	for i, r := range rules {
+		res.Stats[i].Rule = r
+	}
+	ctx.Dict(statsDict).ForEach(func(k string, v interface{}) bool {
+		for i, c := range v.(map[int]ruleCounter) {
+			res.Stats[i].Packets += c.Packets
+			res.Stats[i].Bytes += c.Bytes
+		}
+		return true
+	})
+	return ctx.Reply(msg, res)
+}
+
+func (h queryHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
+	return centralizedMap
+}
+
+func init() {
+	gob.Register([]nom.ACLRule{})
+	gob.Register(map[int]ruleCounter{})
+	gob.Register(ruleCounter{})
+}
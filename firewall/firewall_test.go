@@ -0,0 +1,221 @@
+package firewall
+
+import (
+	"testing"
+
+	bh "github.com/kandoo/beehive"
+	"github.com/kandoo/beehive-netctrl/nom"
+)
+
+func testRules(t *testing.T) []nom.ACLRule {
+	cfgs := []RuleConfig{
+		{Action: "allow", IPProto: 6, IPv4Src: "10.0.0.0/8", DstPort: 22},
+		{Action: "deny", IPProto: 6, DstPort: 22},
+		{Action: "allow", IPProto: 6, DstPort: 80},
+	}
+	var rules []nom.ACLRule
+	for _, c := range cfgs {
+		r, err := c.Rule()
+		if err != nil {
+			t.Fatalf("cannot parse rule %+v: %v", c, err)
+		}
+		rules = append(rules, r)
+	}
+	return rules
+}
+
+func flowMsgs(ctx *bh.MockRcvContext) (adds []nom.AddFlowEntry,
+	dels []nom.DelFlowEntry) {
+
+	for _, m := range ctx.CtxMsgs {
+		switch d := m.Data().(type) {
+		case nom.AddFlowEntry:
+			adds = append(adds, d)
+		case nom.DelFlowEntry:
+			dels = append(dels, d)
+		}
+	}
+	return adds, dels
+}
+
+func TestRuleConfig(t *testing.T) {
+	r, err := RuleConfig{Action: "deny", IPv4Dst: "10.0.0.0/8"}.Rule()
+	if err != nil {
+		t.Fatalf("cannot parse rule: %v", err)
+	}
+	if _, ok := r.Match.EthType(); !ok || r.Action != nom.ACLDeny {
+		t.Errorf("invalid rule: %v", r)
+	}
+
+	invalid := []RuleConfig{
+		{Action: "reject"},
+		{Action: "deny", DstPort: 22},
+		{Action: "deny", IPv4Src: "10.0.0.0"},
+		{Action: "allow", EthSrc: "00:11"},
+	}
+	for _, c := range invalid {
+		if _, err := c.Rule(); err == nil {
+			t.Errorf("invalid rule is parsed: %+v", c)
+		}
+	}
+}
+
+func TestRuleFlows(t *testing.T) {
+	flows := ruleFlows("n1", testRules(t), true)
+	if len(flows) != 3 {
+		t.Fatalf("invalid number of flows: actual=%d want=3", len(flows))
+	}
+	if flows[0].Priority != MaxPriority ||
+		!flows[0].Actions[0].Equals(nom.ActionNormal{}) {
+
+		t.Errorf("invalid flow for the allow rule: %v", flows[0])
+	}
+	if flows[1].Priority != MaxPriority-1 ||
+		!flows[1].Actions[0].Equals(nom.ActionDrop{}) {
+
+		t.Errorf("invalid flow for the deny rule: %v", flows[1])
+	}
+	if flows[2].Priority != MaxPriority-2 ||
+		!flows[2].Actions[0].Equals(nom.ActionNormal{}) {
+
+		t.Errorf("invalid flow for the allow rule: %v", flows[2])
+	}
+
+	flows = ruleFlows("n1", testRules(t), false)
+	for _, i := range []int{0, 2} {
+		if !flows[i].Actions[0].Equals(nom.ActionSendToController{}) {
+			t.Errorf("invalid fallback flow for the allow rule: %v", flows[i])
+		}
+	}
+}
+
+func TestNormalFallback(t *testing.T) {
+	ctx := &bh.MockRcvContext{}
+	rules := testRules(t)
+	joined := nom.NodeJoined{ID: "n1"}
+	nodeHandler{initial: rules}.Rcv(&bh.MockMsg{MsgData: joined}, ctx)
+	adds, _ := flowMsgs(ctx)
+	if len(adds) != 3 || adds[0].Subscriber.Dict != centralizedD {
+		t.Fatalf("invalid flows: %v", adds)
+	}
+
+	ctx.CtxMsgs = nil
+	failed := nom.FlowEntryFailed{
+		Flow: adds[0].Flow,
+		Err:  nom.DriverError{Code: nom.DriverErrBadAction},
+	}
+	h := flowHandler{initial: rules}
+	if err := h.Rcv(&bh.MockMsg{MsgData: failed}, ctx); err != nil {
+		t.Fatalf("error in flow handler: %v", err)
+	}
+	adds, dels := flowMsgs(ctx)
+	if len(adds) != 2 || len(dels) != 2 {
+		t.Fatalf("invalid fallback: adds=%v dels=%v", adds, dels)
+	}
+	for _, add := range adds {
+		if !add.Flow.Actions[0].Equals(nom.ActionSendToController{}) {
+			t.Errorf("invalid fallback flow: %v", add.Flow)
+		}
+	}
+
+	// The node is already using the fallback.
+	ctx.CtxMsgs = nil
+	h.Rcv(&bh.MockMsg{MsgData: failed}, ctx)
+	if adds, dels = flowMsgs(ctx); len(adds) != 0 || len(dels) != 0 {
+		t.Errorf("fallback is installed twice: adds=%v dels=%v", adds, dels)
+	}
+}
+
+func TestNodeJoinedAndUpdate(t *testing.T) {
+	ctx := &bh.MockRcvContext{}
+	rules := testRules(t)
+	joined := nom.NodeJoined{ID: "n1"}
+	nodeHandler{initial: rules}.Rcv(&bh.MockMsg{MsgData: joined}, ctx)
+	adds, _ := flowMsgs(ctx)
+	if len(adds) != 3 {
+		t.Fatalf("invalid number of flows: actual=%d want=3", len(adds))
+	}
+
+	ctx.CtxMsgs = nil
+	upd := nom.UpdateACL{Rules: rules[1:2]}
+	if err := (updateHandler{initial: rules}).Rcv(&bh.MockMsg{MsgData: upd},
+		ctx); err != nil {
+
+		t.Fatalf("cannot update rules: %v", err)
+	}
+	adds, dels := flowMsgs(ctx)
+	if len(dels) != 3 || len(adds) != 1 {
+		t.Fatalf("invalid flow updates: adds=%v dels=%v", adds, dels)
+	}
+	if adds[0].Flow.Priority != MaxPriority {
+		t.Errorf("invalid priority: actual=%v want=%v", adds[0].Flow.Priority,
+			MaxPriority)
+	}
+
+	ctx.CtxMsgs = nil
+	nodeHandler{initial: rules}.Rcv(&bh.MockMsg{MsgData: joined}, ctx)
+	if adds, _ = flowMsgs(ctx); len(adds) != 1 {
+		t.Errorf("updated rules are not installed on a joined node: %v", adds)
+	}
+}
+
+func TestRuleCounters(t *testing.T) {
+	ctx := &bh.MockRcvContext{}
+	rules := testRules(t)
+	for _, n := range []nom.NodeID{"n1", "n2"} {
+		nodeHandler{initial: rules}.Rcv(&bh.MockMsg{
+			MsgData: nom.NodeJoined{ID: n},
+		}, ctx)
+		res := nom.FlowStatsQueryResult{
+			Node: n.UID(),
+			Stats: []nom.FlowStats{
+				{Match: rules[1].Match, Priority: MaxPriority - 1, Packets: 2,
+					Bytes: 100},
+				{Match: nom.Match{}, Priority: 0, Packets: 10, Bytes: 1000},
+			},
+		}
+		statsHandler{initial: rules}.Rcv(&bh.MockMsg{MsgData: res}, ctx)
+	}
+	// A result without the flow of a rule keeps its counters.
+	res := nom.FlowStatsQueryResult{
+		Node: "n1",
+		Stats: []nom.FlowStats{
+			{Match: rules[0].Match, Priority: MaxPriority, Packets: 1, Bytes: 50},
+		},
+	}
+	statsHandler{initial: rules}.Rcv(&bh.MockMsg{MsgData: res}, ctx)
+
+	ctx.CtxMsgs = nil
+	q := &bh.MockMsg{MsgData: nom.ACLStatsQuery{}, MsgFrom: 1}
+	if err := (queryHandler{initial: rules}).Rcv(q, ctx); err != nil {
+		t.Fatalf("error in query handler: %v", err)
+	}
+	acl := ctx.CtxMsgs[0].Data().(nom.ACLStatsQueryResult)
+	if len(acl.Stats) != len(rules) {
+		t.Fatalf("invalid number of stats: actual=%d want=%d", len(acl.Stats),
+			len(rules))
+	}
+	if s := acl.Stats[1]; s.Packets != 4 || s.Bytes != 200 {
+		t.Errorf("invalid counters for %v: packets=%v bytes=%v", s.Rule,
+			s.Packets, s.Bytes)
+	}
+	if s := acl.Stats[0]; s.Packets != 1 || s.Bytes != 50 {
+		t.Errorf("invalid counters for %v: packets=%v bytes=%v", s.Rule,
+			s.Packets, s.Bytes)
+	}
+	if s := acl.Stats[2]; s.Packets != 0 {
+		t.Errorf("invalid counters for %v: packets=%v", s.Rule, s.Packets)
+	}
+
+	upd := nom.UpdateACL{Rules: rules}
+	(updateHandler{initial: rules}).Rcv(&bh.MockMsg{MsgData: upd}, ctx)
+	ctx.CtxMsgs = nil
+	(queryHandler{initial: rules}).Rcv(q, ctx)
+	acl = ctx.CtxMsgs[0].Data().(nom.ACLStatsQueryResult)
+	for _, s := range acl.Stats {
+		if s.Packets != 0 || s.Bytes != 0 {
+			t.Errorf("counters are not reset for %v: packets=%v bytes=%v", s.Rule,
+				s.Packets, s.Bytes)
+		}
+	}
+}
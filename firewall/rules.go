@@ -0,0 +1,141 @@
+package firewall
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+
+	"github.com/kandoo/beehive-netctrl/nom"
+)
+
+// RuleConfig is the JSON representation of an access control rule. Zero
+// values match all packets. For example, the following rules only allow SSH
+// from 10.0.0.0/8:
+//
+//	[
+//		{"Action": "allow", "IPProto": 6, "IPv4Src": "10.0.0.0/8", "DstPort": 22},
+//		{"Action": "deny", "IPProto": 6, "DstPort": 22}
+//	]
+type RuleConfig struct {
+	Action  string // Either "allow" or "deny".
+	InPort  string // UID of the incoming port.
+	EthSrc  string // Source hardware address.
+	EthDst  string // Destination hardware address.
+	EthType uint16 // Ethernet type. Set to IPv4 if an IPv4 field is used.
+	VLAN    uint16 // VLAN ID.
+	IPProto uint8  // IP protocol. Required for SrcPort and DstPort.
+	IPv4Src string // Source IPv4 prefix in CIDR notation.
+	IPv4Dst string // Destination IPv4 prefix in CIDR notation.
+	SrcPort uint16 // Source transport port.
+	DstPort uint16 // Destination transport port.
+}
+
+// LoadRules loads the access control rules from a JSON file, that contains a
+// list of RuleConfig.
+func LoadRules(path string) ([]nom.ACLRule, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cfgs []RuleConfig
+	if err := json.Unmarshal(b, &cfgs); err != nil {
+		return nil, fmt.Errorf("firewall: invalid rules in %v: %v", path, err)
+	}
+	rules := make([]nom.ACLRule, 0, len(cfgs))
+	for i, c := range cfgs {
+		r, err := c.Rule()
+		if err != nil {
+			return nil, fmt.Errorf("firewall: invalid rule %d: %v", i, err)
+		}
+		rules = append(rules, r)
+	}
+	return rules, nil
+}
+
+// Rule returns the access control rule of the configuration.
+func (c RuleConfig) Rule() (nom.ACLRule, error) {
+	var r nom.ACLRule
+	switch c.Action {
+	case "allow":
+		r.Action = nom.ACLAllow
+	case "deny":
+		r.Action = nom.ACLDeny
+	default:
+		return r, fmt.Errorf("invalid action %q", c.Action)
+	}
+
+	m := &r.Match
+	if c.InPort != "" {
+		m.AddField(nom.InPort(c.InPort))
+	}
+	if c.EthSrc != "" {
+		mac, err := parseMAC(c.EthSrc)
+		if err != nil {
+			return r, err
+		}
+		m.AddField(nom.EthSrc{Addr: mac, Mask: nom.MaskNoneMAC})
+	}
+	if c.EthDst != "" {
+		mac, err := parseMAC(c.EthDst)
+		if err != nil {
+			return r, err
+		}
+		m.AddField(nom.EthDst{Addr: mac, Mask: nom.MaskNoneMAC})
+	}
+	ethType := nom.EthType(c.EthType)
+	if ethType == 0 && (c.IPProto != 0 || c.IPv4Src != "" || c.IPv4Dst != "") {
+		ethType = nom.EthTypeIPv4
+	}
+	if ethType != 0 {
+		m.AddField(ethType)
+	}
+	if c.VLAN != 0 {
+		m.AddField(nom.VLANID(c.VLAN))
+	}
+	if c.IPProto != 0 {
+		m.AddField(nom.IPProto(c.IPProto))
+	}
+	if c.IPv4Src != "" {
+		p, err := parsePrefix(c.IPv4Src)
+		if err != nil {
+			return r, err
+		}
+		m.AddField(nom.IPv4Src(p))
+	}
+	if c.IPv4Dst != "" {
+		p, err := parsePrefix(c.IPv4Dst)
+		if err != nil {
+			return r, err
+		}
+		m.AddField(nom.IPv4Dst(p))
+	}
+	if c.SrcPort != 0 {
+		m.AddField(nom.TransportPortSrc(c.SrcPort))
+	}
+	if c.DstPort != 0 {
+		m.AddField(nom.TransportPortDst(c.DstPort))
+	}
+	return r, m.Validate()
+}
+
+func parseMAC(s string) (nom.MACAddr, error) {
+	var mac nom.MACAddr
+	hw, err := net.ParseMAC(s)
+	if err != nil || len(hw) != len(mac) {
+		return mac, fmt.Errorf("invalid MAC address %q", s)
+	}
+	copy(mac[:], hw)
+	return mac, nil
+}
+
+func parsePrefix(s string) (nom.MaskedIPv4Addr, error) {
+	var p nom.MaskedIPv4Addr
+	_, n, err := net.ParseCIDR(s)
+	if err != nil || n.IP.To4() == nil {
+		return p, fmt.Errorf("invalid IPv4 prefix %q", s)
+	}
+	copy(p.Addr[:], n.IP.To4())
+	copy(p.Mask[:], n.Mask)
+	return p, nil
+}
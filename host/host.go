@@ -0,0 +1,322 @@
+// Package host tracks the end hosts attached to the network. Hosts are learned
+// from the packets received on the ports that are not connected to other
+// nodes.
+//
+// Once forwarding flows are installed for a host, its packets no longer reach
+// the controller. Hosts are therefore also refreshed by the port statistics
+// polled by the controller: the hosts on a port are considered seen whenever
+// the port has received new packets. As a result, a host that has left a port
+// shared with other active hosts, e.g., behind a hub, is not removed until it
+// is seen elsewhere or the port goes down.
+package host
+
+import (
+	"time"
+
+	bh "github.com/kandoo/beehive"
+	"github.com/kandoo/beehive-netctrl/nom"
+	"github.com/kandoo/beehive/Godeps/_workspace/src/github.com/golang/glog"
+)
+
+const (
+	centralizedD = "D"
+	centralizedK = "0"
+
+	hostDict = "HostDict"
+	linkDict = "LinkDict"
+	rxDict   = "RxDict"
+
+	defaultTimeout = 5 * time.Minute
+)
+
+var centralizedMap = bh.MappedCells{{Dict: centralizedD, Key: centralizedK}}
+
+type config struct {
+	timeout time.Duration
+}
+
+// Option represents a host tracker option.
+type Option func(c *config)
+
+// HostTimeout returns a host tracker option that sets the duration after
+// which a host that is not seen is removed. The default timeout is used if d
+// is not positive.
+func HostTimeout(d time.Duration) Option {
+	return func(c *config) {
+		c.timeout = d
+	}
+}
+
+// RegisterHostTracker registers the host tracker on the hive.
+func RegisterHostTracker(h bh.Hive, options ...Option) {
+	cfg := config{timeout: defaultTimeout}
+	for _, opt := range options {
+		opt(&cfg)
+	}
+	if cfg.timeout <= 0 {
+		cfg.timeout = defaultTimeout
+	}
+
+	app := h.NewApp("HostTracker")
+	app.Handle(nom.PacketIn{}, pktInHandler{})
+	app.Handle(nom.LinkAdded{}, linkHandler{})
+	app.Handle(nom.LinkDeleted{}, linkHandler{})
+	app.Handle(nom.PortUpdated{}, portHandler{})
+	app.Handle(nom.PortRemoved{}, portHandler{})
+	app.Handle(nom.NodeLeft{}, nodeLeftHandler{})
+	app.Handle(nom.PortStatsQueryResult{}, statsHandler{})
+	app.Handle(nom.HostQuery{}, queryHandler{})
+	app.Handle(ageHosts{}, ageHandler{timeout: cfg.timeout})
+	app.Detached(bh.NewTimer(cfg.timeout/2, func() {
+		h.Emit(ageHosts{})
+	}))
+}
+
+type pktInHandler struct{}
+
+func (h pktInHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
+	in := msg.Data().(nom.PacketIn)
+	if len(in.Packet) < 14 || in.Packet.DstMAC().IsLLDP() {
+		return nil
+	}
+	if _, err := ctx.Dict(linkDict).Get(string(in.InPort)); err == nil {
+		// Packets received on inter-switch ports are not from hosts.
+		return nil
+	}
+
+	now := time.Now()
+	if src := in.Packet.SrcMAC(); isUnicast(src) {
+		vlan, _, _ := in.Packet.VLAN()
+		v4, v6 := senderAddrs(in.Packet)
+		if err := seeHost(src, vlan, in.InPort, v4, v6, now, ctx); err != nil {
+			return err
+		}
+	}
+
+	mac, ip, ok := dhcpLease(in.Packet)
+	if !ok {
+		return nil
+	}
+	d := ctx.Dict(hostDict)
+	k := string(nom.Host{MACAddr: mac}.UID())
+	v, err := d.Get(k)
+	if err != nil {
+		return nil
+	}
+	host := v.(nom.Host)
+	if !addAddrs(&host, []nom.IPv4Addr{ip}, nil) {
+		return nil
+	}
+	glog.V(2).Infof("%v is leased %v", host, ip)
+	return d.Put(k, host)
+}
+
+func (h pktInHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
+	return centralizedMap
+}
+
+// seeHost updates the host that is seen on port, and emits HostJoined or
+// HostMoved if the host is new or has moved.
+func seeHost(mac nom.MACAddr, vlan nom.VLANID, port nom.UID,
+	v4 []nom.IPv4Addr, v6 []nom.IPv6Addr, now time.Time,
+	ctx bh.RcvContext) error {
+
+	d := ctx.Dict(hostDict)
+	host := nom.Host{MACAddr: mac}
+	k := string(host.UID())
+	v, err := d.Get(k)
+	joined := err != nil
+	if !joined {
+		host = v.(nom.Host)
+	}
+
+	prev := host.Port
+	host.Port = port
+	host.VLAN = vlan
+	host.LastSeen = now
+	addAddrs(&host, v4, v6)
+
+	switch {
+	case joined:
+		glog.V(2).Infof("%v joined", host)
+		ctx.Emit(nom.HostJoined(host))
+	case prev != port:
+		glog.V(2).Infof("%v moved from %v", host, prev)
+		ctx.Emit(nom.HostMoved{Host: host, From: prev})
+	}
+	return d.Put(k, host)
+}
+
+// removeHosts removes the hosts for which remove returns true, and emits
+// HostLeft for them.
+func removeHosts(remove func(h nom.Host) bool, ctx bh.RcvContext) error {
+	d := ctx.Dict(hostDict)
+	var left []nom.Host
+	d.ForEach(func(k string, v interface{}) bool {
+		if h := v.(nom.Host); remove(h) {
+			left = append(left, h)
+		}
+		return true
+	})
+	for _, h := range left {
+		if err := d.Del(string(h.UID())); err != nil {
+			return err
+		}
+		glog.V(2).Infof("%v left", h)
+		ctx.Emit(nom.HostLeft(h))
+	}
+	return nil
+}
+
+type linkHandler struct{}
+
+func (h linkHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
+	d := ctx.Dict(linkDict)
+	switch l := msg.Data().(type) {
+	case nom.LinkAdded:
+		if err := d.Put(string(l.From), true); err != nil {
+			return err
+		}
+		// Hosts cannot be attached to an inter-switch port.
+		return removeHosts(func(h nom.Host) bool {
+			return h.Port == l.From
+		}, ctx)
+	case nom.LinkDeleted:
+		d.Del(string(l.From))
+	}
+	return nil
+}
+
+func (h linkHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
+	return centralizedMap
+}
+
+type portHandler struct{}
+
+func (h portHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
+	var port nom.Port
+	switch p := msg.Data().(type) {
+	case nom.PortUpdated:
+		port = nom.Port(p)
+		if port.State != nom.PortStateDown &&
+			port.Config&nom.PortConfigDown == 0 {
+
+			return nil
+		}
+	case nom.PortRemoved:
+		port = nom.Port(p)
+		ctx.Dict(rxDict).Del(string(port.UID()))
+	}
+	id := port.UID()
+	return removeHosts(func(h nom.Host) bool {
+		return h.Port == id
+	}, ctx)
+}
+
+func (h portHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
+	return centralizedMap
+}
+
+type nodeLeftHandler struct{}
+
+func (h nodeLeftHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
+	n := nom.Node(msg.Data().(nom.NodeLeft)).UID()
+	d := ctx.Dict(rxDict)
+	var ports []string
+	d.ForEach(func(k string, v interface{}) bool {
+		if nom.NodeFromPortUID(nom.UID(k)) == n {
+			ports = append(ports, k)
+		}
+		return true
+	})
+	for _, k := range ports {
+		d.Del(k)
+	}
+	return removeHosts(func(h nom.Host) bool {
+		return nom.NodeFromPortUID(h.Port) == n
+	}, ctx)
+}
+
+func (h nodeLeftHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
+	return centralizedMap
+}
+
+// statsHandler refreshes the hosts on the ports that have received packets
+// since the last port statistics of their node.
+type statsHandler struct{}
+
+func (h statsHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
+	res := msg.Data().(nom.PortStatsQueryResult)
+	rx := ctx.Dict(rxDict)
+	active := make(map[nom.UID]bool)
+	for _, s := range res.Stats {
+		k := string(s.Port)
+		if v, err := rx.Get(k); err == nil && v.(uint64) != s.RxPackets {
+			active[s.Port] = true
+		}
+		if err := rx.Put(k, s.RxPackets); err != nil {
+			return err
+		}
+	}
+	if len(active) == 0 {
+		return nil
+	}
+
+	d := ctx.Dict(hostDict)
+	var seen []nom.Host
+	d.ForEach(func(k string, v interface{}) bool {
+		if h := v.(nom.Host); active[h.Port] {
+			seen = append(seen, h)
+		}
+		return true
+	})
+	now := time.Now()
+	for _, h := range seen {
+		h.LastSeen = now
+		if err := d.Put(string(h.UID()), h); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h statsHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
+	return centralizedMap
+}
+
+type queryHandler struct{}
+
+func (h queryHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
+	q := msg.Data().(nom.HostQuery)
+	res := nom.HostQueryResult{Query: q}
+	ctx.Dict(hostDict).ForEach(func(k string, v interface{}) bool {
+		if h := v.(nom.Host); q.Matches(h) {
+			res.Hosts = append(res.Hosts, h)
+		}
+		return true
+	})
+	return ctx.Reply(msg, res)
+}
+
+func (h queryHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
+	return centralizedMap
+}
+
+// ageHosts is emitted periodically to remove the hosts that are not seen
+// recently.
+type ageHosts struct{}
+
+type ageHandler struct {
+	timeout time.Duration
+}
+
+func (h ageHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
+	before := time.Now().Add(-h.timeout)
+	return removeHosts(func(h nom.Host) bool {
+		return h.LastSeen.Before(before)
+	}, ctx)
+}
+
+func (h ageHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
+	return centralizedMap
+}
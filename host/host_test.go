@@ -0,0 +1,167 @@
+package host
+
+import (
+	"testing"
+	"time"
+
+	bh "github.com/kandoo/beehive"
+	"github.com/kandoo/beehive-netctrl/nom"
+)
+
+var (
+	testMAC = nom.MACAddr{0x00, 0x00, 0x00, 0x00, 0x00, 0x01}
+	testIP  = nom.IPv4Addr{10, 0, 0, 1}
+)
+
+func testARP(src nom.MACAddr, spa nom.IPv4Addr) nom.Packet {
+	b := []byte{
+		0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, // dst
+		src[0], src[1], src[2], src[3], src[4], src[5], // src
+		0x08, 0x06, // ethernet type
+		0x00, 0x01, 0x08, 0x00, 6, 4, 0x00, 0x01, // htype, ptype, len, op
+		src[0], src[1], src[2], src[3], src[4], src[5], // sha
+		spa[0], spa[1], spa[2], spa[3], // spa
+		0, 0, 0, 0, 0, 0, // tha
+		10, 0, 0, 254, // tpa
+	}
+	return nom.Packet(b)
+}
+
+func testPacketIn(port nom.UID, pkt nom.Packet) bh.Msg {
+	return &bh.MockMsg{
+		MsgData: nom.PacketIn{
+			Node:   nom.NodeFromPortUID(port),
+			InPort: port,
+			Packet: pkt,
+		},
+	}
+}
+
+func TestHostJoinedAndMoved(t *testing.T) {
+	ctx := &bh.MockRcvContext{}
+	h := pktInHandler{}
+	if err := h.Rcv(testPacketIn("n1$$1", testARP(testMAC, testIP)),
+		ctx); err != nil {
+
+		t.Fatalf("error in packet in handler: %v", err)
+	}
+	if len(ctx.CtxMsgs) != 1 {
+		t.Fatalf("invalid number of emitted messages: actual=%d want=1",
+			len(ctx.CtxMsgs))
+	}
+	joined, ok := ctx.CtxMsgs[0].Data().(nom.HostJoined)
+	if !ok {
+		t.Fatalf("invalid message: actual=%v want=HostJoined",
+			ctx.CtxMsgs[0].Data())
+	}
+	if joined.MACAddr != testMAC || joined.Port != "n1$$1" ||
+		!nom.Host(joined).HasIPv4(testIP) {
+
+		t.Errorf("invalid host: %v", nom.Host(joined))
+	}
+
+	ctx.CtxMsgs = nil
+	h.Rcv(testPacketIn("n1$$1", testARP(testMAC, testIP)), ctx)
+	if len(ctx.CtxMsgs) != 0 {
+		t.Errorf("messages emitted for a known host: %v", ctx.CtxMsgs)
+	}
+
+	h.Rcv(testPacketIn("n2$$1", testARP(testMAC, testIP)), ctx)
+	if len(ctx.CtxMsgs) != 1 {
+		t.Fatalf("invalid number of emitted messages: actual=%d want=1",
+			len(ctx.CtxMsgs))
+	}
+	moved, ok := ctx.CtxMsgs[0].Data().(nom.HostMoved)
+	if !ok || moved.From != "n1$$1" || moved.Host.Port != "n2$$1" {
+		t.Errorf("invalid message: actual=%v want=HostMoved",
+			ctx.CtxMsgs[0].Data())
+	}
+}
+
+func TestHostOnInterSwitchPort(t *testing.T) {
+	ctx := &bh.MockRcvContext{}
+	l := nom.Link{From: "n1$$1", To: "n2$$1"}
+	linkHandler{}.Rcv(&bh.MockMsg{MsgData: nom.LinkAdded(l)}, ctx)
+	pktInHandler{}.Rcv(testPacketIn("n1$$1", testARP(testMAC, testIP)), ctx)
+	if len(ctx.CtxMsgs) != 0 {
+		t.Errorf("host learned on an inter-switch port: %v", ctx.CtxMsgs)
+	}
+}
+
+func TestHostLeft(t *testing.T) {
+	ctx := &bh.MockRcvContext{}
+	pktInHandler{}.Rcv(testPacketIn("n1$$1", testARP(testMAC, testIP)), ctx)
+	pktInHandler{}.Rcv(testPacketIn("n1$$2",
+		testARP(nom.MACAddr{0, 0, 0, 0, 0, 2}, nom.IPv4Addr{10, 0, 0, 2})), ctx)
+
+	ctx.CtxMsgs = nil
+	down := nom.Port{ID: "1", Node: "n1", State: nom.PortStateDown}
+	portHandler{}.Rcv(&bh.MockMsg{MsgData: nom.PortUpdated(down)}, ctx)
+	if len(ctx.CtxMsgs) != 1 {
+		t.Fatalf("invalid number of emitted messages: actual=%d want=1",
+			len(ctx.CtxMsgs))
+	}
+	if left, ok := ctx.CtxMsgs[0].Data().(nom.HostLeft); !ok ||
+		left.MACAddr != testMAC {
+
+		t.Errorf("invalid message: actual=%v want=HostLeft",
+			ctx.CtxMsgs[0].Data())
+	}
+
+	ctx.CtxMsgs = nil
+	ageHandler{timeout: -time.Second}.Rcv(&bh.MockMsg{MsgData: ageHosts{}},
+		ctx)
+	if len(ctx.CtxMsgs) != 1 {
+		t.Errorf("invalid number of aged hosts: actual=%d want=1",
+			len(ctx.CtxMsgs))
+	}
+}
+
+func TestHostRefreshedByPortStats(t *testing.T) {
+	ctx := &bh.MockRcvContext{}
+	pktInHandler{}.Rcv(testPacketIn("n1$$1", testARP(testMAC, testIP)), ctx)
+	k := string(nom.Host{MACAddr: testMAC}.UID())
+	old := time.Now().Add(-time.Hour)
+	v, _ := ctx.Dict(hostDict).Get(k)
+	host := v.(nom.Host)
+	host.LastSeen = old
+	ctx.Dict(hostDict).Put(k, host)
+
+	h := statsHandler{}
+	for i, rx := range []uint64{10, 10, 11} {
+		res := nom.PortStatsQueryResult{
+			Node:  "n1",
+			Stats: []nom.PortStats{{Port: "n1$$1", RxPackets: rx}},
+		}
+		if err := h.Rcv(&bh.MockMsg{MsgData: res}, ctx); err != nil {
+			t.Fatalf("error in stats handler: %v", err)
+		}
+		v, _ = ctx.Dict(hostDict).Get(k)
+		refreshed := v.(nom.Host).LastSeen.After(old)
+		if refreshed != (i == 2) {
+			t.Errorf("invalid refresh after %d packets: actual=%v want=%v", rx,
+				refreshed, i == 2)
+		}
+	}
+}
+
+func TestHostQuery(t *testing.T) {
+	ctx := &bh.MockRcvContext{}
+	pktInHandler{}.Rcv(testPacketIn("n1$$1", testARP(testMAC, testIP)), ctx)
+	pktInHandler{}.Rcv(testPacketIn("n2$$1",
+		testARP(nom.MACAddr{0, 0, 0, 0, 0, 2}, nom.IPv4Addr{10, 0, 0, 2})), ctx)
+
+	ctx.CtxMsgs = nil
+	q := nom.HostQuery{IPv4: testIP}
+	msg := &bh.MockMsg{MsgData: q, MsgFrom: 1}
+	if err := (queryHandler{}).Rcv(msg, ctx); err != nil {
+		t.Fatalf("error in query handler: %v", err)
+	}
+	if len(ctx.CtxMsgs) != 1 {
+		t.Fatalf("no reply for the query")
+	}
+	res := ctx.CtxMsgs[0].Data().(nom.HostQueryResult)
+	if len(res.Hosts) != 1 || res.Hosts[0].MACAddr != testMAC {
+		t.Errorf("invalid query result: %v", res.Hosts)
+	}
+}
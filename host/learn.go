@@ -0,0 +1,122 @@
+package host
+
+import (
+	"encoding/binary"
+
+	"github.com/kandoo/beehive-netctrl/nom"
+)
+
+const (
+	icmpv6NeighborSolicit = 135
+	icmpv6NeighborAdvert  = 136
+
+	dhcpServerPort = 67
+	dhcpClientPort = 68
+	dhcpBootReply  = 2
+	dhcpMagic      = 0x63825363
+	dhcpOptPad     = 0
+	dhcpOptMsgType = 53
+	dhcpOptEnd     = 255
+	dhcpAck        = 5
+)
+
+// isUnicast returns whether mac can be the address of a host.
+func isUnicast(mac nom.MACAddr) bool {
+	return mac[0]&0x01 == 0 && mac != (nom.MACAddr{})
+}
+
+// senderAddrs returns the IP addresses of the sender of the packet that are
+// advertised in ARP and NDP messages.
+func senderAddrs(pkt nom.Packet) (v4 []nom.IPv4Addr, v6 []nom.IPv6Addr) {
+	if arp, ok := pkt.ARP(); ok {
+		if spa := arp.SPA(); spa != (nom.IPv4Addr{}) {
+			v4 = append(v4, spa)
+		}
+		return v4, nil
+	}
+
+	ip, ok := pkt.IPv6()
+	if !ok {
+		return nil, nil
+	}
+	icmp, ok := pkt.ICMP()
+	if !ok {
+		return nil, nil
+	}
+	switch icmp.Type() {
+	case icmpv6NeighborSolicit:
+		// Duplicate address detection uses the unspecified address.
+		if src := ip.Src(); src != (nom.IPv6Addr{}) {
+			v6 = append(v6, src)
+		}
+	case icmpv6NeighborAdvert:
+		if len(icmp) >= 24 {
+			var target nom.IPv6Addr
+			copy(target[:], icmp[8:24])
+			v6 = append(v6, target)
+		}
+	}
+	return nil, v6
+}
+
+// dhcpLease returns the client hardware address and the IP address leased to
+// the client, if the packet is a DHCP acknowledgement.
+func dhcpLease(pkt nom.Packet) (nom.MACAddr, nom.IPv4Addr, bool) {
+	udp, ok := pkt.UDP()
+	if !ok || udp.SrcPort() != dhcpServerPort ||
+		udp.DstPort() != dhcpClientPort {
+
+		return nom.MACAddr{}, nom.IPv4Addr{}, false
+	}
+
+	b := udp.Payload()
+	if len(b) < 240 || b[0] != dhcpBootReply ||
+		binary.BigEndian.Uint32(b[236:]) != dhcpMagic {
+
+		return nom.MACAddr{}, nom.IPv4Addr{}, false
+	}
+
+	for opts := b[240:]; len(opts) > 0; {
+		switch opts[0] {
+		case dhcpOptPad:
+			opts = opts[1:]
+			continue
+		case dhcpOptEnd:
+			return nom.MACAddr{}, nom.IPv4Addr{}, false
+		}
+		if len(opts) < 2 || len(opts) < 2+int(opts[1]) {
+			return nom.MACAddr{}, nom.IPv4Addr{}, false
+		}
+		if opts[0] == dhcpOptMsgType && opts[1] == 1 {
+			if opts[2] != dhcpAck {
+				return nom.MACAddr{}, nom.IPv4Addr{}, false
+			}
+			var mac nom.MACAddr
+			var ip nom.IPv4Addr
+			copy(ip[:], b[16:20])
+			copy(mac[:], b[28:34])
+			return mac, ip, true
+		}
+		opts = opts[2+int(opts[1]):]
+	}
+	return nom.MACAddr{}, nom.IPv4Addr{}, false
+}
+
+// addAddrs adds the addresses to the host and returns whether any address is
+// new.
+func addAddrs(h *nom.Host, v4 []nom.IPv4Addr, v6 []nom.IPv6Addr) bool {
+	added := false
+	for _, ip := range v4 {
+		if !h.HasIPv4(ip) {
+			h.IPv4 = append(h.IPv4, ip)
+			added = true
+		}
+	}
+	for _, ip := range v6 {
+		if !h.HasIPv6(ip) {
+			h.IPv6 = append(h.IPv6, ip)
+			added = true
+		}
+	}
+	return added
+}
@@ -34,6 +34,22 @@ func main() {
 	// switching.RegisterSwitch(h, bh.Persistent(1))
 	// or a hub:
 	// switching.RegisterHub(h, bh.NonTransactional())
+	// and, for topologies with loops, a spanning tree:
+	// spanningtree.RegisterSpanningTree(h)
+	//
+	// Register a router for the subnets in a config file, along with the path
+	// manager that installs its routes:
+	// path.RegisterPath(h)
+	// c, err := routing.LoadConfig("router.json")
+	// if err == nil {
+	//   err = routing.RegisterRouter(h, c)
+	// }
+	//
+	// Register a firewall with the rules in a file:
+	// rules, err := firewall.LoadRules("rules.json")
+	// if err == nil {
+	//   err = firewall.RegisterFirewall(h, rules)
+	// }
 
 	h.Start()
 }
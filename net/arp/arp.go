@@ -0,0 +1,398 @@
+// Automatically generated by Packet Go code generator.
+package arp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+
+	"github.com/packet/packet/src/go/packet"
+
+	"github.com/kandoo/beehive-netctrl/net/ethernet"
+)
+
+type ARPHardwareType int
+
+const (
+	ARP_HW_ETHERNET ARPHardwareType = 1
+)
+
+type ARPOp int
+
+const (
+	ARP_REQUEST ARPOp = 1
+	ARP_REPLY   ARPOp = 2
+)
+
+func NewARPWithBuf(b []byte) ARP {
+	return ARP{ethernet.Ethernet{packet.Packet{Buf: b}}}
+}
+
+func NewARP() ARP {
+	s := 42
+	b := make([]byte, s)
+	p := ARP{ethernet.Ethernet{packet.Packet{Buf: b}}}
+	p.Init()
+	return p
+}
+
+type ARP struct {
+	ethernet.Ethernet
+}
+
+func (this ARP) minSize() int {
+	return 42
+}
+
+func (this ARP) Clone() (ARP, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewARP(), err
+	}
+
+	return NewARPWithBuf(newBuf.Bytes()), nil
+}
+
+type ARPConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewARPConn(c net.Conn) ARPConn {
+	return ARPConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *ARPConn) WriteARP(pkt ARP) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *ARPConn) WriteARPs(pkts []ARP) error {
+	for _, p := range pkts {
+		if err := c.WriteARP(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *ARPConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *ARPConn) ReadARP() (ARP, error) {
+	pkts := make([]ARP, 1)
+	_, err := c.ReadARPs(pkts)
+	if err != nil {
+		return NewARP(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *ARPConn) ReadARPs(pkts []ARP) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewARPWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *ARP) Init() {
+	this.Ethernet.Init()
+	// Invariants.
+	this.SetType(uint16(2054)) // type
+}
+
+func (this ARP) Size() int {
+	return 42
+}
+
+func ToARP(p ethernet.Ethernet) (ARP, error) {
+	if !IsARP(p) {
+		return NewARPWithBuf(nil), errors.New("Cannot convert to arp.ARP")
+	}
+
+	return NewARPWithBuf(p.Buf), nil
+}
+
+func IsARP(p ethernet.Ethernet) bool {
+	return p.Type() == 2054 && true
+}
+
+func (this ARP) HwType() uint16 {
+	offset := this.HwTypeOffset()
+	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	return res
+}
+
+func (this *ARP) SetHwType(h uint16) {
+	offset := this.HwTypeOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], h)
+	offset += 2
+}
+
+func (this ARP) HwTypeOffset() int {
+	offset := 14
+	return offset
+}
+
+func (this ARP) ProtoType() uint16 {
+	offset := this.ProtoTypeOffset()
+	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	return res
+}
+
+func (this *ARP) SetProtoType(p uint16) {
+	offset := this.ProtoTypeOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], p)
+	offset += 2
+}
+
+func (this ARP) ProtoTypeOffset() int {
+	offset := 16
+	return offset
+}
+
+func (this ARP) HwLen() uint8 {
+	offset := this.HwLenOffset()
+	res := uint8(this.Buf[offset])
+	return res
+}
+
+func (this *ARP) SetHwLen(h uint8) {
+	offset := this.HwLenOffset()
+	this.Buf[offset] = byte(h)
+	offset++
+}
+
+func (this ARP) HwLenOffset() int {
+	offset := 18
+	return offset
+}
+
+func (this ARP) ProtoLen() uint8 {
+	offset := this.ProtoLenOffset()
+	res := uint8(this.Buf[offset])
+	return res
+}
+
+func (this *ARP) SetProtoLen(p uint8) {
+	offset := this.ProtoLenOffset()
+	this.Buf[offset] = byte(p)
+	offset++
+}
+
+func (this ARP) ProtoLenOffset() int {
+	offset := 19
+	return offset
+}
+
+func (this ARP) Op() uint16 {
+	offset := this.OpOffset()
+	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	return res
+}
+
+func (this *ARP) SetOp(o uint16) {
+	offset := this.OpOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], o)
+	offset += 2
+}
+
+func (this ARP) OpOffset() int {
+	offset := 20
+	return offset
+}
+
+func (this ARP) SenderHwAddr() [6]uint8 {
+	offset := this.SenderHwAddrOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := 6
+	i := 0
+	var res [6]uint8
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := uint8(this.Buf[offset])
+		if size < 1 {
+			break
+		}
+		size -= 1
+		offset += 1
+		count--
+		res[i] = elem
+		i++
+	}
+	return res
+}
+
+func (this *ARP) SetSenderHwAddr(s [6]uint8) {
+	offset := this.SenderHwAddrOffset()
+	for _, e := range s {
+		this.Buf[offset] = byte(e)
+		offset++
+	}
+}
+
+func (this ARP) SenderHwAddrOffset() int {
+	offset := 22
+	return offset
+}
+
+func (this ARP) SenderProtoAddr() [4]uint8 {
+	offset := this.SenderProtoAddrOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := 4
+	i := 0
+	var res [4]uint8
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := uint8(this.Buf[offset])
+		if size < 1 {
+			break
+		}
+		size -= 1
+		offset += 1
+		count--
+		res[i] = elem
+		i++
+	}
+	return res
+}
+
+func (this *ARP) SetSenderProtoAddr(s [4]uint8) {
+	offset := this.SenderProtoAddrOffset()
+	for _, e := range s {
+		this.Buf[offset] = byte(e)
+		offset++
+	}
+}
+
+func (this ARP) SenderProtoAddrOffset() int {
+	offset := 28
+	return offset
+}
+
+func (this ARP) TargetHwAddr() [6]uint8 {
+	offset := this.TargetHwAddrOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := 6
+	i := 0
+	var res [6]uint8
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := uint8(this.Buf[offset])
+		if size < 1 {
+			break
+		}
+		size -= 1
+		offset += 1
+		count--
+		res[i] = elem
+		i++
+	}
+	return res
+}
+
+func (this *ARP) SetTargetHwAddr(t [6]uint8) {
+	offset := this.TargetHwAddrOffset()
+	for _, e := range t {
+		this.Buf[offset] = byte(e)
+		offset++
+	}
+}
+
+func (this ARP) TargetHwAddrOffset() int {
+	offset := 32
+	return offset
+}
+
+func (this ARP) TargetProtoAddr() [4]uint8 {
+	offset := this.TargetProtoAddrOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := 4
+	i := 0
+	var res [4]uint8
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := uint8(this.Buf[offset])
+		if size < 1 {
+			break
+		}
+		size -= 1
+		offset += 1
+		count--
+		res[i] = elem
+		i++
+	}
+	return res
+}
+
+func (this *ARP) SetTargetProtoAddr(t [4]uint8) {
+	offset := this.TargetProtoAddrOffset()
+	for _, e := range t {
+		this.Buf[offset] = byte(e)
+		offset++
+	}
+}
+
+func (this ARP) TargetProtoAddrOffset() int {
+	offset := 38
+	return offset
+}
@@ -0,0 +1,12 @@
+package arp
+
+import "github.com/kandoo/beehive-netctrl/net/ethernet"
+
+// SetEthernetIPv4 sets the hardware and the protocol types of the packet to
+// ethernet and IPv4.
+func (this *ARP) SetEthernetIPv4() {
+	this.SetHwType(uint16(ARP_HW_ETHERNET))
+	this.SetProtoType(uint16(ethernet.ETH_T_IPV4))
+	this.SetHwLen(6)
+	this.SetProtoLen(4)
+}
@@ -0,0 +1,43 @@
+package arp
+
+import (
+	"testing"
+
+	"github.com/kandoo/beehive-netctrl/net/ethernet"
+)
+
+func TestARPRoundTrip(t *testing.T) {
+	sha := [6]uint8{0, 0, 0, 0, 0, 1}
+	spa := [4]uint8{10, 0, 0, 1}
+	tpa := [4]uint8{10, 0, 0, 2}
+
+	p := NewARP()
+	p.SetSrcMac(sha)
+	p.SetDstMac([6]uint8{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF})
+	p.SetEthernetIPv4()
+	p.SetOp(uint16(ARP_REQUEST))
+	p.SetSenderHwAddr(sha)
+	p.SetSenderProtoAddr(spa)
+	p.SetTargetProtoAddr(tpa)
+
+	e := ethernet.NewEthernetWithBuf(p.Buf)
+	if e.Type() != uint16(ethernet.ETH_T_ARP) {
+		t.Errorf("invalid ethernet type: actual=%#x want=%#x", e.Type(),
+			ethernet.ETH_T_ARP)
+	}
+	d, err := ToARP(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.HwType() != uint16(ARP_HW_ETHERNET) ||
+		d.ProtoType() != uint16(ethernet.ETH_T_IPV4) || d.HwLen() != 6 ||
+		d.ProtoLen() != 4 || d.Op() != uint16(ARP_REQUEST) {
+
+		t.Errorf("invalid ARP header: %x", d.Buf)
+	}
+	if d.SenderHwAddr() != sha || d.SenderProtoAddr() != spa ||
+		d.TargetHwAddr() != [6]uint8{} || d.TargetProtoAddr() != tpa {
+
+		t.Errorf("invalid ARP addresses: %x", d.Buf)
+	}
+}
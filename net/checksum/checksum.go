@@ -0,0 +1,28 @@
+// Package checksum implements the one's complement checksum used by IPv4,
+// ICMP, UDP and TCP.
+package checksum
+
+// Sum adds the 16-bit big endian words of b to sum. If b has an odd length, it
+// is padded with a zero byte.
+func Sum(sum uint32, b []byte) uint32 {
+	for ; len(b) > 1; b = b[2:] {
+		sum += uint32(b[0])<<8 | uint32(b[1])
+	}
+	if len(b) == 1 {
+		sum += uint32(b[0]) << 8
+	}
+	return sum
+}
+
+// Fold folds sum into 16 bits and returns its one's complement.
+func Fold(sum uint32) uint16 {
+	for sum>>16 != 0 {
+		sum = sum&0xFFFF + sum>>16
+	}
+	return ^uint16(sum)
+}
+
+// Checksum returns the one's complement checksum of b.
+func Checksum(b []byte) uint16 {
+	return Fold(Sum(0, b))
+}
@@ -0,0 +1,22 @@
+package checksum
+
+import "testing"
+
+func TestChecksum(t *testing.T) {
+	tests := []struct {
+		b    []byte
+		want uint16
+	}{
+		// The example of RFC 1071.
+		{[]byte{0x00, 0x01, 0xF2, 0x03, 0xF4, 0xF5, 0xF6, 0xF7}, 0x220D},
+		// Odd lengths are padded with a zero byte.
+		{[]byte{0x01}, 0xFEFF},
+		{nil, 0xFFFF},
+	}
+	for _, test := range tests {
+		if c := Checksum(test.b); c != test.want {
+			t.Errorf("invalid checksum of %x: actual=%#x want=%#x", test.b, c,
+				test.want)
+		}
+	}
+}
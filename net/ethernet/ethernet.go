@@ -16,6 +16,9 @@ import (
 type EtherType int
 
 const (
+	ETH_T_IPV4 EtherType = 2048
+	ETH_T_ARP  EtherType = 2054
+	ETH_T_IPV6 EtherType = 34525
 	ETH_T_LLDP EtherType = 35020
 )
 
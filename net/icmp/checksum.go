@@ -0,0 +1,9 @@
+package icmp
+
+import "github.com/kandoo/beehive-netctrl/net/checksum"
+
+// UpdateChecksum computes and sets the checksum of a message of size bytes.
+func (this *ICMP) UpdateChecksum(size int) {
+	this.SetChecksum(0)
+	this.SetChecksum(checksum.Checksum(this.Buf[:size]))
+}
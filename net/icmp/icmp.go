@@ -0,0 +1,1055 @@
+// Automatically generated by Packet Go code generator.
+package icmp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+
+	"github.com/packet/packet/src/go/packet"
+)
+
+type ICMPType int
+
+const (
+	ICMP_ECHO_REPLY    ICMPType = 0
+	ICMP_DEST_UNREACH  ICMPType = 3
+	ICMP_ECHO_REQUEST  ICMPType = 8
+	ICMP_TIME_EXCEEDED ICMPType = 11
+)
+
+type ICMPUnreachCode int
+
+const (
+	ICMP_NET_UNREACH      ICMPUnreachCode = 0
+	ICMP_HOST_UNREACH     ICMPUnreachCode = 1
+	ICMP_PROTO_UNREACH    ICMPUnreachCode = 2
+	ICMP_PORT_UNREACH     ICMPUnreachCode = 3
+	ICMP_FRAG_NEEDED      ICMPUnreachCode = 4
+	ICMP_ADMIN_PROHIBITED ICMPUnreachCode = 13
+)
+
+func NewICMPWithBuf(b []byte) ICMP {
+	return ICMP{packet.Packet{Buf: b}}
+}
+
+func NewICMP() ICMP {
+	s := 4
+	b := make([]byte, s)
+	p := ICMP{packet.Packet{Buf: b}}
+	p.Init()
+	return p
+}
+
+type ICMP struct {
+	packet.Packet
+}
+
+func (this ICMP) minSize() int {
+	return 4
+}
+
+func (this ICMP) Clone() (ICMP, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewICMP(), err
+	}
+
+	return NewICMPWithBuf(newBuf.Bytes()), nil
+}
+
+type ICMPConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewICMPConn(c net.Conn) ICMPConn {
+	return ICMPConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *ICMPConn) WriteICMP(pkt ICMP) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *ICMPConn) WriteICMPs(pkts []ICMP) error {
+	for _, p := range pkts {
+		if err := c.WriteICMP(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *ICMPConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *ICMPConn) ReadICMP() (ICMP, error) {
+	pkts := make([]ICMP, 1)
+	_, err := c.ReadICMPs(pkts)
+	if err != nil {
+		return NewICMP(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *ICMPConn) ReadICMPs(pkts []ICMP) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewICMPWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *ICMP) Init() {
+	// Invariants.
+}
+
+func (this ICMP) Size() int {
+	return 4
+}
+
+func ToICMP(p packet.Packet) (ICMP, error) {
+	if !IsICMP(p) {
+		return NewICMPWithBuf(nil), errors.New("Cannot convert to icmp.ICMP")
+	}
+
+	return NewICMPWithBuf(p.Buf), nil
+}
+
+func IsICMP(p packet.Packet) bool {
+	return true
+}
+
+func (this ICMP) Type() uint8 {
+	offset := this.TypeOffset()
+	res := uint8(this.Buf[offset])
+	return res
+}
+
+func (this *ICMP) SetType(t uint8) {
+	offset := this.TypeOffset()
+	this.Buf[offset] = byte(t)
+	offset++
+}
+
+func (this ICMP) TypeOffset() int {
+	offset := 0
+	return offset
+}
+
+func (this ICMP) Code() uint8 {
+	offset := this.CodeOffset()
+	res := uint8(this.Buf[offset])
+	return res
+}
+
+func (this *ICMP) SetCode(c uint8) {
+	offset := this.CodeOffset()
+	this.Buf[offset] = byte(c)
+	offset++
+}
+
+func (this ICMP) CodeOffset() int {
+	offset := 1
+	return offset
+}
+
+func (this ICMP) Checksum() uint16 {
+	offset := this.ChecksumOffset()
+	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	return res
+}
+
+func (this *ICMP) SetChecksum(c uint16) {
+	offset := this.ChecksumOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], c)
+	offset += 2
+}
+
+func (this ICMP) ChecksumOffset() int {
+	offset := 2
+	return offset
+}
+
+func NewICMPEchoWithBuf(b []byte) ICMPEcho {
+	return ICMPEcho{ICMP{packet.Packet{Buf: b}}}
+}
+
+func NewICMPEcho() ICMPEcho {
+	s := 8
+	b := make([]byte, s)
+	p := ICMPEcho{ICMP{packet.Packet{Buf: b}}}
+	p.Init()
+	return p
+}
+
+type ICMPEcho struct {
+	ICMP
+}
+
+func (this ICMPEcho) minSize() int {
+	return 8
+}
+
+func (this ICMPEcho) Clone() (ICMPEcho, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewICMPEcho(), err
+	}
+
+	return NewICMPEchoWithBuf(newBuf.Bytes()), nil
+}
+
+type ICMPEchoConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewICMPEchoConn(c net.Conn) ICMPEchoConn {
+	return ICMPEchoConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *ICMPEchoConn) WriteICMPEcho(pkt ICMPEcho) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *ICMPEchoConn) WriteICMPEchos(pkts []ICMPEcho) error {
+	for _, p := range pkts {
+		if err := c.WriteICMPEcho(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *ICMPEchoConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *ICMPEchoConn) ReadICMPEcho() (ICMPEcho, error) {
+	pkts := make([]ICMPEcho, 1)
+	_, err := c.ReadICMPEchos(pkts)
+	if err != nil {
+		return NewICMPEcho(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *ICMPEchoConn) ReadICMPEchos(pkts []ICMPEcho) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewICMPEchoWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *ICMPEcho) Init() {
+	this.ICMP.Init()
+	// Invariants.
+}
+
+func (this ICMPEcho) Size() int {
+	return 8
+}
+
+func ToICMPEcho(p ICMP) (ICMPEcho, error) {
+	if !IsICMPEcho(p) {
+		return NewICMPEchoWithBuf(nil), errors.New("Cannot convert to icmp.ICMPEcho")
+	}
+
+	return NewICMPEchoWithBuf(p.Buf), nil
+}
+
+func IsICMPEcho(p ICMP) bool {
+	return true
+}
+
+func (this ICMPEcho) Id() uint16 {
+	offset := this.IdOffset()
+	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	return res
+}
+
+func (this *ICMPEcho) SetId(i uint16) {
+	offset := this.IdOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], i)
+	offset += 2
+}
+
+func (this ICMPEcho) IdOffset() int {
+	offset := 4
+	return offset
+}
+
+func (this ICMPEcho) Seq() uint16 {
+	offset := this.SeqOffset()
+	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	return res
+}
+
+func (this *ICMPEcho) SetSeq(s uint16) {
+	offset := this.SeqOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], s)
+	offset += 2
+}
+
+func (this ICMPEcho) SeqOffset() int {
+	offset := 6
+	return offset
+}
+
+func NewICMPEchoRequestWithBuf(b []byte) ICMPEchoRequest {
+	return ICMPEchoRequest{ICMPEcho{ICMP{packet.Packet{Buf: b}}}}
+}
+
+func NewICMPEchoRequest() ICMPEchoRequest {
+	s := 8
+	b := make([]byte, s)
+	p := ICMPEchoRequest{ICMPEcho{ICMP{packet.Packet{Buf: b}}}}
+	p.Init()
+	return p
+}
+
+type ICMPEchoRequest struct {
+	ICMPEcho
+}
+
+func (this ICMPEchoRequest) minSize() int {
+	return 8
+}
+
+func (this ICMPEchoRequest) Clone() (ICMPEchoRequest, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewICMPEchoRequest(), err
+	}
+
+	return NewICMPEchoRequestWithBuf(newBuf.Bytes()), nil
+}
+
+type ICMPEchoRequestConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewICMPEchoRequestConn(c net.Conn) ICMPEchoRequestConn {
+	return ICMPEchoRequestConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *ICMPEchoRequestConn) WriteICMPEchoRequest(pkt ICMPEchoRequest) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *ICMPEchoRequestConn) WriteICMPEchoRequests(pkts []ICMPEchoRequest) error {
+	for _, p := range pkts {
+		if err := c.WriteICMPEchoRequest(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *ICMPEchoRequestConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *ICMPEchoRequestConn) ReadICMPEchoRequest() (ICMPEchoRequest, error) {
+	pkts := make([]ICMPEchoRequest, 1)
+	_, err := c.ReadICMPEchoRequests(pkts)
+	if err != nil {
+		return NewICMPEchoRequest(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *ICMPEchoRequestConn) ReadICMPEchoRequests(pkts []ICMPEchoRequest) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewICMPEchoRequestWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *ICMPEchoRequest) Init() {
+	this.ICMPEcho.Init()
+	// Invariants.
+	this.SetType(uint8(8)) // type
+}
+
+func (this ICMPEchoRequest) Size() int {
+	return 8
+}
+
+func ToICMPEchoRequest(p ICMPEcho) (ICMPEchoRequest, error) {
+	if !IsICMPEchoRequest(p) {
+		return NewICMPEchoRequestWithBuf(nil), errors.New("Cannot convert to icmp.ICMPEchoRequest")
+	}
+
+	return NewICMPEchoRequestWithBuf(p.Buf), nil
+}
+
+func IsICMPEchoRequest(p ICMPEcho) bool {
+	return p.Type() == 8 && true
+}
+
+func NewICMPEchoReplyWithBuf(b []byte) ICMPEchoReply {
+	return ICMPEchoReply{ICMPEcho{ICMP{packet.Packet{Buf: b}}}}
+}
+
+func NewICMPEchoReply() ICMPEchoReply {
+	s := 8
+	b := make([]byte, s)
+	p := ICMPEchoReply{ICMPEcho{ICMP{packet.Packet{Buf: b}}}}
+	p.Init()
+	return p
+}
+
+type ICMPEchoReply struct {
+	ICMPEcho
+}
+
+func (this ICMPEchoReply) minSize() int {
+	return 8
+}
+
+func (this ICMPEchoReply) Clone() (ICMPEchoReply, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewICMPEchoReply(), err
+	}
+
+	return NewICMPEchoReplyWithBuf(newBuf.Bytes()), nil
+}
+
+type ICMPEchoReplyConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewICMPEchoReplyConn(c net.Conn) ICMPEchoReplyConn {
+	return ICMPEchoReplyConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *ICMPEchoReplyConn) WriteICMPEchoReply(pkt ICMPEchoReply) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *ICMPEchoReplyConn) WriteICMPEchoReplys(pkts []ICMPEchoReply) error {
+	for _, p := range pkts {
+		if err := c.WriteICMPEchoReply(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *ICMPEchoReplyConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *ICMPEchoReplyConn) ReadICMPEchoReply() (ICMPEchoReply, error) {
+	pkts := make([]ICMPEchoReply, 1)
+	_, err := c.ReadICMPEchoReplys(pkts)
+	if err != nil {
+		return NewICMPEchoReply(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *ICMPEchoReplyConn) ReadICMPEchoReplys(pkts []ICMPEchoReply) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewICMPEchoReplyWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *ICMPEchoReply) Init() {
+	this.ICMPEcho.Init()
+	// Invariants.
+	this.SetType(uint8(0)) // type
+}
+
+func (this ICMPEchoReply) Size() int {
+	return 8
+}
+
+func ToICMPEchoReply(p ICMPEcho) (ICMPEchoReply, error) {
+	if !IsICMPEchoReply(p) {
+		return NewICMPEchoReplyWithBuf(nil), errors.New("Cannot convert to icmp.ICMPEchoReply")
+	}
+
+	return NewICMPEchoReplyWithBuf(p.Buf), nil
+}
+
+func IsICMPEchoReply(p ICMPEcho) bool {
+	return p.Type() == 0 && true
+}
+
+func NewICMPDestUnreachWithBuf(b []byte) ICMPDestUnreach {
+	return ICMPDestUnreach{ICMP{packet.Packet{Buf: b}}}
+}
+
+func NewICMPDestUnreach() ICMPDestUnreach {
+	s := 8
+	b := make([]byte, s)
+	p := ICMPDestUnreach{ICMP{packet.Packet{Buf: b}}}
+	p.Init()
+	return p
+}
+
+type ICMPDestUnreach struct {
+	ICMP
+}
+
+func (this ICMPDestUnreach) minSize() int {
+	return 8
+}
+
+func (this ICMPDestUnreach) Clone() (ICMPDestUnreach, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewICMPDestUnreach(), err
+	}
+
+	return NewICMPDestUnreachWithBuf(newBuf.Bytes()), nil
+}
+
+type ICMPDestUnreachConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewICMPDestUnreachConn(c net.Conn) ICMPDestUnreachConn {
+	return ICMPDestUnreachConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *ICMPDestUnreachConn) WriteICMPDestUnreach(pkt ICMPDestUnreach) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *ICMPDestUnreachConn) WriteICMPDestUnreachs(pkts []ICMPDestUnreach) error {
+	for _, p := range pkts {
+		if err := c.WriteICMPDestUnreach(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *ICMPDestUnreachConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *ICMPDestUnreachConn) ReadICMPDestUnreach() (ICMPDestUnreach, error) {
+	pkts := make([]ICMPDestUnreach, 1)
+	_, err := c.ReadICMPDestUnreachs(pkts)
+	if err != nil {
+		return NewICMPDestUnreach(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *ICMPDestUnreachConn) ReadICMPDestUnreachs(pkts []ICMPDestUnreach) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewICMPDestUnreachWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *ICMPDestUnreach) Init() {
+	this.ICMP.Init()
+	// Invariants.
+	this.SetType(uint8(3)) // type
+}
+
+func (this ICMPDestUnreach) Size() int {
+	return 8
+}
+
+func ToICMPDestUnreach(p ICMP) (ICMPDestUnreach, error) {
+	if !IsICMPDestUnreach(p) {
+		return NewICMPDestUnreachWithBuf(nil), errors.New("Cannot convert to icmp.ICMPDestUnreach")
+	}
+
+	return NewICMPDestUnreachWithBuf(p.Buf), nil
+}
+
+func IsICMPDestUnreach(p ICMP) bool {
+	return p.Type() == 3 && true
+}
+
+func (this ICMPDestUnreach) Unused() uint16 {
+	offset := this.UnusedOffset()
+	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	return res
+}
+
+func (this *ICMPDestUnreach) SetUnused(u uint16) {
+	offset := this.UnusedOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], u)
+	offset += 2
+}
+
+func (this ICMPDestUnreach) UnusedOffset() int {
+	offset := 4
+	return offset
+}
+
+func (this ICMPDestUnreach) NextHopMtu() uint16 {
+	offset := this.NextHopMtuOffset()
+	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	return res
+}
+
+func (this *ICMPDestUnreach) SetNextHopMtu(n uint16) {
+	offset := this.NextHopMtuOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], n)
+	offset += 2
+}
+
+func (this ICMPDestUnreach) NextHopMtuOffset() int {
+	offset := 6
+	return offset
+}
+
+func NewICMPTimeExceededWithBuf(b []byte) ICMPTimeExceeded {
+	return ICMPTimeExceeded{ICMP{packet.Packet{Buf: b}}}
+}
+
+func NewICMPTimeExceeded() ICMPTimeExceeded {
+	s := 8
+	b := make([]byte, s)
+	p := ICMPTimeExceeded{ICMP{packet.Packet{Buf: b}}}
+	p.Init()
+	return p
+}
+
+type ICMPTimeExceeded struct {
+	ICMP
+}
+
+func (this ICMPTimeExceeded) minSize() int {
+	return 8
+}
+
+func (this ICMPTimeExceeded) Clone() (ICMPTimeExceeded, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewICMPTimeExceeded(), err
+	}
+
+	return NewICMPTimeExceededWithBuf(newBuf.Bytes()), nil
+}
+
+type ICMPTimeExceededConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewICMPTimeExceededConn(c net.Conn) ICMPTimeExceededConn {
+	return ICMPTimeExceededConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *ICMPTimeExceededConn) WriteICMPTimeExceeded(pkt ICMPTimeExceeded) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *ICMPTimeExceededConn) WriteICMPTimeExceededs(pkts []ICMPTimeExceeded) error {
+	for _, p := range pkts {
+		if err := c.WriteICMPTimeExceeded(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *ICMPTimeExceededConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *ICMPTimeExceededConn) ReadICMPTimeExceeded() (ICMPTimeExceeded, error) {
+	pkts := make([]ICMPTimeExceeded, 1)
+	_, err := c.ReadICMPTimeExceededs(pkts)
+	if err != nil {
+		return NewICMPTimeExceeded(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *ICMPTimeExceededConn) ReadICMPTimeExceededs(pkts []ICMPTimeExceeded) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewICMPTimeExceededWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *ICMPTimeExceeded) Init() {
+	this.ICMP.Init()
+	// Invariants.
+	this.SetType(uint8(11)) // type
+}
+
+func (this ICMPTimeExceeded) Size() int {
+	return 8
+}
+
+func ToICMPTimeExceeded(p ICMP) (ICMPTimeExceeded, error) {
+	if !IsICMPTimeExceeded(p) {
+		return NewICMPTimeExceededWithBuf(nil), errors.New("Cannot convert to icmp.ICMPTimeExceeded")
+	}
+
+	return NewICMPTimeExceededWithBuf(p.Buf), nil
+}
+
+func IsICMPTimeExceeded(p ICMP) bool {
+	return p.Type() == 11 && true
+}
+
+func (this ICMPTimeExceeded) Unused() uint32 {
+	offset := this.UnusedOffset()
+	res := binary.BigEndian.Uint32(this.Buf[offset:])
+	return res
+}
+
+func (this *ICMPTimeExceeded) SetUnused(u uint32) {
+	offset := this.UnusedOffset()
+	binary.BigEndian.PutUint32(this.Buf[offset:], u)
+	offset += 4
+}
+
+func (this ICMPTimeExceeded) UnusedOffset() int {
+	offset := 4
+	return offset
+}
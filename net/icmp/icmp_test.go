@@ -0,0 +1,50 @@
+package icmp
+
+import "testing"
+
+func TestEchoRoundTrip(t *testing.T) {
+	req := NewICMPEchoRequest()
+	req.SetId(1)
+	req.SetSeq(1)
+	req.Buf = append(req.Buf, "ping"...)
+	req.UpdateChecksum(len(req.Buf))
+	if c := req.Checksum(); c != 0x192D {
+		t.Errorf("invalid checksum: actual=%#x want=%#x", c, 0x192D)
+	}
+
+	echo, err := ToICMPEcho(NewICMPWithBuf(req.Buf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if IsICMPEchoReply(echo) {
+		t.Error("echo request is decoded as a reply")
+	}
+	dreq, err := ToICMPEchoRequest(echo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dreq.Id() != 1 || dreq.Seq() != 1 || dreq.Code() != 0 {
+		t.Errorf("invalid echo request: id=%v seq=%v code=%v", dreq.Id(),
+			dreq.Seq(), dreq.Code())
+	}
+
+	rep := NewICMPEchoReplyWithBuf(append([]byte{}, req.Buf...))
+	rep.Init()
+	rep.UpdateChecksum(len(rep.Buf))
+	echo, err = ToICMPEcho(NewICMPWithBuf(rep.Buf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	drep, err := ToICMPEchoReply(echo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if drep.Id() != 1 || drep.Seq() != 1 || string(drep.Buf[drep.Size():]) !=
+		"ping" {
+
+		t.Errorf("invalid echo reply: %x", drep.Buf)
+	}
+	if c := drep.Checksum(); c != 0x212D {
+		t.Errorf("invalid checksum: actual=%#x want=%#x", c, 0x212D)
+	}
+}
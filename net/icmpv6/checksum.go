@@ -0,0 +1,10 @@
+package icmpv6
+
+import "github.com/kandoo/beehive-netctrl/net/checksum"
+
+// UpdateChecksum computes and sets the checksum of a message of size bytes,
+// using the partial checksum of the IPv6 pseudo header.
+func (this *ICMPv6) UpdateChecksum(pseudo uint32, size int) {
+	this.SetChecksum(0)
+	this.SetChecksum(checksum.Fold(checksum.Sum(pseudo, this.Buf[:size])))
+}
@@ -0,0 +1,23 @@
+package icmpv6
+
+import (
+	"testing"
+
+	"github.com/kandoo/beehive-netctrl/net/ipv6"
+)
+
+func TestUpdateChecksum(t *testing.T) {
+	m := NewICMPv6EchoRequest()
+	m.SetId(1)
+	m.SetSeq(1)
+	m.Buf = append(m.Buf, "ping"...)
+
+	ip := ipv6.NewIPv6()
+	ip.SetSrcAddr([16]uint8{0xFE, 0x80, 15: 0x01})
+	ip.SetDstAddr([16]uint8{0xFE, 0x80, 15: 0x02})
+
+	m.UpdateChecksum(ip.PseudoHeaderSum(58, len(m.Buf)), len(m.Buf))
+	if c := m.Checksum(); c != 0xA3E1 {
+		t.Errorf("invalid checksum: actual=%#x want=%#x", c, 0xA3E1)
+	}
+}
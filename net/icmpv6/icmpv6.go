@@ -0,0 +1,1271 @@
+// Automatically generated by Packet Go code generator.
+package icmpv6
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+
+	"github.com/packet/packet/src/go/packet"
+)
+
+type ICMPv6Type int
+
+const (
+	ICMPV6_DEST_UNREACH     ICMPv6Type = 1
+	ICMPV6_PACKET_TOO_BIG   ICMPv6Type = 2
+	ICMPV6_TIME_EXCEEDED    ICMPv6Type = 3
+	ICMPV6_ECHO_REQUEST     ICMPv6Type = 128
+	ICMPV6_ECHO_REPLY       ICMPv6Type = 129
+	ICMPV6_NEIGHBOR_SOLICIT ICMPv6Type = 135
+	ICMPV6_NEIGHBOR_ADVERT  ICMPv6Type = 136
+)
+
+type NeighborAdvertFlag int
+
+const (
+	ICMPV6_NA_ROUTER    NeighborAdvertFlag = 2147483648
+	ICMPV6_NA_SOLICITED NeighborAdvertFlag = 1073741824
+	ICMPV6_NA_OVERRIDE  NeighborAdvertFlag = 536870912
+)
+
+func NewICMPv6WithBuf(b []byte) ICMPv6 {
+	return ICMPv6{packet.Packet{Buf: b}}
+}
+
+func NewICMPv6() ICMPv6 {
+	s := 4
+	b := make([]byte, s)
+	p := ICMPv6{packet.Packet{Buf: b}}
+	p.Init()
+	return p
+}
+
+type ICMPv6 struct {
+	packet.Packet
+}
+
+func (this ICMPv6) minSize() int {
+	return 4
+}
+
+func (this ICMPv6) Clone() (ICMPv6, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewICMPv6(), err
+	}
+
+	return NewICMPv6WithBuf(newBuf.Bytes()), nil
+}
+
+type ICMPv6Conn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewICMPv6Conn(c net.Conn) ICMPv6Conn {
+	return ICMPv6Conn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *ICMPv6Conn) WriteICMPv6(pkt ICMPv6) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *ICMPv6Conn) WriteICMPv6s(pkts []ICMPv6) error {
+	for _, p := range pkts {
+		if err := c.WriteICMPv6(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *ICMPv6Conn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *ICMPv6Conn) ReadICMPv6() (ICMPv6, error) {
+	pkts := make([]ICMPv6, 1)
+	_, err := c.ReadICMPv6s(pkts)
+	if err != nil {
+		return NewICMPv6(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *ICMPv6Conn) ReadICMPv6s(pkts []ICMPv6) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewICMPv6WithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *ICMPv6) Init() {
+	// Invariants.
+}
+
+func (this ICMPv6) Size() int {
+	return 4
+}
+
+func ToICMPv6(p packet.Packet) (ICMPv6, error) {
+	if !IsICMPv6(p) {
+		return NewICMPv6WithBuf(nil), errors.New("Cannot convert to icmpv6.ICMPv6")
+	}
+
+	return NewICMPv6WithBuf(p.Buf), nil
+}
+
+func IsICMPv6(p packet.Packet) bool {
+	return true
+}
+
+func (this ICMPv6) Type() uint8 {
+	offset := this.TypeOffset()
+	res := uint8(this.Buf[offset])
+	return res
+}
+
+func (this *ICMPv6) SetType(t uint8) {
+	offset := this.TypeOffset()
+	this.Buf[offset] = byte(t)
+	offset++
+}
+
+func (this ICMPv6) TypeOffset() int {
+	offset := 0
+	return offset
+}
+
+func (this ICMPv6) Code() uint8 {
+	offset := this.CodeOffset()
+	res := uint8(this.Buf[offset])
+	return res
+}
+
+func (this *ICMPv6) SetCode(c uint8) {
+	offset := this.CodeOffset()
+	this.Buf[offset] = byte(c)
+	offset++
+}
+
+func (this ICMPv6) CodeOffset() int {
+	offset := 1
+	return offset
+}
+
+func (this ICMPv6) Checksum() uint16 {
+	offset := this.ChecksumOffset()
+	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	return res
+}
+
+func (this *ICMPv6) SetChecksum(c uint16) {
+	offset := this.ChecksumOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], c)
+	offset += 2
+}
+
+func (this ICMPv6) ChecksumOffset() int {
+	offset := 2
+	return offset
+}
+
+func NewICMPv6EchoWithBuf(b []byte) ICMPv6Echo {
+	return ICMPv6Echo{ICMPv6{packet.Packet{Buf: b}}}
+}
+
+func NewICMPv6Echo() ICMPv6Echo {
+	s := 8
+	b := make([]byte, s)
+	p := ICMPv6Echo{ICMPv6{packet.Packet{Buf: b}}}
+	p.Init()
+	return p
+}
+
+type ICMPv6Echo struct {
+	ICMPv6
+}
+
+func (this ICMPv6Echo) minSize() int {
+	return 8
+}
+
+func (this ICMPv6Echo) Clone() (ICMPv6Echo, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewICMPv6Echo(), err
+	}
+
+	return NewICMPv6EchoWithBuf(newBuf.Bytes()), nil
+}
+
+type ICMPv6EchoConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewICMPv6EchoConn(c net.Conn) ICMPv6EchoConn {
+	return ICMPv6EchoConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *ICMPv6EchoConn) WriteICMPv6Echo(pkt ICMPv6Echo) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *ICMPv6EchoConn) WriteICMPv6Echos(pkts []ICMPv6Echo) error {
+	for _, p := range pkts {
+		if err := c.WriteICMPv6Echo(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *ICMPv6EchoConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *ICMPv6EchoConn) ReadICMPv6Echo() (ICMPv6Echo, error) {
+	pkts := make([]ICMPv6Echo, 1)
+	_, err := c.ReadICMPv6Echos(pkts)
+	if err != nil {
+		return NewICMPv6Echo(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *ICMPv6EchoConn) ReadICMPv6Echos(pkts []ICMPv6Echo) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewICMPv6EchoWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *ICMPv6Echo) Init() {
+	this.ICMPv6.Init()
+	// Invariants.
+}
+
+func (this ICMPv6Echo) Size() int {
+	return 8
+}
+
+func ToICMPv6Echo(p ICMPv6) (ICMPv6Echo, error) {
+	if !IsICMPv6Echo(p) {
+		return NewICMPv6EchoWithBuf(nil), errors.New("Cannot convert to icmpv6.ICMPv6Echo")
+	}
+
+	return NewICMPv6EchoWithBuf(p.Buf), nil
+}
+
+func IsICMPv6Echo(p ICMPv6) bool {
+	return true
+}
+
+func (this ICMPv6Echo) Id() uint16 {
+	offset := this.IdOffset()
+	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	return res
+}
+
+func (this *ICMPv6Echo) SetId(i uint16) {
+	offset := this.IdOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], i)
+	offset += 2
+}
+
+func (this ICMPv6Echo) IdOffset() int {
+	offset := 4
+	return offset
+}
+
+func (this ICMPv6Echo) Seq() uint16 {
+	offset := this.SeqOffset()
+	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	return res
+}
+
+func (this *ICMPv6Echo) SetSeq(s uint16) {
+	offset := this.SeqOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], s)
+	offset += 2
+}
+
+func (this ICMPv6Echo) SeqOffset() int {
+	offset := 6
+	return offset
+}
+
+func NewICMPv6EchoRequestWithBuf(b []byte) ICMPv6EchoRequest {
+	return ICMPv6EchoRequest{ICMPv6Echo{ICMPv6{packet.Packet{Buf: b}}}}
+}
+
+func NewICMPv6EchoRequest() ICMPv6EchoRequest {
+	s := 8
+	b := make([]byte, s)
+	p := ICMPv6EchoRequest{ICMPv6Echo{ICMPv6{packet.Packet{Buf: b}}}}
+	p.Init()
+	return p
+}
+
+type ICMPv6EchoRequest struct {
+	ICMPv6Echo
+}
+
+func (this ICMPv6EchoRequest) minSize() int {
+	return 8
+}
+
+func (this ICMPv6EchoRequest) Clone() (ICMPv6EchoRequest, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewICMPv6EchoRequest(), err
+	}
+
+	return NewICMPv6EchoRequestWithBuf(newBuf.Bytes()), nil
+}
+
+type ICMPv6EchoRequestConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewICMPv6EchoRequestConn(c net.Conn) ICMPv6EchoRequestConn {
+	return ICMPv6EchoRequestConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *ICMPv6EchoRequestConn) WriteICMPv6EchoRequest(pkt ICMPv6EchoRequest) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *ICMPv6EchoRequestConn) WriteICMPv6EchoRequests(pkts []ICMPv6EchoRequest) error {
+	for _, p := range pkts {
+		if err := c.WriteICMPv6EchoRequest(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *ICMPv6EchoRequestConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *ICMPv6EchoRequestConn) ReadICMPv6EchoRequest() (ICMPv6EchoRequest, error) {
+	pkts := make([]ICMPv6EchoRequest, 1)
+	_, err := c.ReadICMPv6EchoRequests(pkts)
+	if err != nil {
+		return NewICMPv6EchoRequest(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *ICMPv6EchoRequestConn) ReadICMPv6EchoRequests(pkts []ICMPv6EchoRequest) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewICMPv6EchoRequestWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *ICMPv6EchoRequest) Init() {
+	this.ICMPv6Echo.Init()
+	// Invariants.
+	this.SetType(uint8(128)) // type
+}
+
+func (this ICMPv6EchoRequest) Size() int {
+	return 8
+}
+
+func ToICMPv6EchoRequest(p ICMPv6Echo) (ICMPv6EchoRequest, error) {
+	if !IsICMPv6EchoRequest(p) {
+		return NewICMPv6EchoRequestWithBuf(nil), errors.New("Cannot convert to icmpv6.ICMPv6EchoRequest")
+	}
+
+	return NewICMPv6EchoRequestWithBuf(p.Buf), nil
+}
+
+func IsICMPv6EchoRequest(p ICMPv6Echo) bool {
+	return p.Type() == 128 && true
+}
+
+func NewICMPv6EchoReplyWithBuf(b []byte) ICMPv6EchoReply {
+	return ICMPv6EchoReply{ICMPv6Echo{ICMPv6{packet.Packet{Buf: b}}}}
+}
+
+func NewICMPv6EchoReply() ICMPv6EchoReply {
+	s := 8
+	b := make([]byte, s)
+	p := ICMPv6EchoReply{ICMPv6Echo{ICMPv6{packet.Packet{Buf: b}}}}
+	p.Init()
+	return p
+}
+
+type ICMPv6EchoReply struct {
+	ICMPv6Echo
+}
+
+func (this ICMPv6EchoReply) minSize() int {
+	return 8
+}
+
+func (this ICMPv6EchoReply) Clone() (ICMPv6EchoReply, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewICMPv6EchoReply(), err
+	}
+
+	return NewICMPv6EchoReplyWithBuf(newBuf.Bytes()), nil
+}
+
+type ICMPv6EchoReplyConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewICMPv6EchoReplyConn(c net.Conn) ICMPv6EchoReplyConn {
+	return ICMPv6EchoReplyConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *ICMPv6EchoReplyConn) WriteICMPv6EchoReply(pkt ICMPv6EchoReply) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *ICMPv6EchoReplyConn) WriteICMPv6EchoReplys(pkts []ICMPv6EchoReply) error {
+	for _, p := range pkts {
+		if err := c.WriteICMPv6EchoReply(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *ICMPv6EchoReplyConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *ICMPv6EchoReplyConn) ReadICMPv6EchoReply() (ICMPv6EchoReply, error) {
+	pkts := make([]ICMPv6EchoReply, 1)
+	_, err := c.ReadICMPv6EchoReplys(pkts)
+	if err != nil {
+		return NewICMPv6EchoReply(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *ICMPv6EchoReplyConn) ReadICMPv6EchoReplys(pkts []ICMPv6EchoReply) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewICMPv6EchoReplyWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *ICMPv6EchoReply) Init() {
+	this.ICMPv6Echo.Init()
+	// Invariants.
+	this.SetType(uint8(129)) // type
+}
+
+func (this ICMPv6EchoReply) Size() int {
+	return 8
+}
+
+func ToICMPv6EchoReply(p ICMPv6Echo) (ICMPv6EchoReply, error) {
+	if !IsICMPv6EchoReply(p) {
+		return NewICMPv6EchoReplyWithBuf(nil), errors.New("Cannot convert to icmpv6.ICMPv6EchoReply")
+	}
+
+	return NewICMPv6EchoReplyWithBuf(p.Buf), nil
+}
+
+func IsICMPv6EchoReply(p ICMPv6Echo) bool {
+	return p.Type() == 129 && true
+}
+
+func NewICMPv6DestUnreachWithBuf(b []byte) ICMPv6DestUnreach {
+	return ICMPv6DestUnreach{ICMPv6{packet.Packet{Buf: b}}}
+}
+
+func NewICMPv6DestUnreach() ICMPv6DestUnreach {
+	s := 8
+	b := make([]byte, s)
+	p := ICMPv6DestUnreach{ICMPv6{packet.Packet{Buf: b}}}
+	p.Init()
+	return p
+}
+
+type ICMPv6DestUnreach struct {
+	ICMPv6
+}
+
+func (this ICMPv6DestUnreach) minSize() int {
+	return 8
+}
+
+func (this ICMPv6DestUnreach) Clone() (ICMPv6DestUnreach, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewICMPv6DestUnreach(), err
+	}
+
+	return NewICMPv6DestUnreachWithBuf(newBuf.Bytes()), nil
+}
+
+type ICMPv6DestUnreachConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewICMPv6DestUnreachConn(c net.Conn) ICMPv6DestUnreachConn {
+	return ICMPv6DestUnreachConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *ICMPv6DestUnreachConn) WriteICMPv6DestUnreach(pkt ICMPv6DestUnreach) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *ICMPv6DestUnreachConn) WriteICMPv6DestUnreachs(pkts []ICMPv6DestUnreach) error {
+	for _, p := range pkts {
+		if err := c.WriteICMPv6DestUnreach(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *ICMPv6DestUnreachConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *ICMPv6DestUnreachConn) ReadICMPv6DestUnreach() (ICMPv6DestUnreach, error) {
+	pkts := make([]ICMPv6DestUnreach, 1)
+	_, err := c.ReadICMPv6DestUnreachs(pkts)
+	if err != nil {
+		return NewICMPv6DestUnreach(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *ICMPv6DestUnreachConn) ReadICMPv6DestUnreachs(pkts []ICMPv6DestUnreach) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewICMPv6DestUnreachWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *ICMPv6DestUnreach) Init() {
+	this.ICMPv6.Init()
+	// Invariants.
+	this.SetType(uint8(1)) // type
+}
+
+func (this ICMPv6DestUnreach) Size() int {
+	return 8
+}
+
+func ToICMPv6DestUnreach(p ICMPv6) (ICMPv6DestUnreach, error) {
+	if !IsICMPv6DestUnreach(p) {
+		return NewICMPv6DestUnreachWithBuf(nil), errors.New("Cannot convert to icmpv6.ICMPv6DestUnreach")
+	}
+
+	return NewICMPv6DestUnreachWithBuf(p.Buf), nil
+}
+
+func IsICMPv6DestUnreach(p ICMPv6) bool {
+	return p.Type() == 1 && true
+}
+
+func (this ICMPv6DestUnreach) Unused() uint32 {
+	offset := this.UnusedOffset()
+	res := binary.BigEndian.Uint32(this.Buf[offset:])
+	return res
+}
+
+func (this *ICMPv6DestUnreach) SetUnused(u uint32) {
+	offset := this.UnusedOffset()
+	binary.BigEndian.PutUint32(this.Buf[offset:], u)
+	offset += 4
+}
+
+func (this ICMPv6DestUnreach) UnusedOffset() int {
+	offset := 4
+	return offset
+}
+
+func NewNeighborSolicitWithBuf(b []byte) NeighborSolicit {
+	return NeighborSolicit{ICMPv6{packet.Packet{Buf: b}}}
+}
+
+func NewNeighborSolicit() NeighborSolicit {
+	s := 24
+	b := make([]byte, s)
+	p := NeighborSolicit{ICMPv6{packet.Packet{Buf: b}}}
+	p.Init()
+	return p
+}
+
+type NeighborSolicit struct {
+	ICMPv6
+}
+
+func (this NeighborSolicit) minSize() int {
+	return 24
+}
+
+func (this NeighborSolicit) Clone() (NeighborSolicit, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewNeighborSolicit(), err
+	}
+
+	return NewNeighborSolicitWithBuf(newBuf.Bytes()), nil
+}
+
+type NeighborSolicitConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewNeighborSolicitConn(c net.Conn) NeighborSolicitConn {
+	return NeighborSolicitConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *NeighborSolicitConn) WriteNeighborSolicit(pkt NeighborSolicit) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *NeighborSolicitConn) WriteNeighborSolicits(pkts []NeighborSolicit) error {
+	for _, p := range pkts {
+		if err := c.WriteNeighborSolicit(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *NeighborSolicitConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *NeighborSolicitConn) ReadNeighborSolicit() (NeighborSolicit, error) {
+	pkts := make([]NeighborSolicit, 1)
+	_, err := c.ReadNeighborSolicits(pkts)
+	if err != nil {
+		return NewNeighborSolicit(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *NeighborSolicitConn) ReadNeighborSolicits(pkts []NeighborSolicit) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewNeighborSolicitWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *NeighborSolicit) Init() {
+	this.ICMPv6.Init()
+	// Invariants.
+	this.SetType(uint8(135)) // type
+}
+
+func (this NeighborSolicit) Size() int {
+	return 24
+}
+
+func ToNeighborSolicit(p ICMPv6) (NeighborSolicit, error) {
+	if !IsNeighborSolicit(p) {
+		return NewNeighborSolicitWithBuf(nil), errors.New("Cannot convert to icmpv6.NeighborSolicit")
+	}
+
+	return NewNeighborSolicitWithBuf(p.Buf), nil
+}
+
+func IsNeighborSolicit(p ICMPv6) bool {
+	return p.Type() == 135 && true
+}
+
+func (this NeighborSolicit) Reserved() uint32 {
+	offset := this.ReservedOffset()
+	res := binary.BigEndian.Uint32(this.Buf[offset:])
+	return res
+}
+
+func (this *NeighborSolicit) SetReserved(r uint32) {
+	offset := this.ReservedOffset()
+	binary.BigEndian.PutUint32(this.Buf[offset:], r)
+	offset += 4
+}
+
+func (this NeighborSolicit) ReservedOffset() int {
+	offset := 4
+	return offset
+}
+
+func (this NeighborSolicit) TargetAddr() [16]uint8 {
+	offset := this.TargetAddrOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := 16
+	i := 0
+	var res [16]uint8
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := uint8(this.Buf[offset])
+		if size < 1 {
+			break
+		}
+		size -= 1
+		offset += 1
+		count--
+		res[i] = elem
+		i++
+	}
+	return res
+}
+
+func (this *NeighborSolicit) SetTargetAddr(t [16]uint8) {
+	offset := this.TargetAddrOffset()
+	for _, e := range t {
+		this.Buf[offset] = byte(e)
+		offset++
+	}
+}
+
+func (this NeighborSolicit) TargetAddrOffset() int {
+	offset := 8
+	return offset
+}
+
+func NewNeighborAdvertWithBuf(b []byte) NeighborAdvert {
+	return NeighborAdvert{ICMPv6{packet.Packet{Buf: b}}}
+}
+
+func NewNeighborAdvert() NeighborAdvert {
+	s := 24
+	b := make([]byte, s)
+	p := NeighborAdvert{ICMPv6{packet.Packet{Buf: b}}}
+	p.Init()
+	return p
+}
+
+type NeighborAdvert struct {
+	ICMPv6
+}
+
+func (this NeighborAdvert) minSize() int {
+	return 24
+}
+
+func (this NeighborAdvert) Clone() (NeighborAdvert, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewNeighborAdvert(), err
+	}
+
+	return NewNeighborAdvertWithBuf(newBuf.Bytes()), nil
+}
+
+type NeighborAdvertConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewNeighborAdvertConn(c net.Conn) NeighborAdvertConn {
+	return NeighborAdvertConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *NeighborAdvertConn) WriteNeighborAdvert(pkt NeighborAdvert) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *NeighborAdvertConn) WriteNeighborAdverts(pkts []NeighborAdvert) error {
+	for _, p := range pkts {
+		if err := c.WriteNeighborAdvert(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *NeighborAdvertConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *NeighborAdvertConn) ReadNeighborAdvert() (NeighborAdvert, error) {
+	pkts := make([]NeighborAdvert, 1)
+	_, err := c.ReadNeighborAdverts(pkts)
+	if err != nil {
+		return NewNeighborAdvert(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *NeighborAdvertConn) ReadNeighborAdverts(pkts []NeighborAdvert) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewNeighborAdvertWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *NeighborAdvert) Init() {
+	this.ICMPv6.Init()
+	// Invariants.
+	this.SetType(uint8(136)) // type
+}
+
+func (this NeighborAdvert) Size() int {
+	return 24
+}
+
+func ToNeighborAdvert(p ICMPv6) (NeighborAdvert, error) {
+	if !IsNeighborAdvert(p) {
+		return NewNeighborAdvertWithBuf(nil), errors.New("Cannot convert to icmpv6.NeighborAdvert")
+	}
+
+	return NewNeighborAdvertWithBuf(p.Buf), nil
+}
+
+func IsNeighborAdvert(p ICMPv6) bool {
+	return p.Type() == 136 && true
+}
+
+func (this NeighborAdvert) Flags() uint32 {
+	offset := this.FlagsOffset()
+	res := binary.BigEndian.Uint32(this.Buf[offset:])
+	return res
+}
+
+func (this *NeighborAdvert) SetFlags(f uint32) {
+	offset := this.FlagsOffset()
+	binary.BigEndian.PutUint32(this.Buf[offset:], f)
+	offset += 4
+}
+
+func (this NeighborAdvert) FlagsOffset() int {
+	offset := 4
+	return offset
+}
+
+func (this NeighborAdvert) TargetAddr() [16]uint8 {
+	offset := this.TargetAddrOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := 16
+	i := 0
+	var res [16]uint8
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := uint8(this.Buf[offset])
+		if size < 1 {
+			break
+		}
+		size -= 1
+		offset += 1
+		count--
+		res[i] = elem
+		i++
+	}
+	return res
+}
+
+func (this *NeighborAdvert) SetTargetAddr(t [16]uint8) {
+	offset := this.TargetAddrOffset()
+	for _, e := range t {
+		this.Buf[offset] = byte(e)
+		offset++
+	}
+}
+
+func (this NeighborAdvert) TargetAddrOffset() int {
+	offset := 8
+	return offset
+}
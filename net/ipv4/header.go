@@ -0,0 +1,51 @@
+package ipv4
+
+import "github.com/kandoo/beehive-netctrl/net/checksum"
+
+// HeaderLen returns the length of the IPv4 header including its options.
+func (this IPv4) HeaderLen() int {
+	return int(this.VersionIhl()&0x0F) * 4
+}
+
+// SetHeaderLen sets the version to 4 and the length of the header, in bytes.
+func (this *IPv4) SetHeaderLen(l int) {
+	this.SetVersionIhl(uint8(0x40 | (l/4)&0x0F))
+}
+
+// SetPayloadLen sets the total length of the packet based on the header length
+// and the length of the payload.
+func (this *IPv4) SetPayloadLen(l int) {
+	this.SetTotalLen(uint16(this.HeaderLen() + l))
+}
+
+// Header returns the bytes of the IPv4 header including its options.
+func (this IPv4) Header() []byte {
+	off := this.VersionIhlOffset()
+	return this.Buf[off : off+this.HeaderLen()]
+}
+
+// Payload returns the payload of the packet.
+func (this IPv4) Payload() []byte {
+	off := this.VersionIhlOffset()
+	return this.Buf[off+this.HeaderLen() : off+int(this.TotalLen())]
+}
+
+// UpdateChecksum computes and sets the header checksum.
+func (this *IPv4) UpdateChecksum() {
+	this.SetChecksum(0)
+	this.SetChecksum(checksum.Checksum(this.Header()))
+}
+
+// ValidChecksum returns whether the header checksum is valid.
+func (this IPv4) ValidChecksum() bool {
+	return checksum.Checksum(this.Header()) == 0
+}
+
+// PseudoHeaderSum returns the partial checksum of the pseudo header used in
+// the checksum of the transport protocols carried in the packet.
+func (this IPv4) PseudoHeaderSum() uint32 {
+	src, dst := this.SrcAddr(), this.DstAddr()
+	sum := checksum.Sum(0, src[:])
+	sum = checksum.Sum(sum, dst[:])
+	return sum + uint32(this.Proto()) + uint32(len(this.Payload()))
+}
@@ -0,0 +1,33 @@
+package ipv4
+
+import "testing"
+
+// testIPv4 returns an ethernet frame carrying an IPv4 packet from 192.168.0.1
+// to 192.168.0.199 with proto and payload.
+func testIPv4(proto IPProto, payload []byte) IPv4 {
+	p := NewIPv4()
+	p.SetHeaderLen(20)
+	p.SetPayloadLen(len(payload))
+	p.SetFlagsFragOffset(0x4000)
+	p.SetTtl(64)
+	p.SetProto(uint8(proto))
+	p.SetSrcAddr([4]uint8{192, 168, 0, 1})
+	p.SetDstAddr([4]uint8{192, 168, 0, 199})
+	p.Buf = append(p.Buf, payload...)
+	return p
+}
+
+func TestUpdateChecksum(t *testing.T) {
+	p := testIPv4(IP_PROTO_UDP, make([]byte, 95))
+	p.UpdateChecksum()
+	if c := p.Checksum(); c != 0xB861 {
+		t.Errorf("invalid checksum: actual=%#x want=%#x", c, 0xB861)
+	}
+	if !p.ValidChecksum() {
+		t.Error("updated checksum is not valid")
+	}
+	p.SetTtl(63)
+	if p.ValidChecksum() {
+		t.Error("checksum of a modified header is valid")
+	}
+}
@@ -0,0 +1,377 @@
+// Automatically generated by Packet Go code generator.
+package ipv4
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+
+	"github.com/packet/packet/src/go/packet"
+
+	"github.com/kandoo/beehive-netctrl/net/ethernet"
+)
+
+type IPProto int
+
+const (
+	IP_PROTO_ICMP   IPProto = 1
+	IP_PROTO_TCP    IPProto = 6
+	IP_PROTO_UDP    IPProto = 17
+	IP_PROTO_ICMPV6 IPProto = 58
+)
+
+func NewIPv4WithBuf(b []byte) IPv4 {
+	return IPv4{ethernet.Ethernet{packet.Packet{Buf: b}}}
+}
+
+func NewIPv4() IPv4 {
+	s := 34
+	b := make([]byte, s)
+	p := IPv4{ethernet.Ethernet{packet.Packet{Buf: b}}}
+	p.Init()
+	return p
+}
+
+type IPv4 struct {
+	ethernet.Ethernet
+}
+
+func (this IPv4) minSize() int {
+	return 34
+}
+
+func (this IPv4) Clone() (IPv4, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewIPv4(), err
+	}
+
+	return NewIPv4WithBuf(newBuf.Bytes()), nil
+}
+
+type IPv4Conn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewIPv4Conn(c net.Conn) IPv4Conn {
+	return IPv4Conn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *IPv4Conn) WriteIPv4(pkt IPv4) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *IPv4Conn) WriteIPv4s(pkts []IPv4) error {
+	for _, p := range pkts {
+		if err := c.WriteIPv4(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *IPv4Conn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *IPv4Conn) ReadIPv4() (IPv4, error) {
+	pkts := make([]IPv4, 1)
+	_, err := c.ReadIPv4s(pkts)
+	if err != nil {
+		return NewIPv4(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *IPv4Conn) ReadIPv4s(pkts []IPv4) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewIPv4WithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *IPv4) Init() {
+	this.Ethernet.Init()
+	// Invariants.
+	this.SetType(uint16(2048)) // type
+}
+
+func (this IPv4) Size() int {
+	return 34
+}
+
+func ToIPv4(p ethernet.Ethernet) (IPv4, error) {
+	if !IsIPv4(p) {
+		return NewIPv4WithBuf(nil), errors.New("Cannot convert to ipv4.IPv4")
+	}
+
+	return NewIPv4WithBuf(p.Buf), nil
+}
+
+func IsIPv4(p ethernet.Ethernet) bool {
+	return p.Type() == 2048 && true
+}
+
+func (this IPv4) VersionIhl() uint8 {
+	offset := this.VersionIhlOffset()
+	res := uint8(this.Buf[offset])
+	return res
+}
+
+func (this *IPv4) SetVersionIhl(v uint8) {
+	offset := this.VersionIhlOffset()
+	this.Buf[offset] = byte(v)
+	offset++
+}
+
+func (this IPv4) VersionIhlOffset() int {
+	offset := 14
+	return offset
+}
+
+func (this IPv4) DscpEcn() uint8 {
+	offset := this.DscpEcnOffset()
+	res := uint8(this.Buf[offset])
+	return res
+}
+
+func (this *IPv4) SetDscpEcn(d uint8) {
+	offset := this.DscpEcnOffset()
+	this.Buf[offset] = byte(d)
+	offset++
+}
+
+func (this IPv4) DscpEcnOffset() int {
+	offset := 15
+	return offset
+}
+
+func (this IPv4) TotalLen() uint16 {
+	offset := this.TotalLenOffset()
+	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	return res
+}
+
+func (this *IPv4) SetTotalLen(t uint16) {
+	offset := this.TotalLenOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], t)
+	offset += 2
+}
+
+func (this IPv4) TotalLenOffset() int {
+	offset := 16
+	return offset
+}
+
+func (this IPv4) Id() uint16 {
+	offset := this.IdOffset()
+	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	return res
+}
+
+func (this *IPv4) SetId(i uint16) {
+	offset := this.IdOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], i)
+	offset += 2
+}
+
+func (this IPv4) IdOffset() int {
+	offset := 18
+	return offset
+}
+
+func (this IPv4) FlagsFragOffset() uint16 {
+	offset := this.FlagsFragOffsetOffset()
+	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	return res
+}
+
+func (this *IPv4) SetFlagsFragOffset(f uint16) {
+	offset := this.FlagsFragOffsetOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], f)
+	offset += 2
+}
+
+func (this IPv4) FlagsFragOffsetOffset() int {
+	offset := 20
+	return offset
+}
+
+func (this IPv4) Ttl() uint8 {
+	offset := this.TtlOffset()
+	res := uint8(this.Buf[offset])
+	return res
+}
+
+func (this *IPv4) SetTtl(t uint8) {
+	offset := this.TtlOffset()
+	this.Buf[offset] = byte(t)
+	offset++
+}
+
+func (this IPv4) TtlOffset() int {
+	offset := 22
+	return offset
+}
+
+func (this IPv4) Proto() uint8 {
+	offset := this.ProtoOffset()
+	res := uint8(this.Buf[offset])
+	return res
+}
+
+func (this *IPv4) SetProto(p uint8) {
+	offset := this.ProtoOffset()
+	this.Buf[offset] = byte(p)
+	offset++
+}
+
+func (this IPv4) ProtoOffset() int {
+	offset := 23
+	return offset
+}
+
+func (this IPv4) Checksum() uint16 {
+	offset := this.ChecksumOffset()
+	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	return res
+}
+
+func (this *IPv4) SetChecksum(c uint16) {
+	offset := this.ChecksumOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], c)
+	offset += 2
+}
+
+func (this IPv4) ChecksumOffset() int {
+	offset := 24
+	return offset
+}
+
+func (this IPv4) SrcAddr() [4]uint8 {
+	offset := this.SrcAddrOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := 4
+	i := 0
+	var res [4]uint8
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := uint8(this.Buf[offset])
+		if size < 1 {
+			break
+		}
+		size -= 1
+		offset += 1
+		count--
+		res[i] = elem
+		i++
+	}
+	return res
+}
+
+func (this *IPv4) SetSrcAddr(s [4]uint8) {
+	offset := this.SrcAddrOffset()
+	for _, e := range s {
+		this.Buf[offset] = byte(e)
+		offset++
+	}
+}
+
+func (this IPv4) SrcAddrOffset() int {
+	offset := 26
+	return offset
+}
+
+func (this IPv4) DstAddr() [4]uint8 {
+	offset := this.DstAddrOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := 4
+	i := 0
+	var res [4]uint8
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := uint8(this.Buf[offset])
+		if size < 1 {
+			break
+		}
+		size -= 1
+		offset += 1
+		count--
+		res[i] = elem
+		i++
+	}
+	return res
+}
+
+func (this *IPv4) SetDstAddr(d [4]uint8) {
+	offset := this.DstAddrOffset()
+	for _, e := range d {
+		this.Buf[offset] = byte(e)
+		offset++
+	}
+}
+
+func (this IPv4) DstAddrOffset() int {
+	offset := 30
+	return offset
+}
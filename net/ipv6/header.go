@@ -0,0 +1,34 @@
+package ipv6
+
+import "github.com/kandoo/beehive-netctrl/net/checksum"
+
+// SetTrafficClassAndFlowLabel sets the version to 6 and the traffic class and
+// the flow label of the packet.
+func (this *IPv6) SetTrafficClassAndFlowLabel(tc uint8, fl uint32) {
+	this.SetVersionTcFlow(6<<28 | uint32(tc)<<20 | fl&0x000FFFFF)
+}
+
+// TrafficClass returns the traffic class of the packet.
+func (this IPv6) TrafficClass() uint8 {
+	return uint8(this.VersionTcFlow() >> 20)
+}
+
+// FlowLabel returns the flow label of the packet.
+func (this IPv6) FlowLabel() uint32 {
+	return this.VersionTcFlow() & 0x000FFFFF
+}
+
+// Payload returns the payload of the packet including the extension headers.
+func (this IPv6) Payload() []byte {
+	off := this.DstAddrOffset() + 16
+	return this.Buf[off : off+int(this.PayloadLen())]
+}
+
+// PseudoHeaderSum returns the partial checksum of the pseudo header used in
+// the checksum of the upper-layer protocol next, which has l bytes.
+func (this IPv6) PseudoHeaderSum(next uint8, l int) uint32 {
+	src, dst := this.SrcAddr(), this.DstAddr()
+	sum := checksum.Sum(0, src[:])
+	sum = checksum.Sum(sum, dst[:])
+	return sum + uint32(l>>16) + uint32(l&0xFFFF) + uint32(next)
+}
@@ -0,0 +1,300 @@
+// Automatically generated by Packet Go code generator.
+package ipv6
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+
+	"github.com/packet/packet/src/go/packet"
+
+	"github.com/kandoo/beehive-netctrl/net/ethernet"
+)
+
+func NewIPv6WithBuf(b []byte) IPv6 {
+	return IPv6{ethernet.Ethernet{packet.Packet{Buf: b}}}
+}
+
+func NewIPv6() IPv6 {
+	s := 54
+	b := make([]byte, s)
+	p := IPv6{ethernet.Ethernet{packet.Packet{Buf: b}}}
+	p.Init()
+	return p
+}
+
+type IPv6 struct {
+	ethernet.Ethernet
+}
+
+func (this IPv6) minSize() int {
+	return 54
+}
+
+func (this IPv6) Clone() (IPv6, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewIPv6(), err
+	}
+
+	return NewIPv6WithBuf(newBuf.Bytes()), nil
+}
+
+type IPv6Conn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewIPv6Conn(c net.Conn) IPv6Conn {
+	return IPv6Conn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *IPv6Conn) WriteIPv6(pkt IPv6) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *IPv6Conn) WriteIPv6s(pkts []IPv6) error {
+	for _, p := range pkts {
+		if err := c.WriteIPv6(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *IPv6Conn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *IPv6Conn) ReadIPv6() (IPv6, error) {
+	pkts := make([]IPv6, 1)
+	_, err := c.ReadIPv6s(pkts)
+	if err != nil {
+		return NewIPv6(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *IPv6Conn) ReadIPv6s(pkts []IPv6) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewIPv6WithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *IPv6) Init() {
+	this.Ethernet.Init()
+	// Invariants.
+	this.SetType(uint16(34525)) // type
+}
+
+func (this IPv6) Size() int {
+	return 54
+}
+
+func ToIPv6(p ethernet.Ethernet) (IPv6, error) {
+	if !IsIPv6(p) {
+		return NewIPv6WithBuf(nil), errors.New("Cannot convert to ipv6.IPv6")
+	}
+
+	return NewIPv6WithBuf(p.Buf), nil
+}
+
+func IsIPv6(p ethernet.Ethernet) bool {
+	return p.Type() == 34525 && true
+}
+
+func (this IPv6) VersionTcFlow() uint32 {
+	offset := this.VersionTcFlowOffset()
+	res := binary.BigEndian.Uint32(this.Buf[offset:])
+	return res
+}
+
+func (this *IPv6) SetVersionTcFlow(v uint32) {
+	offset := this.VersionTcFlowOffset()
+	binary.BigEndian.PutUint32(this.Buf[offset:], v)
+	offset += 4
+}
+
+func (this IPv6) VersionTcFlowOffset() int {
+	offset := 14
+	return offset
+}
+
+func (this IPv6) PayloadLen() uint16 {
+	offset := this.PayloadLenOffset()
+	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	return res
+}
+
+func (this *IPv6) SetPayloadLen(p uint16) {
+	offset := this.PayloadLenOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], p)
+	offset += 2
+}
+
+func (this IPv6) PayloadLenOffset() int {
+	offset := 18
+	return offset
+}
+
+func (this IPv6) NextHeader() uint8 {
+	offset := this.NextHeaderOffset()
+	res := uint8(this.Buf[offset])
+	return res
+}
+
+func (this *IPv6) SetNextHeader(n uint8) {
+	offset := this.NextHeaderOffset()
+	this.Buf[offset] = byte(n)
+	offset++
+}
+
+func (this IPv6) NextHeaderOffset() int {
+	offset := 20
+	return offset
+}
+
+func (this IPv6) HopLimit() uint8 {
+	offset := this.HopLimitOffset()
+	res := uint8(this.Buf[offset])
+	return res
+}
+
+func (this *IPv6) SetHopLimit(h uint8) {
+	offset := this.HopLimitOffset()
+	this.Buf[offset] = byte(h)
+	offset++
+}
+
+func (this IPv6) HopLimitOffset() int {
+	offset := 21
+	return offset
+}
+
+func (this IPv6) SrcAddr() [16]uint8 {
+	offset := this.SrcAddrOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := 16
+	i := 0
+	var res [16]uint8
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := uint8(this.Buf[offset])
+		if size < 1 {
+			break
+		}
+		size -= 1
+		offset += 1
+		count--
+		res[i] = elem
+		i++
+	}
+	return res
+}
+
+func (this *IPv6) SetSrcAddr(s [16]uint8) {
+	offset := this.SrcAddrOffset()
+	for _, e := range s {
+		this.Buf[offset] = byte(e)
+		offset++
+	}
+}
+
+func (this IPv6) SrcAddrOffset() int {
+	offset := 22
+	return offset
+}
+
+func (this IPv6) DstAddr() [16]uint8 {
+	offset := this.DstAddrOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := 16
+	i := 0
+	var res [16]uint8
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := uint8(this.Buf[offset])
+		if size < 1 {
+			break
+		}
+		size -= 1
+		offset += 1
+		count--
+		res[i] = elem
+		i++
+	}
+	return res
+}
+
+func (this *IPv6) SetDstAddr(d [16]uint8) {
+	offset := this.DstAddrOffset()
+	for _, e := range d {
+		this.Buf[offset] = byte(e)
+		offset++
+	}
+}
+
+func (this IPv6) DstAddrOffset() int {
+	offset := 38
+	return offset
+}
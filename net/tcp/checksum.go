@@ -0,0 +1,20 @@
+package tcp
+
+import "github.com/kandoo/beehive-netctrl/net/checksum"
+
+// HeaderLen returns the length of the TCP header including its options.
+func (this TCP) HeaderLen() int {
+	return int(this.DataOffset()>>4) * 4
+}
+
+// SetHeaderLen sets the length of the TCP header, in bytes.
+func (this *TCP) SetHeaderLen(l int) {
+	this.SetDataOffset(uint8(l/4) << 4)
+}
+
+// UpdateChecksum computes and sets the checksum of a segment of size bytes,
+// using the partial checksum of the IPv4 or IPv6 pseudo header.
+func (this *TCP) UpdateChecksum(pseudo uint32, size int) {
+	this.SetChecksum(0)
+	this.SetChecksum(checksum.Fold(checksum.Sum(pseudo, this.Buf[:size])))
+}
@@ -0,0 +1,30 @@
+package tcp
+
+import (
+	"testing"
+
+	"github.com/kandoo/beehive-netctrl/net/ipv4"
+)
+
+func TestUpdateChecksum(t *testing.T) {
+	s := NewTCP()
+	s.SetSrcPort(1234)
+	s.SetDstPort(80)
+	s.SetSeq(1)
+	s.SetHeaderLen(20)
+	s.SetFlags(uint8(TCP_SYN))
+	s.SetWindow(0xFFFF)
+
+	ip := ipv4.NewIPv4()
+	ip.SetHeaderLen(20)
+	ip.SetPayloadLen(len(s.Buf))
+	ip.SetProto(uint8(ipv4.IP_PROTO_TCP))
+	ip.SetSrcAddr([4]uint8{192, 168, 0, 1})
+	ip.SetDstAddr([4]uint8{192, 168, 0, 199})
+	ip.Buf = append(ip.Buf, s.Buf...)
+
+	s.UpdateChecksum(ip.PseudoHeaderSum(), len(s.Buf))
+	if c := s.Checksum(); c != 0x28A7 {
+		t.Errorf("invalid checksum: actual=%#x want=%#x", c, 0x28A7)
+	}
+}
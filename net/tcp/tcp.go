@@ -0,0 +1,324 @@
+// Automatically generated by Packet Go code generator.
+package tcp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+
+	"github.com/packet/packet/src/go/packet"
+)
+
+type TCPFlag int
+
+const (
+	TCP_FIN TCPFlag = 1
+	TCP_SYN TCPFlag = 2
+	TCP_RST TCPFlag = 4
+	TCP_PSH TCPFlag = 8
+	TCP_ACK TCPFlag = 16
+	TCP_URG TCPFlag = 32
+)
+
+func NewTCPWithBuf(b []byte) TCP {
+	return TCP{packet.Packet{Buf: b}}
+}
+
+func NewTCP() TCP {
+	s := 20
+	b := make([]byte, s)
+	p := TCP{packet.Packet{Buf: b}}
+	p.Init()
+	return p
+}
+
+type TCP struct {
+	packet.Packet
+}
+
+func (this TCP) minSize() int {
+	return 20
+}
+
+func (this TCP) Clone() (TCP, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewTCP(), err
+	}
+
+	return NewTCPWithBuf(newBuf.Bytes()), nil
+}
+
+type TCPConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewTCPConn(c net.Conn) TCPConn {
+	return TCPConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *TCPConn) WriteTCP(pkt TCP) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *TCPConn) WriteTCPs(pkts []TCP) error {
+	for _, p := range pkts {
+		if err := c.WriteTCP(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *TCPConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *TCPConn) ReadTCP() (TCP, error) {
+	pkts := make([]TCP, 1)
+	_, err := c.ReadTCPs(pkts)
+	if err != nil {
+		return NewTCP(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *TCPConn) ReadTCPs(pkts []TCP) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewTCPWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *TCP) Init() {
+	// Invariants.
+}
+
+func (this TCP) Size() int {
+	return 20
+}
+
+func ToTCP(p packet.Packet) (TCP, error) {
+	if !IsTCP(p) {
+		return NewTCPWithBuf(nil), errors.New("Cannot convert to tcp.TCP")
+	}
+
+	return NewTCPWithBuf(p.Buf), nil
+}
+
+func IsTCP(p packet.Packet) bool {
+	return true
+}
+
+func (this TCP) SrcPort() uint16 {
+	offset := this.SrcPortOffset()
+	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	return res
+}
+
+func (this *TCP) SetSrcPort(s uint16) {
+	offset := this.SrcPortOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], s)
+	offset += 2
+}
+
+func (this TCP) SrcPortOffset() int {
+	offset := 0
+	return offset
+}
+
+func (this TCP) DstPort() uint16 {
+	offset := this.DstPortOffset()
+	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	return res
+}
+
+func (this *TCP) SetDstPort(d uint16) {
+	offset := this.DstPortOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], d)
+	offset += 2
+}
+
+func (this TCP) DstPortOffset() int {
+	offset := 2
+	return offset
+}
+
+func (this TCP) Seq() uint32 {
+	offset := this.SeqOffset()
+	res := binary.BigEndian.Uint32(this.Buf[offset:])
+	return res
+}
+
+func (this *TCP) SetSeq(s uint32) {
+	offset := this.SeqOffset()
+	binary.BigEndian.PutUint32(this.Buf[offset:], s)
+	offset += 4
+}
+
+func (this TCP) SeqOffset() int {
+	offset := 4
+	return offset
+}
+
+func (this TCP) Ack() uint32 {
+	offset := this.AckOffset()
+	res := binary.BigEndian.Uint32(this.Buf[offset:])
+	return res
+}
+
+func (this *TCP) SetAck(a uint32) {
+	offset := this.AckOffset()
+	binary.BigEndian.PutUint32(this.Buf[offset:], a)
+	offset += 4
+}
+
+func (this TCP) AckOffset() int {
+	offset := 8
+	return offset
+}
+
+func (this TCP) DataOffset() uint8 {
+	offset := this.DataOffsetOffset()
+	res := uint8(this.Buf[offset])
+	return res
+}
+
+func (this *TCP) SetDataOffset(d uint8) {
+	offset := this.DataOffsetOffset()
+	this.Buf[offset] = byte(d)
+	offset++
+}
+
+func (this TCP) DataOffsetOffset() int {
+	offset := 12
+	return offset
+}
+
+func (this TCP) Flags() uint8 {
+	offset := this.FlagsOffset()
+	res := uint8(this.Buf[offset])
+	return res
+}
+
+func (this *TCP) SetFlags(f uint8) {
+	offset := this.FlagsOffset()
+	this.Buf[offset] = byte(f)
+	offset++
+}
+
+func (this TCP) FlagsOffset() int {
+	offset := 13
+	return offset
+}
+
+func (this TCP) Window() uint16 {
+	offset := this.WindowOffset()
+	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	return res
+}
+
+func (this *TCP) SetWindow(w uint16) {
+	offset := this.WindowOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], w)
+	offset += 2
+}
+
+func (this TCP) WindowOffset() int {
+	offset := 14
+	return offset
+}
+
+func (this TCP) Checksum() uint16 {
+	offset := this.ChecksumOffset()
+	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	return res
+}
+
+func (this *TCP) SetChecksum(c uint16) {
+	offset := this.ChecksumOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], c)
+	offset += 2
+}
+
+func (this TCP) ChecksumOffset() int {
+	offset := 16
+	return offset
+}
+
+func (this TCP) UrgentPtr() uint16 {
+	offset := this.UrgentPtrOffset()
+	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	return res
+}
+
+func (this *TCP) SetUrgentPtr(u uint16) {
+	offset := this.UrgentPtrOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], u)
+	offset += 2
+}
+
+func (this TCP) UrgentPtrOffset() int {
+	offset := 18
+	return offset
+}
@@ -0,0 +1,19 @@
+package udp
+
+import "github.com/kandoo/beehive-netctrl/net/checksum"
+
+// Payload returns the payload of the datagram.
+func (this UDP) Payload() []byte {
+	return this.Buf[this.Size():this.Len()]
+}
+
+// UpdateChecksum computes and sets the checksum of the datagram, using the
+// partial checksum of the IPv4 or IPv6 pseudo header.
+func (this *UDP) UpdateChecksum(pseudo uint32) {
+	this.SetChecksum(0)
+	c := checksum.Fold(checksum.Sum(pseudo, this.Buf[:this.Len()]))
+	if c == 0 {
+		c = 0xFFFF
+	}
+	this.SetChecksum(c)
+}
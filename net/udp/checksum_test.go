@@ -0,0 +1,28 @@
+package udp
+
+import (
+	"testing"
+
+	"github.com/kandoo/beehive-netctrl/net/ipv4"
+)
+
+func TestUpdateChecksum(t *testing.T) {
+	u := NewUDP()
+	u.SetSrcPort(1234)
+	u.SetDstPort(53)
+	u.SetLen(12)
+	u.Buf = append(u.Buf, "abcd"...)
+
+	ip := ipv4.NewIPv4()
+	ip.SetHeaderLen(20)
+	ip.SetPayloadLen(len(u.Buf))
+	ip.SetProto(uint8(ipv4.IP_PROTO_UDP))
+	ip.SetSrcAddr([4]uint8{192, 168, 0, 1})
+	ip.SetDstAddr([4]uint8{192, 168, 0, 199})
+	ip.Buf = append(ip.Buf, u.Buf...)
+
+	u.UpdateChecksum(ip.PseudoHeaderSum())
+	if c := u.Checksum(); c != 0xB3EF {
+		t.Errorf("invalid checksum: actual=%#x want=%#x", c, 0xB3EF)
+	}
+}
@@ -0,0 +1,228 @@
+// Automatically generated by Packet Go code generator.
+package udp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+
+	"github.com/packet/packet/src/go/packet"
+)
+
+func NewUDPWithBuf(b []byte) UDP {
+	return UDP{packet.Packet{Buf: b}}
+}
+
+func NewUDP() UDP {
+	s := 8
+	b := make([]byte, s)
+	p := UDP{packet.Packet{Buf: b}}
+	p.Init()
+	return p
+}
+
+type UDP struct {
+	packet.Packet
+}
+
+func (this UDP) minSize() int {
+	return 8
+}
+
+func (this UDP) Clone() (UDP, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewUDP(), err
+	}
+
+	return NewUDPWithBuf(newBuf.Bytes()), nil
+}
+
+type UDPConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewUDPConn(c net.Conn) UDPConn {
+	return UDPConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *UDPConn) WriteUDP(pkt UDP) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *UDPConn) WriteUDPs(pkts []UDP) error {
+	for _, p := range pkts {
+		if err := c.WriteUDP(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *UDPConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *UDPConn) ReadUDP() (UDP, error) {
+	pkts := make([]UDP, 1)
+	_, err := c.ReadUDPs(pkts)
+	if err != nil {
+		return NewUDP(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *UDPConn) ReadUDPs(pkts []UDP) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewUDPWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *UDP) Init() {
+	// Invariants.
+}
+
+func (this UDP) Size() int {
+	return 8
+}
+
+func ToUDP(p packet.Packet) (UDP, error) {
+	if !IsUDP(p) {
+		return NewUDPWithBuf(nil), errors.New("Cannot convert to udp.UDP")
+	}
+
+	return NewUDPWithBuf(p.Buf), nil
+}
+
+func IsUDP(p packet.Packet) bool {
+	return true
+}
+
+func (this UDP) SrcPort() uint16 {
+	offset := this.SrcPortOffset()
+	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	return res
+}
+
+func (this *UDP) SetSrcPort(s uint16) {
+	offset := this.SrcPortOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], s)
+	offset += 2
+}
+
+func (this UDP) SrcPortOffset() int {
+	offset := 0
+	return offset
+}
+
+func (this UDP) DstPort() uint16 {
+	offset := this.DstPortOffset()
+	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	return res
+}
+
+func (this *UDP) SetDstPort(d uint16) {
+	offset := this.DstPortOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], d)
+	offset += 2
+}
+
+func (this UDP) DstPortOffset() int {
+	offset := 2
+	return offset
+}
+
+func (this UDP) Len() uint16 {
+	offset := this.LenOffset()
+	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	return res
+}
+
+func (this *UDP) SetLen(l uint16) {
+	offset := this.LenOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], l)
+	offset += 2
+}
+
+func (this UDP) LenOffset() int {
+	offset := 4
+	return offset
+}
+
+func (this UDP) Checksum() uint16 {
+	offset := this.ChecksumOffset()
+	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	return res
+}
+
+func (this *UDP) SetChecksum(c uint16) {
+	offset := this.ChecksumOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], c)
+	offset += 2
+}
+
+func (this UDP) ChecksumOffset() int {
+	offset := 6
+	return offset
+}
@@ -0,0 +1,68 @@
+package nom
+
+import (
+	"encoding/gob"
+	"fmt"
+)
+
+// ACLAction is the action of an access control rule.
+type ACLAction int
+
+// Valid values for ACLAction.
+const (
+	ACLAllow ACLAction = iota // Allow the matching packets.
+	ACLDeny                   // Drop the matching packets.
+)
+
+func (a ACLAction) String() string {
+	switch a {
+	case ACLAllow:
+		return "allow"
+	case ACLDeny:
+		return "deny"
+	default:
+		return fmt.Sprintf("ACLAction(%d)", int(a))
+	}
+}
+
+// ACLRule is an access control rule. Packets are checked against the rules in
+// order, and the first matching rule decides whether the packet is allowed.
+type ACLRule struct {
+	Match  Match     // Packets matching this rule.
+	Action ACLAction // The action applied to the matching packets.
+}
+
+func (r ACLRule) String() string {
+	return fmt.Sprintf("%v %v", r.Action, r.Match)
+}
+
+// UpdateACL is emitted to replace the access control rules of the network.
+type UpdateACL struct {
+	Rules []ACLRule
+}
+
+// ACLStatsQuery queries the hit counters of the access control rules.
+type ACLStatsQuery struct{}
+
+// ACLRuleStats is the statistics of an access control rule, aggregated over
+// all the nodes.
+type ACLRuleStats struct {
+	Rule    ACLRule
+	Packets uint64
+	Bytes   uint64
+}
+
+// ACLStatsQueryResult is the result for an ACLStatsQuery. The stats are in the
+// order of the rules.
+type ACLStatsQueryResult struct {
+	Stats []ACLRuleStats
+}
+
+func init() {
+	gob.Register(ACLAction(0))
+	gob.Register(ACLRule{})
+	gob.Register(ACLRuleStats{})
+	gob.Register(ACLStatsQuery{})
+	gob.Register(ACLStatsQueryResult{})
+	gob.Register(UpdateACL{})
+}
@@ -110,6 +110,21 @@ func (mm MaskedMACAddr) Subsumes(thatmm MaskedMACAddr) bool {
 	return mm.Match(thatmm.Addr.Mask(thatmm.Mask))
 }
 
+// Intersect returns the masked address that matches exactly the addresses
+// matched by both mm and thatmm. It returns false if there is no such address.
+func (mm MaskedMACAddr) Intersect(thatmm MaskedMACAddr) (MaskedMACAddr, bool) {
+	var res MaskedMACAddr
+	for i := range mm.Addr {
+		common := mm.Mask[i] & thatmm.Mask[i]
+		if mm.Addr[i]&common != thatmm.Addr[i]&common {
+			return MaskedMACAddr{}, false
+		}
+		res.Addr[i] = mm.Addr[i]&mm.Mask[i] | thatmm.Addr[i]&thatmm.Mask[i]
+		res.Mask[i] = mm.Mask[i] | thatmm.Mask[i]
+	}
+	return res, true
+}
+
 // IPv4Addr represents an IP version 4 address in big endian byte order.
 // For example, 127.0.0.1 is represented as IPv4Addr{127, 0, 0, 1}.
 type IPv4Addr [4]byte
@@ -208,6 +223,23 @@ func (mi MaskedIPv4Addr) Subsumes(thatmi MaskedIPv4Addr) bool {
 	return mi.Addr.Mask(mi.Mask) == thatmi.Addr.Mask(mi.Mask)
 }
 
+// Intersect returns the masked address that matches exactly the addresses
+// matched by both mi and thatmi. It returns false if there is no such address.
+func (mi MaskedIPv4Addr) Intersect(thatmi MaskedIPv4Addr) (MaskedIPv4Addr,
+	bool) {
+
+	var res MaskedIPv4Addr
+	for i := range mi.Addr {
+		common := mi.Mask[i] & thatmi.Mask[i]
+		if mi.Addr[i]&common != thatmi.Addr[i]&common {
+			return MaskedIPv4Addr{}, false
+		}
+		res.Addr[i] = mi.Addr[i]&mi.Mask[i] | thatmi.Addr[i]&thatmi.Mask[i]
+		res.Mask[i] = mi.Mask[i] | thatmi.Mask[i]
+	}
+	return res, true
+}
+
 func (mi MaskedIPv4Addr) String() string {
 	return fmt.Sprintf("%v/%d", mi.Addr, mi.Mask.AsCIDRMask())
 }
@@ -296,6 +328,23 @@ func (mi MaskedIPv6Addr) Subsumes(thatmi MaskedIPv6Addr) bool {
 	return mi.Addr.Mask(mi.Mask) == thatmi.Addr.Mask(mi.Mask)
 }
 
+// Intersect returns the masked address that matches exactly the addresses
+// matched by both mi and thatmi. It returns false if there is no such address.
+func (mi MaskedIPv6Addr) Intersect(thatmi MaskedIPv6Addr) (MaskedIPv6Addr,
+	bool) {
+
+	var res MaskedIPv6Addr
+	for i := range mi.Addr {
+		common := mi.Mask[i] & thatmi.Mask[i]
+		if mi.Addr[i]&common != thatmi.Addr[i]&common {
+			return MaskedIPv6Addr{}, false
+		}
+		res.Addr[i] = mi.Addr[i]&mi.Mask[i] | thatmi.Addr[i]&thatmi.Mask[i]
+		res.Mask[i] = mi.Mask[i] | thatmi.Mask[i]
+	}
+	return res, true
+}
+
 func (mi MaskedIPv6Addr) String() string {
 	return fmt.Sprintf("%v/%d", mi.Addr, mi.Mask.AsCIDRMask())
 }
@@ -0,0 +1,74 @@
+package nom
+
+import (
+	"encoding/gob"
+
+	bh "github.com/kandoo/beehive"
+)
+
+// ApplyBatch is emitted to add and remove a collection of flow entries,
+// possibly on multiple nodes, as one transaction. The controller ensures that
+// either all the changes are applied or none of them, and replies to the
+// subscriber with either BatchApplied or BatchFailed.
+type ApplyBatch struct {
+	ID         string // ID is defined by the subscriber, not globally unique.
+	Adds       []AddFlowEntry
+	Dels       []DelFlowEntry
+	Subscriber bh.AppCellKey
+}
+
+// Nodes returns the nodes that are modified by this batch.
+func (b ApplyBatch) Nodes() []UID {
+	var nodes []UID
+	seen := make(map[UID]struct{})
+	add := func(n UID) {
+		if _, ok := seen[n]; ok {
+			return
+		}
+		seen[n] = struct{}{}
+		nodes = append(nodes, n)
+	}
+	for _, a := range b.Adds {
+		add(a.Flow.Node)
+	}
+	for _, d := range b.Dels {
+		add(d.Node)
+	}
+	return nodes
+}
+
+// BatchApplied is sent to the subscriber of a batch when all the changes in
+// the batch are applied.
+type BatchApplied struct {
+	ID string
+}
+
+// BatchFailed is sent to the subscriber of a batch when a node fails to apply
+// its changes. The changes applied on other nodes are rolled back.
+type BatchFailed struct {
+	ID  string
+	Err DriverError // The error of the first failed node.
+}
+
+// Barrier is sent to a driver to make sure all the previous messages are
+// processed by the node. The driver replies with BarrierReply.
+type Barrier struct {
+	Node UID
+	ID   string
+}
+
+// BarrierReply is sent by the driver to the issuer of a barrier when the node
+// has processed all the messages sent before the barrier. The errors of those
+// messages are always reported before the barrier reply.
+type BarrierReply struct {
+	Node UID
+	ID   string
+}
+
+func init() {
+	gob.Register(ApplyBatch{})
+	gob.Register(Barrier{})
+	gob.Register(BarrierReply{})
+	gob.Register(BatchApplied{})
+	gob.Register(BatchFailed{})
+}
@@ -0,0 +1,27 @@
+package nom
+
+import "testing"
+
+func TestApplyBatchNodes(t *testing.T) {
+	b := ApplyBatch{
+		Adds: []AddFlowEntry{
+			{Flow: FlowEntry{Node: "n1"}},
+			{Flow: FlowEntry{Node: "n2"}},
+			{Flow: FlowEntry{Node: "n1"}},
+		},
+		Dels: []DelFlowEntry{
+			{Node: "n3"},
+			{Node: "n2"},
+		},
+	}
+	nodes := b.Nodes()
+	want := []UID{"n1", "n2", "n3"}
+	if len(nodes) != len(want) {
+		t.Fatalf("invalid nodes: actual=%v want=%v", nodes, want)
+	}
+	for i := range want {
+		if nodes[i] != want[i] {
+			t.Errorf("invalid node: actual=%v want=%v", nodes[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package nom
+
+import (
+	"encoding/gob"
+	"fmt"
+)
+
+// DriverError is sent by the driver to the bee that has issued a message,
+// when the node fails to process that message.
+type DriverError struct {
+	Node    UID
+	Code    DriverErrorCode
+	Err     string      // The error as reported by the node.
+	Request interface{} // The message that has caused the error.
+}
+
+func (e DriverError) Error() string {
+	return fmt.Sprintf("error on %v: %v (%v)", e.Node, e.Code, e.Err)
+}
+
+// DriverErrorCode is the NOM specific category of a driver error.
+type DriverErrorCode uint8
+
+// Valid values for DriverErrorCode.
+const (
+	DriverErrUnknown          DriverErrorCode = iota // Unknown error.
+	DriverErrBadRequest                       = iota // Malformed request.
+	DriverErrUnsupported                      = iota // Unsupported request.
+	DriverErrBadMatch                         = iota // Invalid match.
+	DriverErrBadAction                        = iota // Invalid actions.
+	DriverErrBadPort                          = iota // Port does not exist.
+	DriverErrBadBuffer                        = iota // Buffer does not exist.
+	DriverErrTableFull                        = iota // Flow table is full.
+	DriverErrOverlap                          = iota // Overlapping flows.
+	DriverErrPermissionDenied                 = iota // Permission denied.
+	DriverErrIsSlave                          = iota // The driver is slave.
+	DriverErrStaleRole                        = iota // Stale role request.
+	DriverErrDisconnected                     = iota // Node disconnected.
+	DriverErrTimeout                          = iota // Request timed out.
+)
+
+var driverErrorCodeStrings = map[DriverErrorCode]string{
+	DriverErrUnknown:          "unknown error",
+	DriverErrBadRequest:       "bad request",
+	DriverErrUnsupported:      "unsupported request",
+	DriverErrBadMatch:         "bad match",
+	DriverErrBadAction:        "bad action",
+	DriverErrBadPort:          "bad port",
+	DriverErrBadBuffer:        "bad buffer",
+	DriverErrTableFull:        "table full",
+	DriverErrOverlap:          "overlapping flow",
+	DriverErrPermissionDenied: "permission denied",
+	DriverErrIsSlave:          "driver is slave",
+	DriverErrStaleRole:        "stale role request",
+	DriverErrDisconnected:     "node disconnected",
+	DriverErrTimeout:          "request timed out",
+}
+
+func (c DriverErrorCode) String() string {
+	if s, ok := driverErrorCodeStrings[c]; ok {
+		return s
+	}
+	return fmt.Sprintf("driver error %d", uint8(c))
+}
+
+func init() {
+	gob.Register(DriverError{})
+	gob.Register(DriverErrorCode(0))
+}
@@ -134,6 +134,9 @@ func (e EthType) String() string {
 // VLANID represents the field for the VLAN ID.
 type VLANID uint16
 
+// VLANNone is the VLAN ID that matches the packets without a VLAN tag.
+const VLANNone VLANID = 0xFFFF
+
 func (e VLANID) HasSameType(f Field) bool {
 	switch f.(type) {
 	case VLANID:
@@ -155,6 +158,9 @@ func (e VLANID) Subsumes(f Field) bool {
 }
 
 func (e VLANID) String() string {
+	if e == VLANNone {
+		return "vlan=none"
+	}
 	return fmt.Sprintf("vlan=%v", uint16(e))
 }
 
@@ -200,7 +206,7 @@ func (p IPProto) Subsumes(f Field) bool {
 }
 
 func (p IPProto) String() string {
-	return fmt.Sprint("ip_proto=%v", uint8(p))
+	return fmt.Sprintf("ip_proto=%v", uint8(p))
 }
 
 type IPv4Src MaskedIPv4Addr
@@ -375,11 +381,323 @@ func (p TransportPortDst) String() string {
 	return fmt.Sprintf("tp_port_dst=%v", uint16(p))
 }
 
+// ARPOp is the field for the ARP opcode.
+type ARPOp uint16
+
+func (o ARPOp) HasSameType(f Field) bool {
+	_, ok := f.(ARPOp)
+	return ok
+}
+
+func (o ARPOp) Equals(f Field) bool {
+	if fo, ok := f.(ARPOp); ok {
+		return o == fo
+	}
+	return false
+}
+
+func (o ARPOp) Subsumes(f Field) bool {
+	return o.Equals(f)
+}
+
+func (o ARPOp) String() string {
+	return fmt.Sprintf("arp_op=%v", uint16(o))
+}
+
+// ARPSpa is the field for the ARP source protocol (IPv4) address.
+type ARPSpa MaskedIPv4Addr
+
+func (a ARPSpa) HasSameType(f Field) bool {
+	_, ok := f.(ARPSpa)
+	return ok
+}
+
+func (a ARPSpa) Equals(f Field) bool {
+	if fa, ok := f.(ARPSpa); ok {
+		return a == fa
+	}
+	return false
+}
+
+func (a ARPSpa) Subsumes(f Field) bool {
+	if fa, ok := f.(ARPSpa); ok {
+		return MaskedIPv4Addr(a).Subsumes(MaskedIPv4Addr(fa))
+	}
+	return false
+}
+
+func (a ARPSpa) String() string {
+	return fmt.Sprintf("arp_spa=%v", MaskedIPv4Addr(a))
+}
+
+// ARPTpa is the field for the ARP target protocol (IPv4) address.
+type ARPTpa MaskedIPv4Addr
+
+func (a ARPTpa) HasSameType(f Field) bool {
+	_, ok := f.(ARPTpa)
+	return ok
+}
+
+func (a ARPTpa) Equals(f Field) bool {
+	if fa, ok := f.(ARPTpa); ok {
+		return a == fa
+	}
+	return false
+}
+
+func (a ARPTpa) Subsumes(f Field) bool {
+	if fa, ok := f.(ARPTpa); ok {
+		return MaskedIPv4Addr(a).Subsumes(MaskedIPv4Addr(fa))
+	}
+	return false
+}
+
+func (a ARPTpa) String() string {
+	return fmt.Sprintf("arp_tpa=%v", MaskedIPv4Addr(a))
+}
+
+// ICMPv4Type is the field for the ICMP type.
+type ICMPv4Type uint8
+
+func (t ICMPv4Type) HasSameType(f Field) bool {
+	_, ok := f.(ICMPv4Type)
+	return ok
+}
+
+func (t ICMPv4Type) Equals(f Field) bool {
+	if ft, ok := f.(ICMPv4Type); ok {
+		return t == ft
+	}
+	return false
+}
+
+func (t ICMPv4Type) Subsumes(f Field) bool {
+	return t.Equals(f)
+}
+
+func (t ICMPv4Type) String() string {
+	return fmt.Sprintf("icmpv4_type=%v", uint8(t))
+}
+
+// ICMPv4Code is the field for the ICMP code.
+type ICMPv4Code uint8
+
+func (c ICMPv4Code) HasSameType(f Field) bool {
+	_, ok := f.(ICMPv4Code)
+	return ok
+}
+
+func (c ICMPv4Code) Equals(f Field) bool {
+	if fc, ok := f.(ICMPv4Code); ok {
+		return c == fc
+	}
+	return false
+}
+
+func (c ICMPv4Code) Subsumes(f Field) bool {
+	return c.Equals(f)
+}
+
+func (c ICMPv4Code) String() string {
+	return fmt.Sprintf("icmpv4_code=%v", uint8(c))
+}
+
+// ICMPv6Type is the field for the ICMPv6 type.
+type ICMPv6Type uint8
+
+func (t ICMPv6Type) HasSameType(f Field) bool {
+	_, ok := f.(ICMPv6Type)
+	return ok
+}
+
+func (t ICMPv6Type) Equals(f Field) bool {
+	if ft, ok := f.(ICMPv6Type); ok {
+		return t == ft
+	}
+	return false
+}
+
+func (t ICMPv6Type) Subsumes(f Field) bool {
+	return t.Equals(f)
+}
+
+func (t ICMPv6Type) String() string {
+	return fmt.Sprintf("icmpv6_type=%v", uint8(t))
+}
+
+// ICMPv6Code is the field for the ICMPv6 code.
+type ICMPv6Code uint8
+
+func (c ICMPv6Code) HasSameType(f Field) bool {
+	_, ok := f.(ICMPv6Code)
+	return ok
+}
+
+func (c ICMPv6Code) Equals(f Field) bool {
+	if fc, ok := f.(ICMPv6Code); ok {
+		return c == fc
+	}
+	return false
+}
+
+func (c ICMPv6Code) Subsumes(f Field) bool {
+	return c.Equals(f)
+}
+
+func (c ICMPv6Code) String() string {
+	return fmt.Sprintf("icmpv6_code=%v", uint8(c))
+}
+
+// IPDSCP is the field for the 6-bit DSCP of the IP ToS (traffic class).
+type IPDSCP uint8
+
+func (d IPDSCP) HasSameType(f Field) bool {
+	_, ok := f.(IPDSCP)
+	return ok
+}
+
+func (d IPDSCP) Equals(f Field) bool {
+	if fd, ok := f.(IPDSCP); ok {
+		return d == fd
+	}
+	return false
+}
+
+func (d IPDSCP) Subsumes(f Field) bool {
+	return d.Equals(f)
+}
+
+func (d IPDSCP) String() string {
+	return fmt.Sprintf("ip_dscp=%v", uint8(d))
+}
+
+// IPECN is the field for the 2-bit ECN of the IP ToS (traffic class).
+type IPECN uint8
+
+func (e IPECN) HasSameType(f Field) bool {
+	_, ok := f.(IPECN)
+	return ok
+}
+
+func (e IPECN) Equals(f Field) bool {
+	if fe, ok := f.(IPECN); ok {
+		return e == fe
+	}
+	return false
+}
+
+func (e IPECN) Subsumes(f Field) bool {
+	return e.Equals(f)
+}
+
+func (e IPECN) String() string {
+	return fmt.Sprintf("ip_ecn=%v", uint8(e))
+}
+
+// MPLSLabel is the field for the 20-bit label of the outermost MPLS tag.
+type MPLSLabel uint32
+
+func (l MPLSLabel) HasSameType(f Field) bool {
+	_, ok := f.(MPLSLabel)
+	return ok
+}
+
+func (l MPLSLabel) Equals(f Field) bool {
+	if fl, ok := f.(MPLSLabel); ok {
+		return l == fl
+	}
+	return false
+}
+
+func (l MPLSLabel) Subsumes(f Field) bool {
+	return l.Equals(f)
+}
+
+func (l MPLSLabel) String() string {
+	return fmt.Sprintf("mpls_label=%v", uint32(l))
+}
+
+// Masks that match the exact value of masked fields.
+const (
+	MaskNoneIPv6FlowLabel uint32 = 0x000FFFFF
+	MaskNoneMetadata      uint64 = 0xFFFFFFFFFFFFFFFF
+)
+
+// IPv6FlowLabel is the field for the 20-bit IPv6 flow label. Only the bits set
+// in Mask are matched.
+type IPv6FlowLabel struct {
+	Label uint32
+	Mask  uint32
+}
+
+func (l IPv6FlowLabel) HasSameType(f Field) bool {
+	_, ok := f.(IPv6FlowLabel)
+	return ok
+}
+
+func (l IPv6FlowLabel) Equals(f Field) bool {
+	if fl, ok := f.(IPv6FlowLabel); ok {
+		return l == fl
+	}
+	return false
+}
+
+func (l IPv6FlowLabel) Subsumes(f Field) bool {
+	if fl, ok := f.(IPv6FlowLabel); ok {
+		return l.Mask&fl.Mask == l.Mask && l.Label&l.Mask == fl.Label&l.Mask
+	}
+	return false
+}
+
+func (l IPv6FlowLabel) String() string {
+	return fmt.Sprintf("ipv6_flabel=%v/%#x", l.Label, l.Mask)
+}
+
+// Metadata is the field for the metadata passed between flow tables. Only the
+// bits set in Mask are matched.
+type Metadata struct {
+	Data uint64
+	Mask uint64
+}
+
+func (m Metadata) HasSameType(f Field) bool {
+	_, ok := f.(Metadata)
+	return ok
+}
+
+func (m Metadata) Equals(f Field) bool {
+	if fm, ok := f.(Metadata); ok {
+		return m == fm
+	}
+	return false
+}
+
+func (m Metadata) Subsumes(f Field) bool {
+	if fm, ok := f.(Metadata); ok {
+		return m.Mask&fm.Mask == m.Mask && m.Data&m.Mask == fm.Data&m.Mask
+	}
+	return false
+}
+
+func (m Metadata) String() string {
+	return fmt.Sprintf("metadata=%#x/%#x", m.Data, m.Mask)
+}
+
 // Valid values for EthType.
 const (
-	EthTypeIPv4 EthType = 0x0800
-	EthTypeIPv6         = 0x86DD
-	EthTypeARP          = 0x0806
+	EthTypeIPv4      EthType = 0x0800
+	EthTypeIPv6              = 0x86DD
+	EthTypeARP               = 0x0806
+	EthTypeMPLS              = 0x8847
+	EthTypeMPLSMcast         = 0x8848
+)
+
+// Valid values for IPProto.
+const (
+	IPProtoICMP   IPProto = 1
+	IPProtoTCP    IPProto = 6
+	IPProtoUDP    IPProto = 17
+	IPProtoICMPv6 IPProto = 58
 )
 
 type Field interface {
@@ -519,6 +837,16 @@ func (m Match) VLANPCP() (VLANPCP, bool) {
 	return VLANPCP(0), false
 }
 
+func (m Match) IPProto() (IPProto, bool) {
+	for _, f := range m.Fields {
+		switch field := f.(type) {
+		case IPProto:
+			return field, true
+		}
+	}
+	return IPProto(0), false
+}
+
 func (m Match) TransportPortSrc() (TransportPortSrc, bool) {
 	for _, f := range m.Fields {
 		switch field := f.(type) {
@@ -649,6 +977,19 @@ func (a ActionSendToController) Equals(thata Action) bool {
 	return ok
 }
 
+// ActionNormal processes the packet using the normal L2/L3 pipeline of the
+// node. It is only supported by hybrid nodes.
+type ActionNormal struct{}
+
+func (a ActionNormal) Equals(thata Action) bool {
+	_, ok := thata.(ActionNormal)
+	return ok
+}
+
+func (a ActionNormal) String() string {
+	return "normal"
+}
+
 type ActionPushVLAN struct {
 	ID VLANID
 }
@@ -674,7 +1015,7 @@ type ActionWriteFields struct {
 
 func (a ActionWriteFields) Equals(thata Action) bool {
 	thataw, ok := thata.(ActionWriteFields)
-	if !ok {
+	if !ok || len(a.Fields) != len(thataw.Fields) {
 		return false
 	}
 	for i := range a.Fields {
@@ -689,6 +1030,7 @@ func (a ActionWriteFields) Equals(thata Action) bool {
 type FlowEntry struct {
 	ID          string // ID is defined by the subscriber, not globally unique.
 	Node        UID
+	Cookie      uint64 // Cookie is assigned by the controller, unique per node.
 	Match       Match
 	Actions     []Action
 	Priority    uint16
@@ -702,8 +1044,9 @@ func (f FlowEntry) String() string {
 		a[i] = f.Actions[i]
 	}
 	astr := strings.Join(a, ",")
-	return fmt.Sprintf("flow(%v=>%v,node=%v,priority=%v,idleto=%v,hardto=%v)",
-		f.Match, astr, f.Node, f.Priority, f.IdleTimeout, f.HardTimeout)
+	return fmt.Sprintf("flow(%v=>%v,node=%v,cookie=%#x,priority=%v,idleto=%v,"+
+		"hardto=%v)", f.Match, astr, f.Node, f.Cookie, f.Priority, f.IdleTimeout,
+		f.HardTimeout)
 }
 
 func (f FlowEntry) Equals(thatf FlowEntry) bool {
@@ -742,18 +1085,31 @@ type AddFlowEntry struct {
 	Flow       FlowEntry
 }
 
-// DelFlowEntry is emitted to remove the flow entries with the given match.
-// If Exact is false, it removes all flow entries that are subsumed by the
-// given match.
+// DelFlowEntry is emitted to remove the flow entries with the given match from
+// Node. If Node is empty, the flow entries are removed from all nodes. If Exact
+// is false, it removes all flow entries that are subsumed by the given match.
+// Otherwise, it only removes the flow entry that has exactly the same match and
+// priority. If Cookie is not zero, only the flow entry installed through the
+// controller with that cookie on Node is removed, and Match, Priority and
+// Exact are ignored.
 type DelFlowEntry struct {
-	Match Match
-	Exact bool
+	Node     UID
+	Match    Match
+	Priority uint16
+	Exact    bool
+	Cookie   uint64
 }
 
 // FlowEntryDeleted is emitted (broadcasted and also sent to the subscriber of
-// the flow) when a flow is deleted.
+// the flow) when a flow is deleted. Duration, Packets and Bytes are the final
+// statistics of the flow if the node has reported them, and the latest polled
+// statistics otherwise.
 type FlowEntryDeleted struct {
-	Flow FlowEntry
+	Flow     FlowEntry
+	Reason   FlowRemovedReason
+	Duration time.Duration
+	Packets  uint64
+	Bytes    uint64
 }
 
 // FlowEntryAdded is emitted (broadcasted and also sent to the subscriber of the
@@ -763,15 +1119,54 @@ type FlowEntryAdded struct {
 	Flow FlowEntry
 }
 
+// FlowEntryInstalled is emitted by the driver when the node confirms that it
+// has installed a flow entry. The controller in turn notifies the subscribers
+// of the flow using FlowEntryAdded.
+type FlowEntryInstalled struct {
+	Flow FlowEntry
+}
+
+// FlowEntryFailed is emitted (broadcasted and also sent to the subscribers of
+// the flow) when the node rejects a flow entry.
+type FlowEntryFailed struct {
+	Flow FlowEntry
+	Err  DriverError
+}
+
+// FlowEntryRemoved is emitted by the driver when the switch removes a flow
+// (e.g., when the flow times out or is deleted). The controller in turn
+// notifies the subscribers of the flow using FlowEntryDeleted.
+type FlowEntryRemoved struct {
+	Flow     FlowEntry
+	Reason   FlowRemovedReason
+	Duration time.Duration
+	Packets  uint64
+	Bytes    uint64
+}
+
+// FlowRemovedReason is the reason a flow is removed from a node.
+type FlowRemovedReason uint8
+
+// Valid values for FlowRemovedReason.
+const (
+	FlowRemovedIdleTimeout FlowRemovedReason = iota // Idle timeout expired.
+	FlowRemovedHardTimeout                   = iota // Hard timeout expired.
+	FlowRemovedDeleted                       = iota // Deleted by a flow-mod.
+)
+
 func init() {
 	gob.Register(ActionDrop{})
 	gob.Register(ActionFlood{})
 	gob.Register(ActionForward{})
+	gob.Register(ActionNormal{})
 	gob.Register(ActionPopVLAN{})
 	gob.Register(ActionPushVLAN{})
 	gob.Register(ActionSendToController{})
 	gob.Register(ActionWriteFields{})
 	gob.Register(AddFlowEntry{})
+	gob.Register(ARPOp(0))
+	gob.Register(ARPSpa{})
+	gob.Register(ARPTpa{})
 	gob.Register(DelFlowEntry{})
 	gob.Register(EthAddrField{})
 	gob.Register(EthDst{})
@@ -779,13 +1174,26 @@ func init() {
 	gob.Register(EthType(0))
 	gob.Register(FlowEntryAdded{})
 	gob.Register(FlowEntryDeleted{})
+	gob.Register(FlowEntryFailed{})
+	gob.Register(FlowEntryInstalled{})
+	gob.Register(FlowEntryRemoved{})
 	gob.Register(FlowEntry{})
+	gob.Register(ICMPv4Code(0))
+	gob.Register(ICMPv4Type(0))
+	gob.Register(ICMPv6Code(0))
+	gob.Register(ICMPv6Type(0))
+	gob.Register(IPDSCP(0))
+	gob.Register(IPECN(0))
+	gob.Register(IPProto(0))
 	gob.Register(IPv4Dst{})
 	gob.Register(IPv4Src{})
 	gob.Register(IPv6Dst{})
+	gob.Register(IPv6FlowLabel{})
 	gob.Register(IPv6Src{})
 	gob.Register(InPort(0))
+	gob.Register(MPLSLabel(0))
 	gob.Register(Match{})
+	gob.Register(Metadata{})
 	gob.Register(TransportPortDst(0))
 	gob.Register(TransportPortSrc(0))
 	gob.Register(VLANID(0))
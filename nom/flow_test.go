@@ -224,6 +224,74 @@ func TestTransportPortDst(t *testing.T) {
 	testMatch(t, m1, m1, [2]bool{true, true}, [2]bool{true, true})
 }
 
+func TestARP(t *testing.T) {
+	m1 := Match{
+		Fields: []Field{
+			ARPOp(1),
+			ARPSpa{
+				Addr: IPv4Addr{10, 0, 0, 0},
+				Mask: IPv4Addr{255, 0, 0, 0},
+			},
+		},
+	}
+	m2 := Match{
+		Fields: []Field{
+			ARPOp(1),
+			ARPSpa{
+				Addr: IPv4Addr{10, 1, 0, 0},
+				Mask: IPv4Addr{255, 255, 0, 0},
+			},
+			ARPTpa{
+				Addr: IPv4Addr{10, 1, 0, 1},
+				Mask: MaskNoneIPV4,
+			},
+		},
+	}
+	testMatch(t, m1, m2, [2]bool{true, false}, [2]bool{false, false})
+	m3 := Match{
+		Fields: []Field{ARPOp(2)},
+	}
+	testMatch(t, m1, m3, [2]bool{false, false}, [2]bool{false, false})
+}
+
+func TestICMP(t *testing.T) {
+	m1 := Match{
+		Fields: []Field{ICMPv4Type(8)},
+	}
+	m2 := Match{
+		Fields: []Field{ICMPv4Type(8), ICMPv4Code(0)},
+	}
+	testMatch(t, m1, m2, [2]bool{true, false}, [2]bool{false, false})
+	m3 := Match{
+		Fields: []Field{ICMPv6Type(8)},
+	}
+	testMatch(t, m1, m3, [2]bool{false, false}, [2]bool{false, false})
+}
+
+func TestIPv6FlowLabel(t *testing.T) {
+	m1 := Match{
+		Fields: []Field{IPv6FlowLabel{Label: 0x12300, Mask: 0xFFF00}},
+	}
+	m2 := Match{
+		Fields: []Field{IPv6FlowLabel{Label: 0x12345, Mask: MaskNoneIPv6FlowLabel}},
+	}
+	testMatch(t, m1, m2, [2]bool{true, false}, [2]bool{false, false})
+}
+
+func TestMetadata(t *testing.T) {
+	m1 := Match{
+		Fields: []Field{Metadata{Data: 0x1200, Mask: 0xFF00}},
+	}
+	m2 := Match{
+		Fields: []Field{Metadata{Data: 0x1234, Mask: MaskNoneMetadata}},
+	}
+	testMatch(t, m1, m2, [2]bool{true, false}, [2]bool{false, false})
+	m3 := Match{
+		Fields: []Field{Metadata{Data: 0x1334, Mask: MaskNoneMetadata}},
+	}
+	testMatch(t, m1, m3, [2]bool{false, false}, [2]bool{false, false})
+}
+
 func testFlowEntry(t *testing.T, f1, f2 FlowEntry,
 	equality, subsumption [2]bool) {
 
@@ -0,0 +1,109 @@
+package nom
+
+import (
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// HostJoined is emitted when a host is seen for the first time.
+type HostJoined Host
+
+// HostMoved is emitted when a host is seen on a new attachment port.
+type HostMoved struct {
+	Host Host // The host with its new attachment port.
+	From UID  // The previous attachment port of the host.
+}
+
+// HostLeft is emitted when a host is not seen for a while, or when its
+// attachment port or node is removed.
+type HostLeft Host
+
+// Host represents an end host attached to a port in the network.
+type Host struct {
+	MACAddr  MACAddr    // Hardware address of the host.
+	IPv4     []IPv4Addr // IP version 4 addresses of the host.
+	IPv6     []IPv6Addr // IP version 6 addresses of the host.
+	VLAN     VLANID     // VLAN of the host. Zero if untagged.
+	Port     UID        // The attachment port.
+	LastSeen time.Time  // The last time a packet is received from the host.
+}
+
+func (h Host) String() string {
+	return fmt.Sprintf("Host %v (port=%v, vlan=%v, ipv4=%v, ipv6=%v)",
+		h.MACAddr, h.Port, h.VLAN, h.IPv4, h.IPv6)
+}
+
+// UID returns the host's unique ID, which is its MAC address.
+func (h Host) UID() UID {
+	return UID(h.MACAddr.String())
+}
+
+// HasIPv4 returns whether ip is an address of the host.
+func (h Host) HasIPv4(ip IPv4Addr) bool {
+	for _, hip := range h.IPv4 {
+		if hip == ip {
+			return true
+		}
+	}
+	return false
+}
+
+// HasIPv6 returns whether ip is an address of the host.
+func (h Host) HasIPv6(ip IPv6Addr) bool {
+	for _, hip := range h.IPv6 {
+		if hip == ip {
+			return true
+		}
+	}
+	return false
+}
+
+// JSONDecode decodes the host from a byte array using JSON.
+func (h *Host) JSONDecode(b []byte) error {
+	return json.Unmarshal(b, h)
+}
+
+// JSONEncode encodes the host into a byte array using JSON.
+func (h *Host) JSONEncode() ([]byte, error) {
+	return json.Marshal(h)
+}
+
+// HostQuery queries the hosts in the network. The zero values of the fields
+// match all hosts. Otherwise, only the hosts with the given MAC address, IP
+// version 4 address, or attached to the given node are returned.
+type HostQuery struct {
+	MACAddr MACAddr
+	IPv4    IPv4Addr
+	Node    UID
+}
+
+// Matches returns whether h matches the query.
+func (q HostQuery) Matches(h Host) bool {
+	if q.MACAddr != (MACAddr{}) && q.MACAddr != h.MACAddr {
+		return false
+	}
+	if q.IPv4 != (IPv4Addr{}) && !h.HasIPv4(q.IPv4) {
+		return false
+	}
+	if q.Node != Nil && NodeFromPortUID(h.Port) != q.Node {
+		return false
+	}
+	return true
+}
+
+// HostQueryResult is the result for a HostQuery.
+type HostQueryResult struct {
+	Query HostQuery
+	Hosts []Host
+}
+
+func init() {
+	gob.Register(Host{})
+	gob.Register(HostJoined{})
+	gob.Register(HostLeft{})
+	gob.Register(HostMoved{})
+	gob.Register(HostQuery{})
+	gob.Register(HostQueryResult{})
+}
@@ -0,0 +1,237 @@
+package nom
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Validate returns an error if the match has duplicate or contradictory
+// fields, or if a field is used without its OpenFlow prerequisites (e.g.,
+// TransportPortDst without IPProto).
+func (m Match) Validate() error {
+	for i, f := range m.Fields {
+		for _, thatf := range m.Fields[i+1:] {
+			if !f.HasSameType(thatf) {
+				continue
+			}
+			if f.Equals(thatf) {
+				return fmt.Errorf("nom: duplicate field %v", f)
+			}
+			return fmt.Errorf("nom: contradictory fields %v and %v", f, thatf)
+		}
+		if err := m.checkPrereq(f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Match) checkPrereq(f Field) error {
+	switch f.(type) {
+	case VLANPCP:
+		if _, ok := m.VLANID(); !ok {
+			return fmt.Errorf("nom: %v requires a vlan id", f)
+		}
+	case IPProto, IPDSCP, IPECN:
+		return m.requireEthType(f, EthTypeIPv4, EthTypeIPv6)
+	case IPv4Src, IPv4Dst:
+		return m.requireEthType(f, EthTypeIPv4)
+	case IPv6Src, IPv6Dst, IPv6FlowLabel:
+		return m.requireEthType(f, EthTypeIPv6)
+	case ARPOp, ARPSpa, ARPTpa:
+		return m.requireEthType(f, EthTypeARP)
+	case MPLSLabel:
+		return m.requireEthType(f, EthTypeMPLS, EthTypeMPLSMcast)
+	case TransportPortSrc, TransportPortDst:
+		return m.requireIPProto(f, IPProtoTCP, IPProtoUDP)
+	case ICMPv4Type, ICMPv4Code:
+		if err := m.requireEthType(f, EthTypeIPv4); err != nil {
+			return err
+		}
+		return m.requireIPProto(f, IPProtoICMP)
+	case ICMPv6Type, ICMPv6Code:
+		if err := m.requireEthType(f, EthTypeIPv6); err != nil {
+			return err
+		}
+		return m.requireIPProto(f, IPProtoICMPv6)
+	}
+	return nil
+}
+
+func (m Match) requireEthType(f Field, types ...EthType) error {
+	if t, ok := m.EthType(); ok {
+		for _, want := range types {
+			if t == want {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("nom: %v requires eth_type in %v", f, types)
+}
+
+func (m Match) requireIPProto(f Field, protos ...IPProto) error {
+	if p, ok := m.IPProto(); ok {
+		for _, want := range protos {
+			if p == want {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("nom: %v requires ip_proto in %v", f, protos)
+}
+
+// Normalize returns a copy of the match with its fields sorted in a canonical
+// order, which follows the order of OpenFlow extensible match fields.
+func (m Match) Normalize() Match {
+	n := m.Clone()
+	sort.Stable(byFieldOrder(n.Fields))
+	return n
+}
+
+type byFieldOrder []Field
+
+func (b byFieldOrder) Len() int           { return len(b) }
+func (b byFieldOrder) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
+func (b byFieldOrder) Less(i, j int) bool { return fieldOrder(b[i]) < fieldOrder(b[j]) }
+
+// fieldOrder returns the rank of the field in the canonical order. Unknown
+// fields are ordered last.
+func fieldOrder(f Field) int {
+	switch f.(type) {
+	case InPort:
+		return 0
+	case Metadata:
+		return 1
+	case EthDst:
+		return 2
+	case EthSrc:
+		return 3
+	case EthType:
+		return 4
+	case VLANID:
+		return 5
+	case VLANPCP:
+		return 6
+	case IPDSCP:
+		return 7
+	case IPECN:
+		return 8
+	case IPProto:
+		return 9
+	case IPv4Src:
+		return 10
+	case IPv4Dst:
+		return 11
+	case TransportPortSrc:
+		return 12
+	case TransportPortDst:
+		return 13
+	case ICMPv4Type:
+		return 14
+	case ICMPv4Code:
+		return 15
+	case ARPOp:
+		return 16
+	case ARPSpa:
+		return 17
+	case ARPTpa:
+		return 18
+	case IPv6Src:
+		return 19
+	case IPv6Dst:
+		return 20
+	case IPv6FlowLabel:
+		return 21
+	case ICMPv6Type:
+		return 22
+	case ICMPv6Code:
+		return 23
+	case MPLSLabel:
+		return 24
+	}
+	return 25
+}
+
+// Intersect returns the match that matches exactly the packets matched by both
+// m and thatm. It returns false if no packet can match both.
+func (m Match) Intersect(thatm Match) (Match, bool) {
+	var res Match
+	for _, f := range m.Fields {
+		merged := f
+		for _, thatf := range thatm.Fields {
+			if !f.HasSameType(thatf) {
+				continue
+			}
+			var ok bool
+			if merged, ok = intersectFields(merged, thatf); !ok {
+				return Match{}, false
+			}
+		}
+		res.AddField(merged)
+	}
+	for _, thatf := range thatm.Fields {
+		if m.countFields(thatf.HasSameType) == 0 {
+			res.AddField(thatf)
+		}
+	}
+	return res.Normalize(), true
+}
+
+// Overlaps returns whether there is a packet that matches both m and thatm.
+func (m Match) Overlaps(thatm Match) bool {
+	_, ok := m.Intersect(thatm)
+	return ok
+}
+
+// intersectFields returns the intersection of two fields of the same type.
+// Masked fields are intersected bitwise and the rest intersect only if they
+// are equal.
+func intersectFields(f, thatf Field) (Field, bool) {
+	switch ff := f.(type) {
+	case EthDst:
+		r, ok := MaskedMACAddr(ff).Intersect(MaskedMACAddr(thatf.(EthDst)))
+		return EthDst(r), ok
+	case EthSrc:
+		r, ok := MaskedMACAddr(ff).Intersect(MaskedMACAddr(thatf.(EthSrc)))
+		return EthSrc(r), ok
+	case IPv4Src:
+		r, ok := MaskedIPv4Addr(ff).Intersect(MaskedIPv4Addr(thatf.(IPv4Src)))
+		return IPv4Src(r), ok
+	case IPv4Dst:
+		r, ok := MaskedIPv4Addr(ff).Intersect(MaskedIPv4Addr(thatf.(IPv4Dst)))
+		return IPv4Dst(r), ok
+	case IPv6Src:
+		r, ok := MaskedIPv6Addr(ff).Intersect(MaskedIPv6Addr(thatf.(IPv6Src)))
+		return IPv6Src(r), ok
+	case IPv6Dst:
+		r, ok := MaskedIPv6Addr(ff).Intersect(MaskedIPv6Addr(thatf.(IPv6Dst)))
+		return IPv6Dst(r), ok
+	case ARPSpa:
+		r, ok := MaskedIPv4Addr(ff).Intersect(MaskedIPv4Addr(thatf.(ARPSpa)))
+		return ARPSpa(r), ok
+	case ARPTpa:
+		r, ok := MaskedIPv4Addr(ff).Intersect(MaskedIPv4Addr(thatf.(ARPTpa)))
+		return ARPTpa(r), ok
+	case IPv6FlowLabel:
+		thatl := thatf.(IPv6FlowLabel)
+		common := ff.Mask & thatl.Mask
+		if ff.Label&common != thatl.Label&common {
+			return nil, false
+		}
+		return IPv6FlowLabel{
+			Label: ff.Label&ff.Mask | thatl.Label&thatl.Mask,
+			Mask:  ff.Mask | thatl.Mask,
+		}, true
+	case Metadata:
+		thatm := thatf.(Metadata)
+		common := ff.Mask & thatm.Mask
+		if ff.Data&common != thatm.Data&common {
+			return nil, false
+		}
+		return Metadata{
+			Data: ff.Data&ff.Mask | thatm.Data&thatm.Mask,
+			Mask: ff.Mask | thatm.Mask,
+		}, true
+	}
+	return f, f.Equals(thatf)
+}
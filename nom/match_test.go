@@ -0,0 +1,184 @@
+package nom
+
+import "testing"
+
+func TestMatchValidate(t *testing.T) {
+	valid := []Match{
+		{},
+		{Fields: []Field{InPort("n1$$1"), EthType(EthTypeIPv4)}},
+		{
+			Fields: []Field{
+				TransportPortDst(80),
+				IPProto(IPProtoTCP),
+				EthType(EthTypeIPv4),
+			},
+		},
+		{Fields: []Field{VLANPCP(3), VLANID(10)}},
+		{Fields: []Field{EthType(EthTypeARP), ARPOp(1)}},
+		{
+			Fields: []Field{
+				EthType(EthTypeIPv6),
+				IPProto(IPProtoICMPv6),
+				ICMPv6Type(135),
+			},
+		},
+	}
+	for _, m := range valid {
+		if err := m.Validate(); err != nil {
+			t.Errorf("error for valid match %v: %v", m, err)
+		}
+	}
+
+	invalid := []Match{
+		{Fields: []Field{TransportPortDst(80)}},
+		{Fields: []Field{TransportPortDst(80), IPProto(IPProtoTCP)}},
+		{
+			Fields: []Field{
+				TransportPortDst(80),
+				IPProto(IPProtoICMP),
+				EthType(EthTypeIPv4),
+			},
+		},
+		{Fields: []Field{EthType(EthTypeIPv4), EthType(EthTypeIPv6)}},
+		{Fields: []Field{EthType(EthTypeIPv4), EthType(EthTypeIPv4)}},
+		{Fields: []Field{VLANPCP(3)}},
+		{Fields: []Field{EthType(EthTypeIPv4), ARPOp(1)}},
+		{
+			Fields: []Field{
+				EthType(EthTypeIPv6),
+				IPProto(IPProtoICMP),
+				ICMPv4Type(8),
+			},
+		},
+	}
+	for _, m := range invalid {
+		if err := m.Validate(); err == nil {
+			t.Errorf("no error for invalid match %v", m)
+		}
+	}
+}
+
+func TestMatchNormalize(t *testing.T) {
+	m := Match{
+		Fields: []Field{
+			TransportPortDst(80),
+			IPProto(IPProtoTCP),
+			EthType(EthTypeIPv4),
+			InPort("n1$$1"),
+		},
+	}
+	n := m.Normalize()
+	want := []Field{
+		InPort("n1$$1"),
+		EthType(EthTypeIPv4),
+		IPProto(IPProtoTCP),
+		TransportPortDst(80),
+	}
+	for i := range want {
+		if !n.Fields[i].Equals(want[i]) {
+			t.Errorf("invalid field at %d: actual=%v want=%v", i, n.Fields[i],
+				want[i])
+		}
+	}
+	if !m.Fields[0].Equals(TransportPortDst(80)) {
+		t.Error("normalize has modified the original match")
+	}
+}
+
+func TestMatchIntersect(t *testing.T) {
+	m1 := Match{
+		Fields: []Field{
+			EthType(EthTypeIPv4),
+			IPv4Dst(CIDRToMaskedIPv4(0x0A000000, 8)),
+		},
+	}
+	m2 := Match{
+		Fields: []Field{
+			InPort("n1$$1"),
+			IPv4Dst(CIDRToMaskedIPv4(0x0A010000, 16)),
+			EthType(EthTypeIPv4),
+		},
+	}
+	i, ok := m1.Intersect(m2)
+	if !ok {
+		t.Fatalf("no intersection for %v and %v", m1, m2)
+	}
+	want := Match{
+		Fields: []Field{
+			InPort("n1$$1"),
+			EthType(EthTypeIPv4),
+			IPv4Dst(CIDRToMaskedIPv4(0x0A010000, 16)),
+		},
+	}
+	if !i.Equals(want) {
+		t.Errorf("invalid intersection: actual=%v want=%v", i, want)
+	}
+	if !m1.Overlaps(m2) || !m2.Overlaps(m1) {
+		t.Errorf("%v and %v should overlap", m1, m2)
+	}
+
+	m3 := Match{
+		Fields: []Field{
+			EthType(EthTypeIPv4),
+			IPv4Dst(CIDRToMaskedIPv4(0x0B000000, 8)),
+		},
+	}
+	if m1.Overlaps(m3) || m2.Overlaps(m3) {
+		t.Errorf("%v should not overlap with %v or %v", m3, m1, m2)
+	}
+	if !m1.Overlaps(Match{}) {
+		t.Errorf("%v should overlap with the wildcard match", m1)
+	}
+}
+
+func TestMatchIntersectMasked(t *testing.T) {
+	m1 := Match{
+		Fields: []Field{
+			EthDst{
+				Addr: MACAddr{0x01, 0, 0, 0, 0, 0},
+				Mask: MACAddr{0x01, 0, 0, 0, 0, 0},
+			},
+			Metadata{Data: 0x10, Mask: 0xF0},
+		},
+	}
+	m2 := Match{
+		Fields: []Field{
+			EthDst{
+				Addr: MACAddr{0, 0, 0, 0, 0, 0x02},
+				Mask: MACAddr{0, 0, 0, 0, 0, 0xFF},
+			},
+			Metadata{Data: 0x03, Mask: 0x0F},
+		},
+	}
+	i, ok := m1.Intersect(m2)
+	if !ok {
+		t.Fatalf("no intersection for %v and %v", m1, m2)
+	}
+	want := Match{
+		Fields: []Field{
+			Metadata{Data: 0x13, Mask: 0xFF},
+			EthDst{
+				Addr: MACAddr{0x01, 0, 0, 0, 0, 0x02},
+				Mask: MACAddr{0x01, 0, 0, 0, 0, 0xFF},
+			},
+		},
+	}
+	if !i.Equals(want) {
+		t.Errorf("invalid intersection: actual=%v want=%v", i, want)
+	}
+
+	m3 := Match{
+		Fields: []Field{
+			EthDst{
+				Addr: MACAddr{0, 0, 0, 0, 0, 0},
+				Mask: MACAddr{0x01, 0, 0, 0, 0, 0},
+			},
+		},
+	}
+	if m1.Overlaps(m3) {
+		t.Errorf("%v should not overlap with %v", m1, m3)
+	}
+	if !m2.Overlaps(m3) {
+		t.Errorf("%v should overlap with %v", m2, m3)
+	}
+}
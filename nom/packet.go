@@ -1,6 +1,7 @@
 package nom
 
 import (
+	"encoding/binary"
 	"encoding/gob"
 	"fmt"
 )
@@ -30,6 +31,16 @@ type PacketOut struct {
 	BufferID PacketBufferID
 	Packet   Packet
 	Actions  []Action
+	// Issuer is the bee that has emitted the PacketOut. It is set by the
+	// controller, and PacketOutFailed is sent to this bee.
+	Issuer uint64
+}
+
+// PacketOutFailed is sent to the issuer of a PacketOut when the node fails to
+// send out the packet. If the issuer is not known, it is emitted.
+type PacketOutFailed struct {
+	Packet PacketOut
+	Err    DriverError
 }
 
 // Packet is simply the packet data.
@@ -45,7 +56,383 @@ func (p Packet) SrcMAC() MACAddr {
 	return MACAddr{p[6], p[7], p[8], p[9], p[10], p[11]}
 }
 
-// TODO(soheil): add code to parse ip addresses and tcp ports.
+// Ethernet types of 802.1Q and 802.1ad tags.
+const (
+	ethTypeVLAN  = 0x8100
+	ethTypeQinQ  = 0x88A8
+	ethHeaderLen = 14
+	vlanTagLen   = 4
+)
+
+// IPv6 extension headers.
+const (
+	ipv6HopByHop = 0
+	ipv6Routing  = 43
+	ipv6Fragment = 44
+	ipv6ESP      = 50
+	ipv6AH       = 51
+	ipv6DstOpts  = 60
+)
+
+// VLAN returns the VLAN ID and the priority of the outermost 802.1Q tag of the
+// packet, and whether the packet is tagged.
+func (p Packet) VLAN() (VLANID, VLANPCP, bool) {
+	if len(p) < ethHeaderLen+vlanTagLen {
+		return 0, 0, false
+	}
+	switch binary.BigEndian.Uint16(p[12:]) {
+	case ethTypeVLAN, ethTypeQinQ:
+		tci := binary.BigEndian.Uint16(p[14:])
+		return VLANID(tci & 0x0FFF), VLANPCP(tci >> 13), true
+	}
+	return 0, 0, false
+}
+
+// EthType returns the ethernet type of the packet after all VLAN tags.
+func (p Packet) EthType() EthType {
+	t, _ := p.l3()
+	return t
+}
+
+// l3 returns the ethernet type and the payload of the ethernet frame, skipping
+// all VLAN tags.
+func (p Packet) l3() (EthType, []byte) {
+	off := 12
+	for len(p) >= off+2 {
+		t := binary.BigEndian.Uint16(p[off:])
+		if t != ethTypeVLAN && t != ethTypeQinQ {
+			return EthType(t), p[off+2:]
+		}
+		off += vlanTagLen
+	}
+	return 0, nil
+}
+
+// ARP returns the ARP header of the packet, if the packet is a valid ARP
+// packet for IPv4 over ethernet.
+func (p Packet) ARP() (ARPHeader, bool) {
+	t, b := p.l3()
+	if t != EthTypeARP || len(b) < 28 {
+		return nil, false
+	}
+	return ARPHeader(b[:28]), true
+}
+
+// IPv4 returns the IPv4 header and its payload, if the packet is a valid IPv4
+// packet.
+func (p Packet) IPv4() (IPv4Header, bool) {
+	t, b := p.l3()
+	if t != EthTypeIPv4 || len(b) < 20 || b[0]>>4 != 4 {
+		return nil, false
+	}
+	h := IPv4Header(b)
+	if hl := h.HeaderLen(); hl < 20 || len(b) < hl {
+		return nil, false
+	}
+	if tl := int(binary.BigEndian.Uint16(b[2:])); tl >= h.HeaderLen() &&
+		tl < len(b) {
+
+		// Trim the ethernet padding.
+		h = h[:tl]
+	}
+	return h, true
+}
+
+// IPv6 returns the IPv6 header and its payload, if the packet is a valid IPv6
+// packet.
+func (p Packet) IPv6() (IPv6Header, bool) {
+	t, b := p.l3()
+	if t != EthTypeIPv6 || len(b) < 40 || b[0]>>4 != 6 {
+		return nil, false
+	}
+	h := IPv6Header(b)
+	if tl := 40 + int(binary.BigEndian.Uint16(b[4:])); tl < len(b) {
+		h = h[:tl]
+	}
+	return h, true
+}
+
+// l4 returns the transport protocol and the transport header of an IPv4 or an
+// IPv6 packet. It returns false for non-IP packets and for fragments other
+// than the first one.
+func (p Packet) l4() (IPProto, []byte, bool) {
+	if ip, ok := p.IPv4(); ok {
+		return ip.Transport()
+	}
+	if ip, ok := p.IPv6(); ok {
+		return ip.Transport()
+	}
+	return 0, nil, false
+}
+
+// TCP returns the TCP header of the packet, if the packet is a TCP segment.
+func (p Packet) TCP() (TCPHeader, bool) {
+	proto, b, ok := p.l4()
+	if !ok || proto != IPProtoTCP || len(b) < 20 {
+		return nil, false
+	}
+	return TCPHeader(b), true
+}
+
+// UDP returns the UDP header of the packet, if the packet is a UDP datagram.
+func (p Packet) UDP() (UDPHeader, bool) {
+	proto, b, ok := p.l4()
+	if !ok || proto != IPProtoUDP || len(b) < 8 {
+		return nil, false
+	}
+	return UDPHeader(b), true
+}
+
+// ICMP returns the ICMP header of the packet, if the packet is an ICMP or an
+// ICMPv6 message.
+func (p Packet) ICMP() (ICMPHeader, bool) {
+	proto, b, ok := p.l4()
+	if !ok || len(b) < 4 {
+		return nil, false
+	}
+	switch t := p.EthType(); {
+	case t == EthTypeIPv4 && proto != IPProtoICMP,
+		t == EthTypeIPv6 && proto != IPProtoICMPv6:
+		return nil, false
+	}
+	return ICMPHeader(b), true
+}
+
+// Match returns the exact match of the packet received on inPort. It includes
+// the L2 headers of the packet, the L3 headers of ARP, IPv4 and IPv6 packets,
+// and the ports of TCP and UDP or the type and code of ICMP messages. Untagged
+// packets match VLANNone.
+func (p Packet) Match(inPort UID) Match {
+	var m Match
+	m.AddField(InPort(inPort))
+	m.AddField(EthDst{Addr: p.DstMAC(), Mask: MaskNoneMAC})
+	m.AddField(EthSrc{Addr: p.SrcMAC(), Mask: MaskNoneMAC})
+	if vid, pcp, ok := p.VLAN(); ok {
+		m.AddField(vid)
+		m.AddField(pcp)
+	} else {
+		m.AddField(VLANNone)
+	}
+	t := p.EthType()
+	m.AddField(t)
+
+	switch t {
+	case EthTypeARP:
+		arp, ok := p.ARP()
+		if !ok {
+			return m
+		}
+		m.AddField(ARPOp(arp.Op()))
+		m.AddField(ARPSpa{Addr: arp.SPA(), Mask: MaskNoneIPV4})
+		m.AddField(ARPTpa{Addr: arp.TPA(), Mask: MaskNoneIPV4})
+		return m
+	case EthTypeIPv4:
+		ip, ok := p.IPv4()
+		if !ok {
+			return m
+		}
+		m.AddField(ip.DSCP())
+		m.AddField(IPProto(ip.Proto()))
+		m.AddField(IPv4Src{Addr: ip.Src(), Mask: MaskNoneIPV4})
+		m.AddField(IPv4Dst{Addr: ip.Dst(), Mask: MaskNoneIPV4})
+	case EthTypeIPv6:
+		ip, ok := p.IPv6()
+		if !ok {
+			return m
+		}
+		m.AddField(ip.DSCP())
+		proto, _, _ := ip.Transport()
+		m.AddField(proto)
+		m.AddField(IPv6Src{Addr: ip.Src(), Mask: MaskNoneIPV6})
+		m.AddField(IPv6Dst{Addr: ip.Dst(), Mask: MaskNoneIPV6})
+	default:
+		return m
+	}
+
+	if tcp, ok := p.TCP(); ok {
+		m.AddField(TransportPortSrc(tcp.SrcPort()))
+		m.AddField(TransportPortDst(tcp.DstPort()))
+	} else if udp, ok := p.UDP(); ok {
+		m.AddField(TransportPortSrc(udp.SrcPort()))
+		m.AddField(TransportPortDst(udp.DstPort()))
+	} else if icmp, ok := p.ICMP(); ok {
+		if t == EthTypeIPv4 {
+			m.AddField(ICMPv4Type(icmp.Type()))
+			m.AddField(ICMPv4Code(icmp.Code()))
+		} else {
+			m.AddField(ICMPv6Type(icmp.Type()))
+			m.AddField(ICMPv6Code(icmp.Code()))
+		}
+	}
+	return m
+}
+
+// ARPHeader is an ARP header for IPv4 over ethernet. It shares the underlying
+// bytes with the packet.
+type ARPHeader []byte
+
+// Op returns the operation of the ARP packet.
+func (h ARPHeader) Op() uint16 { return binary.BigEndian.Uint16(h[6:]) }
+
+// SHA returns the sender hardware address.
+func (h ARPHeader) SHA() (a MACAddr) { copy(a[:], h[8:14]); return }
+
+// SPA returns the sender protocol address.
+func (h ARPHeader) SPA() (a IPv4Addr) { copy(a[:], h[14:18]); return }
+
+// THA returns the target hardware address.
+func (h ARPHeader) THA() (a MACAddr) { copy(a[:], h[18:24]); return }
+
+// TPA returns the target protocol address.
+func (h ARPHeader) TPA() (a IPv4Addr) { copy(a[:], h[24:28]); return }
+
+// IPv4Header is an IPv4 header followed by its payload. It shares the
+// underlying bytes with the packet.
+type IPv4Header []byte
+
+// HeaderLen returns the length of the header including options in bytes.
+func (h IPv4Header) HeaderLen() int { return int(h[0]&0x0F) * 4 }
+
+// DSCP returns the differentiated services code point of the packet.
+func (h IPv4Header) DSCP() IPDSCP { return IPDSCP(h[1] >> 2) }
+
+// ECN returns the explicit congestion notification bits of the packet.
+func (h IPv4Header) ECN() IPECN { return IPECN(h[1] & 0x03) }
+
+// FragOffset returns the fragment offset of the packet in bytes.
+func (h IPv4Header) FragOffset() int {
+	return int(binary.BigEndian.Uint16(h[6:])&0x1FFF) * 8
+}
+
+// TTL returns the time to live of the packet.
+func (h IPv4Header) TTL() uint8 { return h[8] }
+
+// Proto returns the protocol of the payload.
+func (h IPv4Header) Proto() IPProto { return IPProto(h[9]) }
+
+// Src returns the source address.
+func (h IPv4Header) Src() (a IPv4Addr) { copy(a[:], h[12:16]); return }
+
+// Dst returns the destination address.
+func (h IPv4Header) Dst() (a IPv4Addr) { copy(a[:], h[16:20]); return }
+
+// Payload returns the payload of the packet.
+func (h IPv4Header) Payload() []byte { return h[h.HeaderLen():] }
+
+// Transport returns the transport protocol and the transport header of the
+// packet. It returns false if the packet is not the first fragment.
+func (h IPv4Header) Transport() (IPProto, []byte, bool) {
+	if h.FragOffset() != 0 {
+		return h.Proto(), nil, false
+	}
+	return h.Proto(), h.Payload(), true
+}
+
+// IPv6Header is an IPv6 header followed by its extension headers and payload.
+// It shares the underlying bytes with the packet.
+type IPv6Header []byte
+
+// DSCP returns the differentiated services code point of the packet.
+func (h IPv6Header) DSCP() IPDSCP {
+	return IPDSCP(binary.BigEndian.Uint16(h[0:]) >> 6 & 0x3F)
+}
+
+// ECN returns the explicit congestion notification bits of the packet.
+func (h IPv6Header) ECN() IPECN {
+	return IPECN(binary.BigEndian.Uint16(h[0:]) >> 4 & 0x03)
+}
+
+// FlowLabel returns the flow label of the packet.
+func (h IPv6Header) FlowLabel() uint32 {
+	return binary.BigEndian.Uint32(h[0:]) & MaskNoneIPv6FlowLabel
+}
+
+// NextHeader returns the type of the header following the IPv6 header.
+func (h IPv6Header) NextHeader() uint8 { return h[6] }
+
+// HopLimit returns the hop limit of the packet.
+func (h IPv6Header) HopLimit() uint8 { return h[7] }
+
+// Src returns the source address.
+func (h IPv6Header) Src() (a IPv6Addr) { copy(a[:], h[8:24]); return }
+
+// Dst returns the destination address.
+func (h IPv6Header) Dst() (a IPv6Addr) { copy(a[:], h[24:40]); return }
+
+// Payload returns the bytes following the IPv6 header, including the
+// extension headers.
+func (h IPv6Header) Payload() []byte { return h[40:] }
+
+// Transport skips the extension headers and returns the transport protocol and
+// the transport header of the packet. It returns false if the extension
+// headers are truncated, if the payload is encrypted, or if the packet is not
+// the first fragment.
+func (h IPv6Header) Transport() (IPProto, []byte, bool) {
+	next := h.NextHeader()
+	b := h.Payload()
+	for {
+		switch next {
+		case ipv6HopByHop, ipv6Routing, ipv6DstOpts:
+			if len(b) < 8 || len(b) < (int(b[1])+1)*8 {
+				return IPProto(next), nil, false
+			}
+			next, b = b[0], b[(int(b[1])+1)*8:]
+		case ipv6AH:
+			if len(b) < 8 || len(b) < (int(b[1])+2)*4 {
+				return IPProto(next), nil, false
+			}
+			next, b = b[0], b[(int(b[1])+2)*4:]
+		case ipv6Fragment:
+			if len(b) < 8 {
+				return IPProto(next), nil, false
+			}
+			if binary.BigEndian.Uint16(b[2:])&0xFFF8 != 0 {
+				return IPProto(b[0]), nil, false
+			}
+			next, b = b[0], b[8:]
+		case ipv6ESP:
+			return IPProto(next), nil, false
+		default:
+			return IPProto(next), b, true
+		}
+	}
+}
+
+// TCPHeader is a TCP header followed by its payload. It shares the underlying
+// bytes with the packet.
+type TCPHeader []byte
+
+// SrcPort returns the source port of the segment.
+func (h TCPHeader) SrcPort() uint16 { return binary.BigEndian.Uint16(h[0:]) }
+
+// DstPort returns the destination port of the segment.
+func (h TCPHeader) DstPort() uint16 { return binary.BigEndian.Uint16(h[2:]) }
+
+// Flags returns the control bits of the segment.
+func (h TCPHeader) Flags() uint8 { return h[13] }
+
+// UDPHeader is a UDP header followed by its payload. It shares the underlying
+// bytes with the packet.
+type UDPHeader []byte
+
+// SrcPort returns the source port of the datagram.
+func (h UDPHeader) SrcPort() uint16 { return binary.BigEndian.Uint16(h[0:]) }
+
+// DstPort returns the destination port of the datagram.
+func (h UDPHeader) DstPort() uint16 { return binary.BigEndian.Uint16(h[2:]) }
+
+// Payload returns the payload of the datagram.
+func (h UDPHeader) Payload() []byte { return h[8:] }
+
+// ICMPHeader is an ICMP or ICMPv6 header followed by its body. It shares the
+// underlying bytes with the packet.
+type ICMPHeader []byte
+
+// Type returns the type of the message.
+func (h ICMPHeader) Type() uint8 { return h[0] }
+
+// Code returns the code of the message.
+func (h ICMPHeader) Code() uint8 { return h[1] }
 
 // PacketBufferID represents a packet buffered in the switch.
 type PacketBufferID uint32
@@ -55,4 +442,5 @@ func init() {
 	gob.Register(PacketBufferID(0))
 	gob.Register(PacketIn{})
 	gob.Register(PacketOut{})
+	gob.Register(PacketOutFailed{})
 }
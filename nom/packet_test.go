@@ -0,0 +1,216 @@
+package nom
+
+import "testing"
+
+var (
+	testDstMAC = []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x02}
+	testSrcMAC = []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x01}
+)
+
+func testEthernet(ethType []byte, payload ...[]byte) Packet {
+	var p []byte
+	p = append(p, testDstMAC...)
+	p = append(p, testSrcMAC...)
+	p = append(p, ethType...)
+	for _, b := range payload {
+		p = append(p, b...)
+	}
+	return Packet(p)
+}
+
+func testIPv4Header(proto byte, payloadLen int) []byte {
+	l := 20 + payloadLen
+	return []byte{
+		0x45, 0x28, byte(l >> 8), byte(l), // version, ihl, tos, length
+		0x00, 0x00, 0x00, 0x00, // id, flags, fragment offset
+		0x40, proto, 0x00, 0x00, // ttl, proto, checksum
+		10, 0, 0, 1, // src
+		10, 0, 0, 2, // dst
+	}
+}
+
+func testIPv6Header(next byte, payloadLen int) []byte {
+	h := []byte{
+		0x60, 0x00, 0x00, 0x00, // version, traffic class, flow label
+		byte(payloadLen >> 8), byte(payloadLen), next, 64,
+	}
+	h = append(h, make([]byte, 16)...)
+	h = append(h, make([]byte, 16)...)
+	h[8+15] = 1
+	h[24+15] = 2
+	return h
+}
+
+func testMatchFields(t *testing.T, m Match, want []Field) {
+	if err := m.Validate(); err != nil {
+		t.Errorf("invalid match %v: %v", m, err)
+	}
+	if !m.Equals(Match{Fields: want}) {
+		t.Errorf("invalid match: actual=%v want=%v", m, Match{Fields: want})
+	}
+}
+
+func TestPacketTCPOverVLAN(t *testing.T) {
+	tcp := make([]byte, 20)
+	tcp[0], tcp[1], tcp[2], tcp[3] = 0x30, 0x39, 0x00, 0x50
+	tcp[12], tcp[13] = 0x50, 0x02
+	p := testEthernet([]byte{0x81, 0x00}, []byte{0xA0, 0x0A, 0x08, 0x00},
+		testIPv4Header(6, len(tcp)), tcp, make([]byte, 6))
+
+	vid, pcp, ok := p.VLAN()
+	if !ok || vid != 10 || pcp != 5 {
+		t.Errorf("invalid vlan: actual=%v/%v/%v want=10/5/true", vid, pcp, ok)
+	}
+	if p.EthType() != EthTypeIPv4 {
+		t.Errorf("invalid eth type: actual=%v want=%v", p.EthType(), EthTypeIPv4)
+	}
+	ip, ok := p.IPv4()
+	if !ok {
+		t.Fatal("cannot decode the ipv4 header")
+	}
+	if len(ip) != 40 {
+		t.Errorf("ethernet padding is not trimmed: actual=%v want=40", len(ip))
+	}
+	h, ok := p.TCP()
+	if !ok {
+		t.Fatal("cannot decode the tcp header")
+	}
+	if h.SrcPort() != 12345 || h.DstPort() != 80 || h.Flags() != 0x02 {
+		t.Errorf("invalid tcp header: %v->%v flags=%v", h.SrcPort(), h.DstPort(),
+			h.Flags())
+	}
+	if _, ok := p.UDP(); ok {
+		t.Error("tcp segment decoded as udp")
+	}
+
+	testMatchFields(t, p.Match("n1$$1"), []Field{
+		InPort("n1$$1"),
+		EthDst{Addr: p.DstMAC(), Mask: MaskNoneMAC},
+		EthSrc{Addr: p.SrcMAC(), Mask: MaskNoneMAC},
+		VLANID(10),
+		VLANPCP(5),
+		EthType(EthTypeIPv4),
+		IPDSCP(10),
+		IPProto(IPProtoTCP),
+		IPv4Src{Addr: IPv4Addr{10, 0, 0, 1}, Mask: MaskNoneIPV4},
+		IPv4Dst{Addr: IPv4Addr{10, 0, 0, 2}, Mask: MaskNoneIPV4},
+		TransportPortSrc(12345),
+		TransportPortDst(80),
+	})
+}
+
+func TestPacketUDPOverIPv6(t *testing.T) {
+	hbh := []byte{17, 0, 0, 0, 0, 0, 0, 0}
+	udp := []byte{0x00, 0x35, 0x04, 0x00, 0x00, 0x08, 0x00, 0x00}
+	p := testEthernet([]byte{0x86, 0xDD},
+		testIPv6Header(ipv6HopByHop, len(hbh)+len(udp)), hbh, udp)
+
+	ip, ok := p.IPv6()
+	if !ok {
+		t.Fatal("cannot decode the ipv6 header")
+	}
+	if ip.NextHeader() != ipv6HopByHop {
+		t.Errorf("invalid next header: actual=%v want=%v", ip.NextHeader(),
+			ipv6HopByHop)
+	}
+	h, ok := p.UDP()
+	if !ok {
+		t.Fatal("cannot decode the udp header")
+	}
+	if h.SrcPort() != 53 || h.DstPort() != 1024 {
+		t.Errorf("invalid udp ports: %v->%v", h.SrcPort(), h.DstPort())
+	}
+
+	src, dst := IPv6Addr{15: 1}, IPv6Addr{15: 2}
+	testMatchFields(t, p.Match("n1$$2"), []Field{
+		InPort("n1$$2"),
+		EthDst{Addr: p.DstMAC(), Mask: MaskNoneMAC},
+		EthSrc{Addr: p.SrcMAC(), Mask: MaskNoneMAC},
+		VLANNone,
+		EthType(EthTypeIPv6),
+		IPDSCP(0),
+		IPProto(IPProtoUDP),
+		IPv6Src{Addr: src, Mask: MaskNoneIPV6},
+		IPv6Dst{Addr: dst, Mask: MaskNoneIPV6},
+		TransportPortSrc(53),
+		TransportPortDst(1024),
+	})
+}
+
+func TestPacketIPv6Fragment(t *testing.T) {
+	frag := []byte{17, 0, 0x00, 0x08, 0, 0, 0, 1}
+	p := testEthernet([]byte{0x86, 0xDD},
+		testIPv6Header(ipv6Fragment, len(frag)+8), frag, make([]byte, 8))
+	if _, ok := p.UDP(); ok {
+		t.Error("non-first fragment decoded as udp")
+	}
+	proto, ok := p.Match("n1$$1").IPProto()
+	if !ok || proto != IPProtoUDP {
+		t.Errorf("invalid ip proto for fragment: actual=%v want=%v", proto,
+			IPProtoUDP)
+	}
+}
+
+func TestPacketICMP(t *testing.T) {
+	icmp := []byte{8, 0, 0, 0, 0, 1, 0, 1}
+	p := testEthernet([]byte{0x08, 0x00}, testIPv4Header(1, len(icmp)), icmp)
+	h, ok := p.ICMP()
+	if !ok || h.Type() != 8 || h.Code() != 0 {
+		t.Errorf("invalid icmp header: %v", h)
+	}
+	m := p.Match("n1$$1")
+	if err := m.Validate(); err != nil {
+		t.Errorf("invalid match %v: %v", m, err)
+	}
+	if m.countFields(ICMPv4Type(8).Equals) != 1 {
+		t.Errorf("no icmp type in %v", m)
+	}
+}
+
+func TestPacketARP(t *testing.T) {
+	arp := []byte{
+		0x00, 0x01, 0x08, 0x00, 6, 4, 0x00, 0x01, // htype, ptype, len, op
+	}
+	arp = append(arp, testSrcMAC...)
+	arp = append(arp, 10, 0, 0, 1)
+	arp = append(arp, make([]byte, 6)...)
+	arp = append(arp, 10, 0, 0, 2)
+	p := testEthernet([]byte{0x08, 0x06}, arp)
+
+	h, ok := p.ARP()
+	if !ok {
+		t.Fatal("cannot decode the arp header")
+	}
+	if h.Op() != 1 || h.SHA() != p.SrcMAC() ||
+		h.SPA() != (IPv4Addr{10, 0, 0, 1}) || h.TPA() != (IPv4Addr{10, 0, 0, 2}) {
+
+		t.Errorf("invalid arp header: op=%v sha=%v spa=%v tpa=%v", h.Op(), h.SHA(),
+			h.SPA(), h.TPA())
+	}
+	if _, ok := p.IPv4(); ok {
+		t.Error("arp packet decoded as ipv4")
+	}
+	testMatchFields(t, p.Match("n1$$1"), []Field{
+		InPort("n1$$1"),
+		EthDst{Addr: p.DstMAC(), Mask: MaskNoneMAC},
+		EthSrc{Addr: p.SrcMAC(), Mask: MaskNoneMAC},
+		VLANNone,
+		EthType(EthTypeARP),
+		ARPOp(1),
+		ARPSpa{Addr: IPv4Addr{10, 0, 0, 1}, Mask: MaskNoneIPV4},
+		ARPTpa{Addr: IPv4Addr{10, 0, 0, 2}, Mask: MaskNoneIPV4},
+	})
+}
+
+func TestPacketTruncated(t *testing.T) {
+	p := testEthernet([]byte{0x08, 0x00}, testIPv4Header(6, 20)[:10])
+	if _, ok := p.IPv4(); ok {
+		t.Error("truncated ipv4 header decoded")
+	}
+	if _, ok := p.TCP(); ok {
+		t.Error("tcp decoded from a truncated packet")
+	}
+	if err := p.Match("n1$$1").Validate(); err != nil {
+		t.Errorf("invalid match for truncated packet: %v", err)
+	}
+}
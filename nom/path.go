@@ -72,9 +72,11 @@ type AddPath struct {
 	Path       Path
 }
 
-// DelPath is emitted to delete a path from the network.
+// DelPath is emitted to delete the path with the ID of Path, which is added by
+// Subscriber, from the network.
 type DelPath struct {
-	Path Path
+	Subscriber bh.AppCellKey
+	Path       Path
 }
 
 // PathAdded is emitted to the subscriber when the path is successfully added.
@@ -10,12 +10,35 @@ import (
 // its state/configuration is changed.
 type PortUpdated Port
 
+// PortRemoved is a high-level event emitted when a port is removed from its
+// node.
+type PortRemoved Port
+
 // PortStatusChanged is emitted when a driver receives a port status
 type PortStatusChanged struct {
 	Port   Port
+	Reason PortStatusReason
 	Driver Driver
 }
 
+// PortStatusReason is the reason of a port status change.
+type PortStatusReason uint8
+
+// Valid values for PortStatusReason.
+const (
+	PortStatusAdded    PortStatusReason = iota // The port is added.
+	PortStatusDeleted                   = iota // The port is removed.
+	PortStatusModified                  = iota // Port's attributes are changed.
+)
+
+// ModifyPort is a message to change the configuration of a port. Only the
+// bits of the configuration that are set in Mask are changed.
+type ModifyPort struct {
+	Port   UID        // The port.
+	Config PortConfig // The new values of the configuration bits.
+	Mask   PortConfig // The configuration bits to change.
+}
+
 // Port is either a physical or a virtual port of a node.
 type Port struct {
 	ID      PortID      // ID is unique among the ports of this node.
@@ -146,8 +169,10 @@ const (
 )
 
 func init() {
+	gob.Register(ModifyPort{})
 	gob.Register(Port{})
 	gob.Register(PortID(""))
+	gob.Register(PortRemoved{})
 	gob.Register(PortStatusChanged{})
 	gob.Register(PortUpdated{})
 }
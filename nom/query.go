@@ -28,14 +28,19 @@ type PortQueryResult struct {
 }
 
 // FlowStatsQuery queries the flows that would match the query. If Exact is
-// false, it removes all flow entries that are subsumed by the given match.
+// false, it removes all flow entries that are subsumed by the given match. ID
+// is an optional identifier of the query that is set in its result.
 type FlowStatsQuery struct {
+	ID    uint64
 	Node  UID
 	Match Match
 }
 
-// FlowStatsQueryResult is the result for a FlowStatQuery
+// FlowStatsQueryResult is the result for a FlowStatQuery. It has the
+// statistics of all the flows replied by the node, even if the node has sent
+// them in several messages. ID is the ID of the query.
 type FlowStatsQueryResult struct {
+	ID    uint64
 	Node  UID
 	Stats []FlowStats
 }
@@ -43,6 +48,8 @@ type FlowStatsQueryResult struct {
 // FlowStats is the statistics of flow
 type FlowStats struct {
 	Match    Match
+	Priority uint16
+	Cookie   uint64
 	Duration time.Duration
 	Packets  uint64
 	Bytes    uint64
@@ -55,6 +62,32 @@ func (stats FlowStats) BW() Bandwidth {
 	return Bandwidth(stats.Bytes / uint64(stats.Duration))
 }
 
+// PortStatsQuery queries the statistics of the ports of a node. If Port is
+// empty, the statistics of all the ports of the node are queried.
+type PortStatsQuery struct {
+	Node UID
+	Port UID
+}
+
+// PortStatsQueryResult is the result for a PortStatsQuery.
+type PortStatsQueryResult struct {
+	Node  UID
+	Stats []PortStats
+}
+
+// PortStats is the statistics of a port.
+type PortStats struct {
+	Port      UID
+	RxPackets uint64 // Number of received packets.
+	TxPackets uint64 // Number of transmitted packets.
+	RxBytes   uint64 // Number of received bytes.
+	TxBytes   uint64 // Number of transmitted bytes.
+	RxDropped uint64 // Number of packets dropped on receive.
+	TxDropped uint64 // Number of packets dropped on transmit.
+	RxErrors  uint64 // Number of receive errors.
+	TxErrors  uint64 // Number of transmit errors.
+}
+
 func init() {
 	gob.Register(FlowStatsQuery{})
 	gob.Register(FlowStatsQueryResult{})
@@ -62,4 +95,6 @@ func init() {
 	gob.Register(NodeQueryResult{})
 	gob.Register(PortQuery{})
 	gob.Register(PortQueryResult{})
+	gob.Register(PortStatsQuery{})
+	gob.Register(PortStatsQueryResult{})
 }
@@ -0,0 +1,52 @@
+package nom
+
+import (
+	"encoding/gob"
+	"hash/fnv"
+)
+
+// CookieAppMask is the mask of the high bits of a flow cookie, that the
+// controller reserves for the application subscribed to the flow.
+const CookieAppMask uint64 = 0xFFFF << 48
+
+// AppCookie returns the high bits that the controller sets in the cookie of
+// the flows added with a subscriber in app. An application owns all such flows
+// using Cookie: AppCookie(app) and CookieMask: CookieAppMask.
+func AppCookie(app string) uint64 {
+	h := fnv.New32a()
+	h.Write([]byte(app))
+	return uint64(h.Sum32()&0xFFFF) << 48
+}
+
+// FlowOwnership is emitted by an application to claim the ownership of the
+// flows whose cookie matches Cookie on the bits set in CookieMask. To own the
+// flows that it adds through the controller, an application uses AppCookie. If
+// DeleteUnknown is true, the controller removes the flows owned by the
+// application that are found on a node but are not installed through the
+// controller, when it reconciles the flow table of the node.
+type FlowOwnership struct {
+	App           string
+	Cookie        uint64
+	CookieMask    uint64
+	DeleteUnknown bool
+}
+
+// Owns returns whether a flow with the given cookie is owned by o.
+func (o FlowOwnership) Owns(cookie uint64) bool {
+	return cookie&o.CookieMask == o.Cookie&o.CookieMask
+}
+
+// FlowsReconciled is emitted when the controller reconciles the flow table of
+// a node with the flows installed through the controller. That happens when a
+// node joins or when the master driver of a node changes.
+type FlowsReconciled struct {
+	Node        UID
+	Reinstalled []FlowEntry // The flows missing on the node.
+	Deleted     []FlowStats // The unknown flows removed from the node.
+	Unknown     []FlowStats // The unknown flows kept on the node.
+}
+
+func init() {
+	gob.Register(FlowOwnership{})
+	gob.Register(FlowsReconciled{})
+}
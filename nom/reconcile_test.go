@@ -0,0 +1,40 @@
+package nom
+
+import "testing"
+
+func TestFlowOwnershipOwns(t *testing.T) {
+	o := FlowOwnership{
+		App:        "app",
+		Cookie:     0x0102000000000000,
+		CookieMask: 0xFFFF000000000000,
+	}
+	cookies := []struct {
+		cookie uint64
+		owns   bool
+	}{
+		{0x0102000000000000, true},
+		{0x0102000000000042, true},
+		{0x0103000000000000, false},
+		{0, false},
+	}
+	for _, c := range cookies {
+		if o.Owns(c.cookie) != c.owns {
+			t.Errorf("invalid ownership for %#x: actual=%v want=%v", c.cookie,
+				!c.owns, c.owns)
+		}
+	}
+}
+
+func TestAppCookie(t *testing.T) {
+	c := AppCookie("app")
+	if c&^CookieAppMask != 0 {
+		t.Errorf("app cookie %#x sets low bits", c)
+	}
+	if c != AppCookie("app") {
+		t.Errorf("app cookie is not deterministic")
+	}
+	o := FlowOwnership{App: "app", Cookie: c, CookieMask: CookieAppMask}
+	if !o.Owns(c | 42) {
+		t.Errorf("%v does not own %#x", o, c|42)
+	}
+}
@@ -0,0 +1,681 @@
+package openflow
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/kandoo/beehive-netctrl/nom"
+	"github.com/kandoo/beehive-netctrl/openflow/of10"
+	"github.com/kandoo/beehive-netctrl/openflow/of12"
+	"github.com/kandoo/beehive-netctrl/openflow/of13"
+)
+
+// ethTypeVLAN is the ethertype of 802.1Q tags.
+const ethTypeVLAN = 0x8100
+
+// ofActions converts NOM actions into OpenFlow 1.0 actions. A forward action
+// is expanded into one output action per port, and a drop action results in
+// no action.
+func (d *of10Driver) ofActions(actions []nom.Action) ([]of10.Action, error) {
+	var ofas []of10.Action
+	for _, a := range actions {
+		switch action := a.(type) {
+		case nom.ActionDrop:
+
+		case nom.ActionFlood:
+			flood := of10.NewActionOutput()
+			flood.SetPort(uint16(of10.PP_FLOOD))
+			ofas = append(ofas, flood.Action)
+
+		case nom.ActionNormal:
+			out := of10.NewActionOutput()
+			out.SetPort(uint16(of10.PP_NORMAL))
+			ofas = append(ofas, out.Action)
+
+		case nom.ActionSendToController:
+			out := of10.NewActionOutput()
+			out.SetPort(uint16(of10.PP_CONTROLLER))
+			out.SetMaxLen(0xFFFF)
+			ofas = append(ofas, out.Action)
+
+		case nom.ActionForward:
+			if len(action.Ports) == 0 {
+				return nil, errors.New("of10Driver: no port to forward to")
+			}
+			for _, p := range action.Ports {
+				ofp, ok := d.ports.portNo(p)
+				if !ok {
+					return nil, fmt.Errorf("of10Driver: port %v not found", p)
+				}
+				out := of10.NewActionOutput()
+				out.SetPort(uint16(ofp))
+				ofas = append(ofas, out.Action)
+			}
+
+		case nom.ActionPushVLAN:
+			// OpenFlow 1.0 adds a VLAN tag if the packet is not tagged.
+			vid := of10.NewActionVlanVid()
+			vid.SetVlanVid(uint16(action.ID))
+			ofas = append(ofas, vid.Action)
+
+		case nom.ActionPopVLAN:
+			strip := of10.NewActionStripVlan()
+			ofas = append(ofas, strip.Action)
+
+		case nom.ActionWriteFields:
+			for _, f := range action.Fields {
+				ofa, err := d.ofSetField(f)
+				if err != nil {
+					return nil, err
+				}
+				ofas = append(ofas, ofa)
+			}
+
+		default:
+			return nil, fmt.Errorf("of10Driver: action not supported %v", action)
+		}
+	}
+	return ofas, nil
+}
+
+func (d *of10Driver) ofSetField(f nom.Field) (of10.Action, error) {
+	switch f := f.(type) {
+	case nom.EthSrc:
+		if f.Mask != nom.MaskNoneMAC {
+			break
+		}
+		set := of10.NewActionDlSrcAddr()
+		set.SetDlAddr([6]byte(f.Addr))
+		return set.Action, nil
+
+	case nom.EthDst:
+		if f.Mask != nom.MaskNoneMAC {
+			break
+		}
+		set := of10.NewActionDlDstAddr()
+		set.SetDlAddr([6]byte(f.Addr))
+		return set.Action, nil
+
+	case nom.VLANID:
+		set := of10.NewActionVlanVid()
+		set.SetVlanVid(uint16(f))
+		return set.Action, nil
+
+	case nom.VLANPCP:
+		set := of10.NewActionVlanPcp()
+		set.SetVlanPcp(uint8(f))
+		return set.Action, nil
+
+	case nom.IPv4Src:
+		if f.Mask != nom.MaskNoneIPV4 {
+			break
+		}
+		set := of10.NewActionNwSrcAddr()
+		set.SetNwAddr(f.Addr.Uint())
+		return set.Action, nil
+
+	case nom.IPv4Dst:
+		if f.Mask != nom.MaskNoneIPV4 {
+			break
+		}
+		set := of10.NewActionNwDstAddr()
+		set.SetNwAddr(f.Addr.Uint())
+		return set.Action, nil
+
+	case nom.TransportPortSrc:
+		set := of10.NewActionTpSrcPort()
+		set.SetTpPort(uint16(f))
+		return set.Action, nil
+
+	case nom.TransportPortDst:
+		set := of10.NewActionTpDstPort()
+		set.SetTpPort(uint16(f))
+		return set.Action, nil
+	}
+	return of10.Action{}, fmt.Errorf("of10Driver: cannot write field %v", f)
+}
+
+// nomActions converts OpenFlow 1.0 actions into NOM actions. Consecutive output
+// actions are merged into one forward action, and consecutive set actions are
+// merged into one write-fields action.
+func (d *of10Driver) nomActions(ofas []of10.Action) ([]nom.Action, error) {
+	if len(ofas) == 0 {
+		return []nom.Action{nom.ActionDrop{}}, nil
+	}
+
+	var actions []nom.Action
+	for _, ofa := range ofas {
+		switch of10.ActionType(ofa.Type()) {
+		case of10.PAT_OUTPUT:
+			out, err := of10.ToActionOutput(ofa)
+			if err != nil {
+				return nil, err
+			}
+			switch of10.Ports(out.Port()) {
+			case of10.PP_FLOOD:
+				actions = append(actions, nom.ActionFlood{})
+			case of10.PP_CONTROLLER:
+				actions = append(actions, nom.ActionSendToController{})
+			case of10.PP_NORMAL:
+				actions = append(actions, nom.ActionNormal{})
+			default:
+				p, ok := d.ports.ofPort(uint32(out.Port()))
+				if !ok {
+					return nil, fmt.Errorf("of10Driver: cannot find port %v",
+						out.Port())
+				}
+				actions = appendForward(actions, p.UID())
+			}
+
+		case of10.PAT_SET_VLAN_VID:
+			vid, err := of10.ToActionVlanVid(ofa)
+			if err != nil {
+				return nil, err
+			}
+			actions = append(actions, nom.ActionPushVLAN{
+				ID: nom.VLANID(vid.VlanVid()),
+			})
+
+		case of10.PAT_STRIP_VLAN:
+			actions = append(actions, nom.ActionPopVLAN{})
+
+		case of10.PAT_SET_VLAN_PCP:
+			set, err := of10.ToActionVlanPcp(ofa)
+			if err != nil {
+				return nil, err
+			}
+			actions = appendWriteField(actions, nom.VLANPCP(set.VlanPcp()))
+
+		case of10.PAT_SET_DL_SRC:
+			set, err := of10.ToActionDlSrcAddr(ofa)
+			if err != nil {
+				return nil, err
+			}
+			actions = appendWriteField(actions, nom.EthSrc{
+				Addr: set.DlAddr(),
+				Mask: nom.MaskNoneMAC,
+			})
+
+		case of10.PAT_SET_DL_DST:
+			set, err := of10.ToActionDlDstAddr(ofa)
+			if err != nil {
+				return nil, err
+			}
+			actions = appendWriteField(actions, nom.EthDst{
+				Addr: set.DlAddr(),
+				Mask: nom.MaskNoneMAC,
+			})
+
+		case of10.PAT_SET_NW_SRC:
+			set, err := of10.ToActionNwSrcAddr(ofa)
+			if err != nil {
+				return nil, err
+			}
+			f := nom.IPv4Src{Mask: nom.MaskNoneIPV4}
+			f.Addr.FromUint(set.NwAddr())
+			actions = appendWriteField(actions, f)
+
+		case of10.PAT_SET_NW_DST:
+			set, err := of10.ToActionNwDstAddr(ofa)
+			if err != nil {
+				return nil, err
+			}
+			f := nom.IPv4Dst{Mask: nom.MaskNoneIPV4}
+			f.Addr.FromUint(set.NwAddr())
+			actions = appendWriteField(actions, f)
+
+		case of10.PAT_SET_TP_SRC:
+			set, err := of10.ToActionTpSrcPort(ofa)
+			if err != nil {
+				return nil, err
+			}
+			actions = appendWriteField(actions, nom.TransportPortSrc(set.TpPort()))
+
+		case of10.PAT_SET_TP_DST:
+			set, err := of10.ToActionTpDstPort(ofa)
+			if err != nil {
+				return nil, err
+			}
+			actions = appendWriteField(actions, nom.TransportPortDst(set.TpPort()))
+
+		default:
+			return nil, fmt.Errorf("of10Driver: action type %v not supported",
+				ofa.Type())
+		}
+	}
+	return actions, nil
+}
+
+// ofActions converts NOM actions into OpenFlow 1.2 actions. A forward action
+// is expanded into one output action per port, and a drop action results in
+// no action. proto is the IP protocol of the packets, which selects the OXM
+// fields written for transport ports.
+func (d *of12Driver) ofActions(actions []nom.Action,
+	proto nom.IPProto) ([]of12.Action, error) {
+	var ofas []of12.Action
+	for _, a := range actions {
+		switch action := a.(type) {
+		case nom.ActionDrop:
+
+		case nom.ActionFlood:
+			ofas = append(ofas, d.ofFlood()...)
+
+		case nom.ActionNormal:
+			out := of12.NewActionOutput()
+			out.SetPort(uint32(of12.PP_NORMAL))
+			ofas = append(ofas, out.Action)
+
+		case nom.ActionSendToController:
+			out := of12.NewActionOutput()
+			out.SetPort(uint32(of12.PP_CONTROLLER))
+			out.SetMaxLen(uint16(of12.PCML_NO_BUFFER))
+			ofas = append(ofas, out.Action)
+
+		case nom.ActionForward:
+			if len(action.Ports) == 0 {
+				return nil, errors.New("of12Driver: no port to forward to")
+			}
+			for _, p := range action.Ports {
+				ofp, ok := d.ports.portNo(p)
+				if !ok {
+					return nil, fmt.Errorf("of12Driver: port %v not found", p)
+				}
+				out := of12.NewActionOutput()
+				out.SetPort(ofp)
+				ofas = append(ofas, out.Action)
+			}
+
+		case nom.ActionPushVLAN:
+			push := of12.NewActionPushVlan()
+			push.SetEthertype(ethTypeVLAN)
+			vid, err := d.ofOxmField(action.ID, proto)
+			if err != nil {
+				return nil, err
+			}
+			ofas = append(ofas, push.Action, of12SetField(vid))
+
+		case nom.ActionPopVLAN:
+			pop := of12.NewActionPopVlan()
+			ofas = append(ofas, pop.Action)
+
+		case nom.ActionWriteFields:
+			for _, f := range action.Fields {
+				if _, ok := f.(nom.InPort); ok {
+					return nil, errors.New("of12Driver: cannot write the input port")
+				}
+				xf, err := d.ofOxmField(f, proto)
+				if err != nil {
+					return nil, err
+				}
+				if xf.OxmField()&1 != 0 {
+					return nil, fmt.Errorf("of12Driver: cannot write masked field %v",
+						f)
+				}
+				ofas = append(ofas, of12SetField(xf))
+			}
+
+		default:
+			return nil, fmt.Errorf("of12Driver: action not supported %v", action)
+		}
+	}
+	return ofas, nil
+}
+
+// ofFlood returns the actions that flood a packet. OpenFlow 1.2 has no port
+// configuration for flooding, and the driver emulates PortConfigNoFlood by
+// outputting the packet on each port that is not configured so. The switch
+// drops the copy output on the ingress port. If no port is configured with
+// PortConfigNoFlood, the packet is output on PP_FLOOD.
+func (d *of12Driver) ofFlood() []of12.Action {
+	var ports []uint32
+	blocked := false
+	for no, p := range d.ports.ports() {
+		if p.Config&nom.PortConfigNoFlood != 0 {
+			blocked = true
+			continue
+		}
+		ports = append(ports, no)
+	}
+	if !blocked {
+		ports = []uint32{uint32(of12.PP_FLOOD)}
+	}
+	sort.Sort(portNos(ports))
+
+	ofas := make([]of12.Action, 0, len(ports))
+	for _, p := range ports {
+		out := of12.NewActionOutput()
+		out.SetPort(p)
+		ofas = append(ofas, out.Action)
+	}
+	return ofas
+}
+
+// of12SetField returns a set-field action for the OXM field, padded to 64 bits.
+func of12SetField(f of12.OxmField) of12.Action {
+	set := of12.NewActionSetField()
+	set.SetField(f)
+	for set.Size()%8 != 0 {
+		set.AddPad(0)
+	}
+	return set.Action
+}
+
+// nomActions converts OpenFlow 1.2 actions into NOM actions. Consecutive output
+// actions are merged into one forward action, and consecutive set-field
+// actions are merged into one write-fields action.
+func (d *of12Driver) nomActions(ofas []of12.Action) ([]nom.Action, error) {
+	if len(ofas) == 0 {
+		return []nom.Action{nom.ActionDrop{}}, nil
+	}
+
+	var actions []nom.Action
+	for i := 0; i < len(ofas); i++ {
+		ofa := ofas[i]
+		switch of12.ActionType(ofa.Type()) {
+		case of12.PAT_OUTPUT:
+			out, err := of12.ToActionOutput(ofa)
+			if err != nil {
+				return nil, err
+			}
+			switch of12.Ports(out.Port()) {
+			case of12.PP_FLOOD:
+				actions = append(actions, nom.ActionFlood{})
+			case of12.PP_CONTROLLER:
+				actions = append(actions, nom.ActionSendToController{})
+			case of12.PP_NORMAL:
+				actions = append(actions, nom.ActionNormal{})
+			default:
+				p, ok := d.ports.ofPort(out.Port())
+				if !ok {
+					return nil, fmt.Errorf("of12Driver: cannot find port %v",
+						out.Port())
+				}
+				actions = appendForward(actions, p.UID())
+			}
+
+		case of12.PAT_PUSH_VLAN:
+			push := nom.ActionPushVLAN{}
+			// The VLAN ID of the new tag is set by the following set-field.
+			if i+1 < len(ofas) {
+				if f, err := d.nomSetField(ofas[i+1]); err == nil {
+					if vid, ok := f.(nom.VLANID); ok {
+						push.ID = vid
+						i++
+					}
+				}
+			}
+			actions = append(actions, push)
+
+		case of12.PAT_POP_VLAN:
+			actions = append(actions, nom.ActionPopVLAN{})
+
+		case of12.PAT_SET_FIELD:
+			f, err := d.nomSetField(ofa)
+			if err != nil {
+				return nil, err
+			}
+			actions = appendWriteField(actions, f)
+
+		default:
+			return nil, fmt.Errorf("of12Driver: action type %v not supported",
+				ofa.Type())
+		}
+	}
+	return actions, nil
+}
+
+func (d *of12Driver) nomSetField(ofa of12.Action) (nom.Field, error) {
+	set, err := of12.ToActionSetField(ofa)
+	if err != nil {
+		return nil, err
+	}
+	f, err := d.nomField(set.Field())
+	if err != nil {
+		return nil, err
+	}
+	if f == nil {
+		return nil, fmt.Errorf("of12Driver: set-field %v not supported",
+			set.Field().OxmField())
+	}
+	return f, nil
+}
+
+// ofActions converts NOM actions into OpenFlow 1.3 actions. A forward action
+// is expanded into one output action per port, and a drop action results in
+// no action. proto is the IP protocol of the packets, which selects the OXM
+// fields written for transport ports.
+func (d *of13Driver) ofActions(actions []nom.Action,
+	proto nom.IPProto) ([]of13.Action, error) {
+	var ofas []of13.Action
+	for _, a := range actions {
+		switch action := a.(type) {
+		case nom.ActionDrop:
+
+		case nom.ActionFlood:
+			ofas = append(ofas, d.ofFlood()...)
+
+		case nom.ActionNormal:
+			out := of13.NewActionOutput()
+			out.SetPort(uint32(of13.PP_NORMAL))
+			ofas = append(ofas, out.Action)
+
+		case nom.ActionSendToController:
+			out := of13.NewActionOutput()
+			out.SetPort(uint32(of13.PP_CONTROLLER))
+			out.SetMaxLen(uint16(of13.PCML_NO_BUFFER))
+			ofas = append(ofas, out.Action)
+
+		case nom.ActionForward:
+			if len(action.Ports) == 0 {
+				return nil, errors.New("of13Driver: no port to forward to")
+			}
+			for _, p := range action.Ports {
+				ofp, ok := d.ports.portNo(p)
+				if !ok {
+					return nil, fmt.Errorf("of13Driver: port %v not found", p)
+				}
+				out := of13.NewActionOutput()
+				out.SetPort(ofp)
+				ofas = append(ofas, out.Action)
+			}
+
+		case nom.ActionPushVLAN:
+			push := of13.NewActionPushVlan()
+			push.SetEthertype(ethTypeVLAN)
+			vid, err := d.ofOxmField(action.ID, proto)
+			if err != nil {
+				return nil, err
+			}
+			ofas = append(ofas, push.Action, of13SetField(vid))
+
+		case nom.ActionPopVLAN:
+			pop := of13.NewActionPopVlan()
+			ofas = append(ofas, pop.Action)
+
+		case nom.ActionWriteFields:
+			for _, f := range action.Fields {
+				if _, ok := f.(nom.InPort); ok {
+					return nil, errors.New("of13Driver: cannot write the input port")
+				}
+				xf, err := d.ofOxmField(f, proto)
+				if err != nil {
+					return nil, err
+				}
+				if xf.OxmField()&1 != 0 {
+					return nil, fmt.Errorf("of13Driver: cannot write masked field %v",
+						f)
+				}
+				ofas = append(ofas, of13SetField(xf))
+			}
+
+		default:
+			return nil, fmt.Errorf("of13Driver: action not supported %v", action)
+		}
+	}
+	return ofas, nil
+}
+
+// ofFlood returns the actions that flood a packet. OpenFlow 1.3 has no port
+// configuration for flooding, and the driver emulates PortConfigNoFlood by
+// outputting the packet on each port that is not configured so. The switch
+// drops the copy output on the ingress port. If no port is configured with
+// PortConfigNoFlood, the packet is output on PP_FLOOD.
+func (d *of13Driver) ofFlood() []of13.Action {
+	var ports []uint32
+	blocked := false
+	for no, p := range d.ports.ports() {
+		if p.Config&nom.PortConfigNoFlood != 0 {
+			blocked = true
+			continue
+		}
+		ports = append(ports, no)
+	}
+	if !blocked {
+		ports = []uint32{uint32(of13.PP_FLOOD)}
+	}
+	sort.Sort(portNos(ports))
+
+	ofas := make([]of13.Action, 0, len(ports))
+	for _, p := range ports {
+		out := of13.NewActionOutput()
+		out.SetPort(p)
+		ofas = append(ofas, out.Action)
+	}
+	return ofas
+}
+
+// of13SetField returns a set-field action for the OXM field, padded to 64 bits.
+func of13SetField(f of13.OxmField) of13.Action {
+	set := of13.NewActionSetField()
+	set.SetField(f)
+	for set.Size()%8 != 0 {
+		set.AddPad(0)
+	}
+	return set.Action
+}
+
+// nomActions converts OpenFlow 1.3 actions into NOM actions. Consecutive output
+// actions are merged into one forward action, and consecutive set-field
+// actions are merged into one write-fields action.
+func (d *of13Driver) nomActions(ofas []of13.Action) ([]nom.Action, error) {
+	if len(ofas) == 0 {
+		return []nom.Action{nom.ActionDrop{}}, nil
+	}
+
+	var actions []nom.Action
+	for i := 0; i < len(ofas); i++ {
+		ofa := ofas[i]
+		switch of13.ActionType(ofa.Type()) {
+		case of13.PAT_OUTPUT:
+			out, err := of13.ToActionOutput(ofa)
+			if err != nil {
+				return nil, err
+			}
+			switch of13.Ports(out.Port()) {
+			case of13.PP_FLOOD:
+				actions = append(actions, nom.ActionFlood{})
+			case of13.PP_CONTROLLER:
+				actions = append(actions, nom.ActionSendToController{})
+			case of13.PP_NORMAL:
+				actions = append(actions, nom.ActionNormal{})
+			default:
+				p, ok := d.ports.ofPort(out.Port())
+				if !ok {
+					return nil, fmt.Errorf("of13Driver: cannot find port %v",
+						out.Port())
+				}
+				actions = appendForward(actions, p.UID())
+			}
+
+		case of13.PAT_PUSH_VLAN:
+			push := nom.ActionPushVLAN{}
+			// The VLAN ID of the new tag is set by the following set-field.
+			if i+1 < len(ofas) {
+				if f, err := d.nomSetField(ofas[i+1]); err == nil {
+					if vid, ok := f.(nom.VLANID); ok {
+						push.ID = vid
+						i++
+					}
+				}
+			}
+			actions = append(actions, push)
+
+		case of13.PAT_POP_VLAN:
+			actions = append(actions, nom.ActionPopVLAN{})
+
+		case of13.PAT_SET_FIELD:
+			f, err := d.nomSetField(ofa)
+			if err != nil {
+				return nil, err
+			}
+			actions = appendWriteField(actions, f)
+
+		default:
+			return nil, fmt.Errorf("of13Driver: action type %v not supported",
+				ofa.Type())
+		}
+	}
+	return actions, nil
+}
+
+func (d *of13Driver) nomSetField(ofa of13.Action) (nom.Field, error) {
+	set, err := of13.ToActionSetField(ofa)
+	if err != nil {
+		return nil, err
+	}
+	f, err := d.nomField(set.Field())
+	if err != nil {
+		return nil, err
+	}
+	if f == nil {
+		return nil, fmt.Errorf("of13Driver: set-field %v not supported",
+			set.Field().OxmField())
+	}
+	return f, nil
+}
+
+type portNos []uint32
+
+func (s portNos) Len() int           { return len(s) }
+func (s portNos) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s portNos) Less(i, j int) bool { return s[i] < s[j] }
+
+// packetIPProto returns the IP protocol of the packet, or zero if the packet is
+// neither a TCP segment nor a UDP datagram.
+func packetIPProto(p nom.Packet) nom.IPProto {
+	if _, ok := p.UDP(); ok {
+		return nom.IPProtoUDP
+	}
+	if _, ok := p.TCP(); ok {
+		return nom.IPProtoTCP
+	}
+	return 0
+}
+
+// appendForward adds the port to the forward action at the end of actions. If
+// the last action is not a forward action, a new one is appended.
+func appendForward(actions []nom.Action, port nom.UID) []nom.Action {
+	if n := len(actions); n != 0 {
+		if fwd, ok := actions[n-1].(nom.ActionForward); ok {
+			fwd.Ports = append(fwd.Ports, port)
+			actions[n-1] = fwd
+			return actions
+		}
+	}
+	return append(actions, nom.ActionForward{Ports: []nom.UID{port}})
+}
+
+// appendWriteField adds the field to the write-fields action at the end of
+// actions. If the last action is not a write-fields action, a new one is
+// appended.
+func appendWriteField(actions []nom.Action, f nom.Field) []nom.Action {
+	if n := len(actions); n != 0 {
+		if w, ok := actions[n-1].(nom.ActionWriteFields); ok {
+			w.Fields = append(w.Fields, f)
+			actions[n-1] = w
+			return actions
+		}
+	}
+	return append(actions, nom.ActionWriteFields{Fields: []nom.Field{f}})
+}
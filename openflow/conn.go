@@ -2,6 +2,8 @@ package openflow
 
 import (
 	"io"
+	"sync"
+	"sync/atomic"
 
 	bh "github.com/kandoo/beehive"
 	"github.com/kandoo/beehive-netctrl/nom"
@@ -24,6 +26,28 @@ type ofConn struct {
 
 	node   nom.Node // Node that this connection represents.
 	driver ofDriver // OpenFlow driver of this connection.
+
+	xid uint32 // The last transaction ID used in this connection.
+
+	pendingMu sync.Mutex
+	pending   map[uint32]pendingRequest // Outstanding requests by xid.
+
+	// Flow statistics of the replies that have more fragments to come, by xid.
+	// It is only accessed when reading packets.
+	flowStats map[uint32][]nom.FlowStats
+}
+
+// maxPendingRequests is the maximum number of outstanding requests, that are
+// not waiting for a barrier reply, tracked in a connection. Such requests are
+// never acknowledged by the switch and we only keep the recent ones to match
+// the errors.
+const maxPendingRequests = 1024
+
+// pendingRequest is a message sent to the switch that can still fail.
+type pendingRequest struct {
+	From    uint64      // The bee that has issued the message.
+	Msg     interface{} // The NOM message.
+	Barrier bool        // Whether the request is waiting for a barrier reply.
 }
 
 func (c *ofConn) drainWCh() {
@@ -40,6 +64,7 @@ func (c *ofConn) Start(ctx bh.RcvContext) {
 			c.driver.handleConnClose(c)
 		}
 		c.Close()
+		c.failPendingRequests()
 		// TODO(soheil): is there any better way to prevent deadlocks?
 		glog.Infof("%v drains write queue for %v", ctx, c.RemoteAddr())
 		go c.drainWCh()
@@ -166,3 +191,156 @@ func (c *ofConn) WriteHeader(pkt of.Header) error {
 	c.wErr = c.HeaderConn.WriteHeader(pkt)
 	return c.wErr
 }
+
+// nextXid returns a new transaction ID for a message sent to the switch.
+func (c *ofConn) nextXid() uint32 {
+	return atomic.AddUint32(&c.xid, 1)
+}
+
+// addPendingRequest tracks msg using xid until an error or a barrier reply
+// (if barrier is true) is received for xid.
+func (c *ofConn) addPendingRequest(xid uint32, msg bh.Msg, barrier bool) {
+	c.pendingMu.Lock()
+	defer c.pendingMu.Unlock()
+
+	if c.pending == nil {
+		c.pending = make(map[uint32]pendingRequest)
+	}
+	c.pending[xid] = pendingRequest{
+		From:    msg.From(),
+		Msg:     msg.Data(),
+		Barrier: barrier,
+	}
+	if r, ok := c.pending[xid-maxPendingRequests]; ok && !r.Barrier {
+		delete(c.pending, xid-maxPendingRequests)
+	}
+}
+
+// popPendingRequest removes and returns the request pending on xid, if any.
+func (c *ofConn) popPendingRequest(xid uint32) (pendingRequest, bool) {
+	c.pendingMu.Lock()
+	defer c.pendingMu.Unlock()
+
+	r, ok := c.pending[xid]
+	if ok {
+		delete(c.pending, xid)
+	}
+	return r, ok
+}
+
+// addFlowStats collects the flow statistics of a fragment of the reply for xid.
+// If more is false, the fragment is the last one and it returns the result of
+// the query with all the statistics of the reply.
+func (c *ofConn) addFlowStats(xid uint32, stats []nom.FlowStats,
+	more bool) (nom.FlowStatsQueryResult, bool) {
+
+	if more {
+		if c.flowStats == nil {
+			c.flowStats = make(map[uint32][]nom.FlowStats)
+		}
+		c.flowStats[xid] = append(c.flowStats[xid], stats...)
+		return nom.FlowStatsQueryResult{}, false
+	}
+
+	res := nom.FlowStatsQueryResult{
+		Node:  c.node.UID(),
+		Stats: append(c.flowStats[xid], stats...),
+	}
+	delete(c.flowStats, xid)
+	if r, ok := c.popPendingRequest(xid); ok {
+		if q, ok := r.Msg.(nom.FlowStatsQuery); ok {
+			res.ID = q.ID
+		}
+	}
+	return res, true
+}
+
+// writeRequest writes pkt, the OpenFlow message of msg, and tracks it so that
+// errors can be sent back to the bee that has issued msg. If pkt is a barrier
+// request, barrier must be true.
+func (c *ofConn) writeRequest(msg bh.Msg, pkt of.Header, barrier bool) error {
+	xid := c.nextXid()
+	pkt.SetXid(xid)
+	c.addPendingRequest(xid, msg, barrier)
+	return c.WriteHeader(pkt)
+}
+
+// writeMod writes the flow-mod or the port-mod of msg followed by a barrier
+// request. Both messages share the same transaction ID, and the request is kept
+// pending until either the barrier reply or an error with that transaction ID
+// is received.
+func (c *ofConn) writeMod(msg bh.Msg, mod of.Header,
+	barrier of.Header) error {
+
+	xid := c.nextXid()
+	mod.SetXid(xid)
+	barrier.SetXid(xid)
+	c.addPendingRequest(xid, msg, true)
+	if err := c.WriteHeader(mod); err != nil {
+		c.popPendingRequest(xid)
+		return err
+	}
+	return c.WriteHeader(barrier)
+}
+
+// confirmRequest handles the barrier reply for xid. If the pending request is
+// a flow-mod, it emits a FlowEntryInstalled. If it is a port-mod, the driver
+// reports the new configuration of the port. If it is a barrier, it sends a
+// BarrierReply to the issuer of the barrier. BarrierReply is sent the same way
+// as DriverError to make sure it is received after the errors.
+func (c *ofConn) confirmRequest(xid uint32) {
+	r, ok := c.popPendingRequest(xid)
+	if !ok {
+		return
+	}
+	switch req := r.Msg.(type) {
+	case nom.AddFlowEntry:
+		c.ctx.Emit(nom.FlowEntryInstalled{Flow: req.Flow})
+	case nom.ModifyPort:
+		c.driver.handlePortModified(req, c)
+	case nom.Barrier:
+		c.ctx.SendToBee(nom.BarrierReply{Node: req.Node, ID: req.ID}, r.From)
+	}
+}
+
+// failRequest sends a DriverError to the bee that has issued the request
+// pending on xid, and returns whether there was such a request.
+func (c *ofConn) failRequest(xid uint32, code nom.DriverErrorCode,
+	err string) bool {
+
+	r, ok := c.popPendingRequest(xid)
+	if !ok {
+		return false
+	}
+	c.sendDriverError(r, code, err)
+	return true
+}
+
+// failPendingRequests fails the requests that are waiting for a barrier reply.
+func (c *ofConn) failPendingRequests() {
+	c.pendingMu.Lock()
+	defer c.pendingMu.Unlock()
+
+	for xid, r := range c.pending {
+		if r.Barrier {
+			c.sendDriverError(r, nom.DriverErrDisconnected, "connection closed")
+		}
+		delete(c.pending, xid)
+	}
+}
+
+func (c *ofConn) sendDriverError(r pendingRequest, code nom.DriverErrorCode,
+	err string) {
+
+	derr := nom.DriverError{
+		Node:    c.node.UID(),
+		Code:    code,
+		Err:     err,
+		Request: r.Msg,
+	}
+	if r.From == 0 {
+		glog.Errorf("%v", derr)
+		return
+	}
+	c.ctx.SendToBee(derr, r.From)
+}
@@ -0,0 +1,123 @@
+package openflow
+
+import (
+	"testing"
+
+	bh "github.com/kandoo/beehive"
+	"github.com/kandoo/beehive-netctrl/nom"
+	"github.com/kandoo/beehive-netctrl/openflow/of10"
+)
+
+func TestPendingRequests(t *testing.T) {
+	ctx := &bh.MockRcvContext{}
+	c := &ofConn{ctx: ctx}
+	flows := []nom.FlowEntry{
+		{Node: "n1", Priority: 1},
+		{Node: "n1", Priority: 2},
+	}
+	for i := range flows {
+		msg := &bh.MockMsg{
+			MsgData: nom.AddFlowEntry{Flow: flows[i]},
+			MsgFrom: 1,
+		}
+		c.addPendingRequest(uint32(i+1), msg, true)
+	}
+
+	c.confirmRequest(1)
+	if !c.failRequest(2, nom.DriverErrTableFull, "table full") {
+		t.Error("no pending request for xid 2")
+	}
+	// The flow is already failed and the barrier reply should be ignored.
+	c.confirmRequest(2)
+	if c.failRequest(3, nom.DriverErrTableFull, "table full") {
+		t.Error("unexpected pending request for xid 3")
+	}
+
+	if len(ctx.CtxMsgs) != 2 {
+		t.Fatalf("invalid number of messages: actual=%v want=2", len(ctx.CtxMsgs))
+	}
+	inst, ok := ctx.CtxMsgs[0].Data().(nom.FlowEntryInstalled)
+	if !ok || !inst.Flow.Equals(flows[0]) {
+		t.Errorf("invalid confirmation: %v", ctx.CtxMsgs[0].Data())
+	}
+	derr, ok := ctx.CtxMsgs[1].Data().(nom.DriverError)
+	if !ok || derr.Code != nom.DriverErrTableFull {
+		t.Errorf("invalid driver error: %v", ctx.CtxMsgs[1].Data())
+	}
+	if ctx.CtxMsgs[1].To() != 1 {
+		t.Errorf("driver error is not sent to the issuer: actual=%v want=1",
+			ctx.CtxMsgs[1].To())
+	}
+	if add, ok := derr.Request.(nom.AddFlowEntry); !ok ||
+		!add.Flow.Equals(flows[1]) {
+
+		t.Errorf("invalid request in driver error: %v", derr.Request)
+	}
+	if len(c.pending) != 0 {
+		t.Errorf("requests are still pending: %v", c.pending)
+	}
+}
+
+func TestPendingRequestsEviction(t *testing.T) {
+	c := &ofConn{}
+	msg := &bh.MockMsg{MsgData: nom.PacketOut{}}
+	c.addPendingRequest(1, msg, false)
+	c.addPendingRequest(2, msg, true)
+	c.addPendingRequest(1+maxPendingRequests, msg, false)
+	c.addPendingRequest(2+maxPendingRequests, msg, false)
+	if _, ok := c.pending[1]; ok {
+		t.Error("old request is not evicted")
+	}
+	if _, ok := c.pending[2]; !ok {
+		t.Error("request waiting for a barrier is evicted")
+	}
+}
+
+func TestFragmentedFlowStatsReply(t *testing.T) {
+	ctx := &bh.MockRcvContext{}
+	c := &ofConn{ctx: ctx, node: nom.Node{ID: "n1"}}
+	d := &of10Driver{}
+	query := &bh.MockMsg{MsgData: nom.FlowStatsQuery{ID: 7, Node: "n1"}}
+	c.addPendingRequest(1, query, false)
+
+	for i, more := range []bool{true, true, false} {
+		reply := of10.NewFlowStatsReply()
+		reply.SetXid(1)
+		if more {
+			reply.SetFlags(uint16(of10.PSF_REPLY_MORE))
+		}
+		match := of10.NewMatch()
+		match.SetWildcards(uint32(of10.PFW_ALL))
+		stats := of10.NewFlowStats()
+		stats.SetMatch(match)
+		stats.SetPriority(uint16(i))
+		reply.AddFlowStats(stats)
+		if err := d.handleFlowStatsReply(reply, c); err != nil {
+			t.Fatal(err)
+		}
+		if more && len(ctx.CtxMsgs) != 0 {
+			t.Fatalf("result emitted before the last fragment: %v",
+				ctx.CtxMsgs[0].Data())
+		}
+	}
+
+	if len(ctx.CtxMsgs) != 1 {
+		t.Fatalf("invalid number of messages: actual=%v want=1", len(ctx.CtxMsgs))
+	}
+	res := ctx.CtxMsgs[0].Data().(nom.FlowStatsQueryResult)
+	if res.ID != 7 || res.Node != "n1" {
+		t.Errorf("invalid result: actual=%v/%v want=7/n1", res.ID, res.Node)
+	}
+	if len(res.Stats) != 3 {
+		t.Fatalf("invalid number of stats: actual=%v want=3", len(res.Stats))
+	}
+	for i, s := range res.Stats {
+		if s.Priority != uint16(i) {
+			t.Errorf("invalid priority of stats #%d: actual=%v want=%v", i,
+				s.Priority, i)
+		}
+	}
+	if len(c.flowStats) != 0 || len(c.pending) != 0 {
+		t.Errorf("the reply is not cleaned up: %v %v", c.flowStats, c.pending)
+	}
+}
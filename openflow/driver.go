@@ -3,12 +3,14 @@ package openflow
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	bh "github.com/kandoo/beehive"
 	"github.com/kandoo/beehive-netctrl/nom"
 	"github.com/kandoo/beehive-netctrl/openflow/of"
 	"github.com/kandoo/beehive-netctrl/openflow/of10"
 	"github.com/kandoo/beehive-netctrl/openflow/of12"
+	"github.com/kandoo/beehive-netctrl/openflow/of13"
 	"github.com/kandoo/beehive/Godeps/_workspace/src/github.com/golang/glog"
 )
 
@@ -17,16 +19,19 @@ type ofDriver interface {
 	handlePkt(pkt of.Header, conn *ofConn) error
 	handleMsg(msg bh.Msg, conn *ofConn) error
 	handleConnClose(conn *ofConn)
+	handlePortModified(mod nom.ModifyPort, conn *ofConn)
 }
 
 type of10Driver struct {
-	ofPorts  map[uint16]*nom.Port
-	nomPorts map[nom.UID]uint16
+	ports portMap
 }
 
 type of12Driver struct {
-	ofPorts  map[uint32]*nom.Port
-	nomPorts map[nom.UID]uint32
+	ports portMap
+}
+
+type of13Driver struct {
+	ports portMap
 }
 
 func (d *of10Driver) handlePkt(pkt of.Header, c *ofConn) error {
@@ -42,6 +47,13 @@ func (d *of10Driver) handlePkt(pkt of.Header, c *ofConn) error {
 		return d.handleFeaturesReply(of10.NewFeaturesReplyWithBuf(pkt10.Buf), c)
 	case of10.IsPacketIn(pkt10):
 		return d.handlePacketIn(of10.NewPacketInWithBuf(pkt10.Buf), c)
+	case of10.IsFlowRemoved(pkt10):
+		return d.handleFlowRemoved(of10.NewFlowRemovedWithBuf(pkt10.Buf), c)
+	case of10.IsPortStatus(pkt10):
+		return d.handlePortStatus(of10.NewPortStatusWithBuf(pkt10.Buf), c)
+	case of10.IsBarrierReply(pkt10):
+		c.confirmRequest(pkt10.Xid())
+		return nil
 	case of10.IsErrorMsg(pkt10):
 		return d.handleErrorMsg(of10.NewErrorMsgWithBuf(pkt10.Buf), c)
 	case of10.IsStatsReply(pkt10):
@@ -65,6 +77,13 @@ func (d *of12Driver) handlePkt(pkt of.Header, c *ofConn) error {
 		return d.handleFeaturesReply(of12.NewFeaturesReplyWithBuf(pkt12.Buf), c)
 	case of12.IsPacketIn(pkt12):
 		return d.handlePacketIn(of12.NewPacketInWithBuf(pkt12.Buf), c)
+	case of12.IsFlowRemoved(pkt12):
+		return d.handleFlowRemoved(of12.NewFlowRemovedWithBuf(pkt12.Buf), c)
+	case of12.IsPortStatus(pkt12):
+		return d.handlePortStatus(of12.NewPortStatusWithBuf(pkt12.Buf), c)
+	case of12.IsBarrierReply(pkt12):
+		c.confirmRequest(pkt12.Xid())
+		return nil
 	case of12.IsErrorMsg(pkt12):
 		return d.handleErrorMsg(of12.NewErrorMsgWithBuf(pkt12.Buf), c)
 	case of12.IsStatsReply(pkt12):
@@ -77,6 +96,38 @@ func (d *of12Driver) handlePkt(pkt of.Header, c *ofConn) error {
 	}
 }
 
+func (d *of13Driver) handlePkt(pkt of.Header, c *ofConn) error {
+	pkt13, err := of13.ToHeader13(pkt)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case of13.IsEchoRequest(pkt13):
+		return d.handleEchoRequest(of13.NewEchoRequestWithBuf(pkt13.Buf), c)
+	case of13.IsFeaturesReply(pkt13):
+		return d.handleFeaturesReply(of13.NewFeaturesReplyWithBuf(pkt13.Buf), c)
+	case of13.IsPacketIn(pkt13):
+		return d.handlePacketIn(of13.NewPacketInWithBuf(pkt13.Buf), c)
+	case of13.IsFlowRemoved(pkt13):
+		return d.handleFlowRemoved(of13.NewFlowRemovedWithBuf(pkt13.Buf), c)
+	case of13.IsPortStatus(pkt13):
+		return d.handlePortStatus(of13.NewPortStatusWithBuf(pkt13.Buf), c)
+	case of13.IsBarrierReply(pkt13):
+		c.confirmRequest(pkt13.Xid())
+		return nil
+	case of13.IsErrorMsg(pkt13):
+		return d.handleErrorMsg(of13.NewErrorMsgWithBuf(pkt13.Buf), c)
+	case of13.IsMultipartReply(pkt13):
+		return d.handleMultipartReply(of13.NewMultipartReplyWithBuf(pkt13.Buf), c)
+	case of13.IsRoleReply(pkt13):
+		return d.handleRoleReply(of13.NewRoleReplyWithBuf(pkt13.Buf), c)
+	default:
+		glog.Errorf("received unsupported packet: %v", pkt.Type())
+		return nil
+	}
+}
+
 func (d *of10Driver) handleMsg(msg bh.Msg, c *ofConn) error {
 	ofh, err := d.convToOF(msg, c)
 	if err != nil {
@@ -88,7 +139,15 @@ func (d *of10Driver) handleMsg(msg bh.Msg, c *ofConn) error {
 		return nil
 	}
 
-	if err := c.WriteHeader(ofh); err != nil {
+	switch msg.Data().(type) {
+	case nom.AddFlowEntry, nom.ModifyPort:
+		err = c.writeMod(msg, ofh, of10.NewBarrierRequest().Header)
+	case nom.Barrier:
+		err = c.writeRequest(msg, ofh, true)
+	default:
+		err = c.writeRequest(msg, ofh, false)
+	}
+	if err != nil {
 		glog.Errorf("ofconn: cannot write packet: %v", err)
 		return err
 	}
@@ -107,7 +166,42 @@ func (d *of12Driver) handleMsg(msg bh.Msg, c *ofConn) error {
 		return nil
 	}
 
-	if err := c.WriteHeader(ofh); err != nil {
+	switch msg.Data().(type) {
+	case nom.AddFlowEntry, nom.ModifyPort:
+		err = c.writeMod(msg, ofh, of12.NewBarrierRequest().Header)
+	case nom.Barrier:
+		err = c.writeRequest(msg, ofh, true)
+	default:
+		err = c.writeRequest(msg, ofh, false)
+	}
+	if err != nil {
+		glog.Errorf("ofconn: cannot write packet: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (d *of13Driver) handleMsg(msg bh.Msg, c *ofConn) error {
+	ofh, err := d.convToOF(msg, c)
+	if err != nil {
+		return err
+	}
+
+	// Means we should ignore the OpenFlow header.
+	if ofh.Size() == 0 {
+		return nil
+	}
+
+	switch msg.Data().(type) {
+	case nom.AddFlowEntry, nom.ModifyPort:
+		err = c.writeMod(msg, ofh, of13.NewBarrierRequest().Header)
+	case nom.Barrier:
+		err = c.writeRequest(msg, ofh, true)
+	default:
+		err = c.writeRequest(msg, ofh, false)
+	}
+	if err != nil {
 		glog.Errorf("ofconn: cannot write packet: %v", err)
 		return err
 	}
@@ -123,6 +217,10 @@ func (d *of12Driver) handleConnClose(c *ofConn) {
 	emitNodeDisconnected(c)
 }
 
+func (d *of13Driver) handleConnClose(c *ofConn) {
+	emitNodeDisconnected(c)
+}
+
 func emitNodeDisconnected(c *ofConn) {
 	c.ctx.Emit(nom.NodeDisconnected{
 		Node: c.node,
@@ -151,19 +249,19 @@ func (d *of10Driver) convToOF(msg bh.Msg, c *ofConn) (of.Header, error) {
 		out.Init()
 		out.SetBufferId(uint32(data.BufferID))
 
-		ofPort, ok := d.nomPorts[data.InPort]
+		ofPort, ok := d.ports.portNo(data.InPort)
 		if ok {
-			out.SetInPort(ofPort)
+			out.SetInPort(uint16(ofPort))
 		}
 
 		// FIXME(soheil): when actions are added after data, the packet becomes
 		// corrupted.
-		for _, a := range data.Actions {
-			ofa, err := d.convAction(a)
-			if err != nil {
-				return of.Header{},
-					fmt.Errorf("of10Driver: invalid action %v", err)
-			}
+		ofas, err := d.ofActions(data.Actions)
+		if err != nil {
+			return of.Header{},
+				fmt.Errorf("of10Driver: invalid action %v", err)
+		}
+		for _, ofa := range ofas {
 			out.AddActions(ofa)
 		}
 
@@ -178,21 +276,23 @@ func (d *of10Driver) convToOF(msg bh.Msg, c *ofConn) (of.Header, error) {
 	case nom.AddFlowEntry:
 		mod := of10.NewFlowMod()
 		mod.SetCommand(uint16(of10.PFC_ADD))
+		mod.SetFlags(uint16(of10.PFF_SEND_FLOW_REM))
+		mod.SetCookie(data.Flow.Cookie)
 		mod.SetPriority(uint16(data.Flow.Priority))
-		mod.SetIdleTimeout(uint16(data.Flow.IdleTimeout))
-		mod.SetHardTimeout(uint16(data.Flow.HardTimeout))
+		mod.SetIdleTimeout(uint16(data.Flow.IdleTimeout / time.Second))
+		mod.SetHardTimeout(uint16(data.Flow.HardTimeout / time.Second))
 		mod.SetBufferId(0xFFFFFFFF)
 		match, err := d.ofMatch(data.Flow.Match)
 		if err != nil {
 			return of.Header{}, fmt.Errorf("of10Driver: invalid match %v", err)
 		}
 		mod.SetMatch(match)
-		for _, a := range data.Flow.Actions {
-			ofa, err := d.convAction(a)
-			if err != nil {
-				return of.Header{},
-					fmt.Errorf("of10Driver: invalid action %v", err)
-			}
+		ofas, err := d.ofActions(data.Flow.Actions)
+		if err != nil {
+			return of.Header{},
+				fmt.Errorf("of10Driver: invalid action %v", err)
+		}
+		for _, ofa := range ofas {
 			mod.AddActions(ofa)
 		}
 		return mod.Header, nil
@@ -200,10 +300,13 @@ func (d *of10Driver) convToOF(msg bh.Msg, c *ofConn) (of.Header, error) {
 	case nom.DelFlowEntry:
 		mod := of10.NewFlowMod()
 		if data.Exact {
-			mod.SetCommand(uint16(of10.PFC_DELETE))
-		} else {
 			mod.SetCommand(uint16(of10.PFC_DELETE_STRICT))
+			mod.SetPriority(data.Priority)
+		} else {
+			mod.SetCommand(uint16(of10.PFC_DELETE))
 		}
+		mod.SetBufferId(0xFFFFFFFF)
+		mod.SetOutPort(uint16(of10.PP_NONE))
 		match, err := d.ofMatch(data.Match)
 		if err != nil {
 			return of.Header{}, fmt.Errorf("of10Driver: invalid match %v", err)
@@ -222,6 +325,35 @@ func (d *of10Driver) convToOF(msg bh.Msg, c *ofConn) (of.Header, error) {
 		query.SetOutPort(uint16(of10.PP_NONE))
 		return query.Header, nil
 
+	case nom.Barrier:
+		return of10.NewBarrierRequest().Header, nil
+
+	case nom.PortStatsQuery:
+		query := of10.NewPortStatsRequest()
+		query.SetPortNo(uint16(of10.PP_NONE))
+		if data.Port != "" {
+			p, ok := d.ports.portNo(data.Port)
+			if !ok {
+				return of.Header{},
+					fmt.Errorf("of10Driver: port %v not found", data.Port)
+			}
+			query.SetPortNo(uint16(p))
+		}
+		return query.Header, nil
+
+	case nom.ModifyPort:
+		portNo, port, ok := d.ports.nomPort(data.Port)
+		if !ok {
+			return of.Header{},
+				fmt.Errorf("of10Driver: port %v not found", data.Port)
+		}
+		mod := of10.NewPortMod()
+		mod.SetPortNo(uint16(portNo))
+		mod.SetHwAddr(port.MACAddr)
+		mod.SetConfig(uint32(of10NOMPortConfig(data.Config)))
+		mod.SetMask(uint32(of10NOMPortConfig(data.Mask)))
+		return mod.Header, nil
+
 	default:
 		return of.Header{}, fmt.Errorf("of10Driver: unsupported message %#v", data)
 	}
@@ -252,17 +384,18 @@ func (d *of12Driver) convToOF(msg bh.Msg, c *ofConn) (of.Header, error) {
 		out.Init()
 		out.SetBufferId(uint32(data.BufferID))
 
-		ofPort, ok := d.nomPorts[data.InPort]
+		ofPort, ok := d.ports.portNo(data.InPort)
 		if ok {
 			out.SetInPort(ofPort)
 		}
 
-		for _, a := range data.Actions {
-			ofa, err := d.convAction(a)
-			if err != nil {
-				return of.Header{},
-					fmt.Errorf("of10Driver: invalid action %v", err)
-			}
+		ofas, err := d.ofActions(data.Actions,
+			packetIPProto(data.Packet))
+		if err != nil {
+			return of.Header{},
+				fmt.Errorf("of12Driver: invalid action %v", err)
+		}
+		for _, ofa := range ofas {
 			out.AddActions(ofa)
 		}
 
@@ -277,9 +410,11 @@ func (d *of12Driver) convToOF(msg bh.Msg, c *ofConn) (of.Header, error) {
 	case nom.AddFlowEntry:
 		mod := of12.NewFlowMod()
 		mod.SetCommand(uint8(of12.PFC_ADD))
+		mod.SetFlags(uint16(of12.PFF_SEND_FLOW_REM))
+		mod.SetCookie(data.Flow.Cookie)
 		mod.SetPriority(uint16(data.Flow.Priority))
-		mod.SetIdleTimeout(uint16(data.Flow.IdleTimeout))
-		mod.SetHardTimeout(uint16(data.Flow.HardTimeout))
+		mod.SetIdleTimeout(uint16(data.Flow.IdleTimeout / time.Second))
+		mod.SetHardTimeout(uint16(data.Flow.HardTimeout / time.Second))
 		mod.SetBufferId(^uint32(0))
 		match, err := d.ofMatch(data.Flow.Match)
 		if err != nil {
@@ -288,12 +423,13 @@ func (d *of12Driver) convToOF(msg bh.Msg, c *ofConn) (of.Header, error) {
 		mod.SetMatch(match)
 
 		inst := of12.NewApplyActions()
-		for _, a := range data.Flow.Actions {
-			ofa, err := d.convAction(a)
-			if err != nil {
-				return of.Header{},
-					fmt.Errorf("of12Driver: invalid action %v", err)
-			}
+		proto, _ := data.Flow.Match.IPProto()
+		ofas, err := d.ofActions(data.Flow.Actions, proto)
+		if err != nil {
+			return of.Header{},
+				fmt.Errorf("of12Driver: invalid action %v", err)
+		}
+		for _, ofa := range ofas {
 			inst.AddActions(ofa)
 		}
 		mod.AddInstructions(inst.Instruction)
@@ -303,13 +439,22 @@ func (d *of12Driver) convToOF(msg bh.Msg, c *ofConn) (of.Header, error) {
 	case nom.DelFlowEntry:
 		mod := of12.NewFlowMod()
 		if data.Exact {
-			mod.SetCommand(uint8(of10.PFC_DELETE))
+			mod.SetCommand(uint8(of12.PFC_DELETE_STRICT))
+			mod.SetPriority(data.Priority)
 		} else {
-			mod.SetCommand(uint8(of10.PFC_DELETE_STRICT))
+			mod.SetCommand(uint8(of12.PFC_DELETE))
 		}
+		if data.Cookie != 0 {
+			mod.SetCookie(data.Cookie)
+			mod.SetCookieMask(^uint64(0))
+		}
+		mod.SetTableId(0xFF)
+		mod.SetBufferId(^uint32(0))
+		mod.SetOutPort(uint32(of12.PP_ANY))
+		mod.SetOutGroup(uint32(of12.PG_ANY))
 		match, err := d.ofMatch(data.Match)
 		if err != nil {
-			return of.Header{}, fmt.Errorf("of10Driver: invalid match %v", err)
+			return of.Header{}, fmt.Errorf("of12Driver: invalid match %v", err)
 		}
 		mod.SetMatch(match)
 		return mod.Header, nil
@@ -326,35 +471,202 @@ func (d *of12Driver) convToOF(msg bh.Msg, c *ofConn) (of.Header, error) {
 		query.SetMatch(match)
 		return query.Header, nil
 
+	case nom.Barrier:
+		return of12.NewBarrierRequest().Header, nil
+
+	case nom.PortStatsQuery:
+		query := of12.NewPortStatsRequest()
+		query.SetPortNo(uint32(of12.PP_ANY))
+		if data.Port != "" {
+			p, ok := d.ports.portNo(data.Port)
+			if !ok {
+				return of.Header{},
+					fmt.Errorf("of12Driver: port %v not found", data.Port)
+			}
+			query.SetPortNo(p)
+		}
+		return query.Header, nil
+
+	case nom.ModifyPort:
+		portNo, port, ok := d.ports.nomPort(data.Port)
+		if !ok {
+			return of.Header{},
+				fmt.Errorf("of12Driver: port %v not found", data.Port)
+		}
+		config, err := of12NOMPortConfig(data.Config)
+		if err != nil {
+			return of.Header{}, fmt.Errorf("of12Driver: %v", err)
+		}
+		mask, err := of12NOMPortConfig(data.Mask)
+		if err != nil {
+			return of.Header{}, fmt.Errorf("of12Driver: %v", err)
+		}
+		mod := of12.NewPortMod()
+		mod.SetPortNo(portNo)
+		mod.SetHwAddr(port.MACAddr)
+		mod.SetConfig(uint32(config))
+		mod.SetMask(uint32(mask))
+		return mod.Header, nil
+
 	default:
 		return of.Header{}, fmt.Errorf("of12Driver: unsupported message %#v", data)
 	}
 }
 
-func (d *of10Driver) convAction(a nom.Action) (of10.Action, error) {
-	switch action := a.(type) {
-	case nom.ActionFlood:
-		flood := of10.NewActionOutput()
-		flood.SetPort(uint16(of10.PP_FLOOD))
-		return flood.Action, nil
+func (d *of13Driver) convToOF(msg bh.Msg, c *ofConn) (of.Header, error) {
+	switch data := msg.Data().(type) {
+	case nom.Ping:
+		c.ctx.Reply(msg, nom.Pong{})
+		return of.Header{}, nil
+
+	case nom.ChangeDriverRole:
+		rr := of13.NewRoleRequest()
+		rr.SetGenerationId(data.Generation)
+		switch data.Role {
+		case nom.DriverRoleDefault:
+			rr.SetRole(uint32(of13.ROLE_EQUAL))
+		case nom.DriverRoleMaster:
+			rr.SetRole(uint32(of13.ROLE_MASTER))
+		case nom.DriverRoleSlave:
+			rr.SetRole(uint32(of13.ROLE_SLAVE))
+		}
+		return rr.Header, nil
+
+	case nom.PacketOut:
+		buf := make([]byte, 256)
+		out := of13.NewPacketOutWithBuf(buf)
+		out.Init()
+		out.SetBufferId(uint32(data.BufferID))
+
+		if ofPort, ok := d.ports.portNo(data.InPort); ok {
+			out.SetInPort(ofPort)
+		} else {
+			out.SetInPort(uint32(of13.PP_CONTROLLER))
+		}
+
+		ofas, err := d.ofActions(data.Actions,
+			packetIPProto(data.Packet))
+		if err != nil {
+			return of.Header{},
+				fmt.Errorf("of13Driver: invalid action %v", err)
+		}
+		for _, ofa := range ofas {
+			out.AddActions(ofa)
+		}
+
+		if data.BufferID == 0xFFFFFFFF {
+			for _, d := range data.Packet {
+				out.AddData(d)
+			}
+		}
+
+		return out.Header, nil
+
+	case nom.AddFlowEntry:
+		mod := of13.NewFlowMod()
+		mod.SetCommand(uint8(of13.PFC_ADD))
+		mod.SetFlags(uint16(of13.PFF_SEND_FLOW_REM))
+		mod.SetCookie(data.Flow.Cookie)
+		mod.SetPriority(uint16(data.Flow.Priority))
+		mod.SetIdleTimeout(uint16(data.Flow.IdleTimeout / time.Second))
+		mod.SetHardTimeout(uint16(data.Flow.HardTimeout / time.Second))
+		mod.SetBufferId(uint32(of13.P_NO_BUFFER))
+		mod.SetOutPort(uint32(of13.PP_ANY))
+		mod.SetOutGroup(uint32(of13.PG_ANY))
+		match, err := d.ofMatch(data.Flow.Match)
+		if err != nil {
+			return of.Header{}, fmt.Errorf("of13Driver: invalid match %v", err)
+		}
+		mod.SetMatch(match)
+
+		inst := of13.NewApplyActions()
+		proto, _ := data.Flow.Match.IPProto()
+		ofas, err := d.ofActions(data.Flow.Actions, proto)
+		if err != nil {
+			return of.Header{},
+				fmt.Errorf("of13Driver: invalid action %v", err)
+		}
+		for _, ofa := range ofas {
+			inst.AddActions(ofa)
+		}
+		mod.AddInstructions(inst.Instruction)
+
+		return mod.Header, nil
+
+	case nom.DelFlowEntry:
+		mod := of13.NewFlowMod()
+		if data.Exact {
+			mod.SetCommand(uint8(of13.PFC_DELETE_STRICT))
+			mod.SetPriority(data.Priority)
+		} else {
+			mod.SetCommand(uint8(of13.PFC_DELETE))
+		}
+		if data.Cookie != 0 {
+			mod.SetCookie(data.Cookie)
+			mod.SetCookieMask(^uint64(0))
+		}
+		mod.SetTableId(0xFF)
+		mod.SetBufferId(uint32(of13.P_NO_BUFFER))
+		mod.SetOutPort(uint32(of13.PP_ANY))
+		mod.SetOutGroup(uint32(of13.PG_ANY))
+		match, err := d.ofMatch(data.Match)
+		if err != nil {
+			return of.Header{}, fmt.Errorf("of13Driver: invalid match %v", err)
+		}
+		mod.SetMatch(match)
+		return mod.Header, nil
+
+	case nom.FlowStatsQuery:
+		match, err := d.ofMatch(data.Match)
+		if err != nil {
+			return of.Header{}, fmt.Errorf("of13Driver: invalid match %v", err)
+		}
+		query := of13.NewFlowStatsRequest()
+		query.SetTableId(0xFF)
+		query.SetOutPort(uint32(of13.PP_ANY))
+		query.SetOutGroup(uint32(of13.PG_ANY))
+		query.SetMatch(match)
+		return query.Header, nil
+
+	case nom.Barrier:
+		return of13.NewBarrierRequest().Header, nil
 
-	case nom.ActionForward:
-		if len(action.Ports) != 1 {
-			return of10.Action{},
-				errors.New("of10Driver: can forward to only one port")
+	case nom.PortStatsQuery:
+		query := of13.NewPortStatsRequest()
+		query.SetPortNo(uint32(of13.PP_ANY))
+		if data.Port != "" {
+			p, ok := d.ports.portNo(data.Port)
+			if !ok {
+				return of.Header{},
+					fmt.Errorf("of13Driver: port %v not found", data.Port)
+			}
+			query.SetPortNo(p)
 		}
-		p, ok := d.nomPorts[action.Ports[0]]
+		return query.Header, nil
+
+	case nom.ModifyPort:
+		portNo, port, ok := d.ports.nomPort(data.Port)
 		if !ok {
-			return of10.Action{},
-				fmt.Errorf("of10Driver: port %v no found", action.Ports[0])
+			return of.Header{},
+				fmt.Errorf("of13Driver: port %v not found", data.Port)
+		}
+		config, err := of12NOMPortConfig(data.Config)
+		if err != nil {
+			return of.Header{}, fmt.Errorf("of13Driver: %v", err)
+		}
+		mask, err := of12NOMPortConfig(data.Mask)
+		if err != nil {
+			return of.Header{}, fmt.Errorf("of13Driver: %v", err)
 		}
-		out := of10.NewActionOutput()
-		out.SetPort(uint16(p))
-		return out.Action, nil
+		mod := of13.NewPortMod()
+		mod.SetPortNo(portNo)
+		mod.SetHwAddr(port.MACAddr)
+		mod.SetConfig(uint32(config))
+		mod.SetMask(uint32(mask))
+		return mod.Header, nil
 
 	default:
-		return of10.Action{},
-			fmt.Errorf("of10Driver: action not supported %v", action)
+		return of.Header{}, fmt.Errorf("of13Driver: unsupported message %#v", data)
 	}
 }
 
@@ -363,7 +675,7 @@ func (d *of10Driver) nomMatch(m of10.Match) (nom.Match, error) {
 	wc := of10.FlowWildcards(m.Wildcards())
 	if wc&of10.PFW_IN_PORT == 0 {
 		ofp := m.InPort()
-		nomp, ok := d.ofPorts[ofp]
+		nomp, ok := d.ports.ofPort(uint32(ofp))
 		if !ok {
 			return nom.Match{}, fmt.Errorf("of10Driver: cannot find port %v", ofp)
 		}
@@ -381,22 +693,62 @@ func (d *of10Driver) nomMatch(m of10.Match) (nom.Match, error) {
 			Mask: nom.MaskNoneMAC,
 		})
 	}
+	if wc&of10.PFW_DL_VLAN == 0 {
+		nm.AddField(nom.VLANID(m.DlVlan()))
+	}
+	if wc&of10.PFW_DL_VLAN_PCP == 0 {
+		nm.AddField(nom.VLANPCP(m.DlVlanPcp()))
+	}
+	isARP := false
 	if wc&of10.PFW_DL_TYPE == 0 {
 		nm.AddField(nom.EthType(m.DlType()))
+		isARP = nom.EthType(m.DlType()) == nom.EthTypeARP
+	}
+	isICMP := false
+	if wc&of10.PFW_NW_PROTO == 0 {
+		if isARP {
+			nm.AddField(nom.ARPOp(m.NwProto()))
+		} else {
+			nm.AddField(nom.IPProto(m.NwProto()))
+			isICMP = nom.IPProto(m.NwProto()) == nom.IPProtoICMP
+		}
 	}
-	if wc&of10.PFW_NW_SRC_MASK != of10.PFW_NW_SRC_ALL && m.NwSrc() != 0 {
-		mask := uint(wc&of10.PFW_NW_SRC_MASK) >> uint(of10.PFW_NW_SRC_SHIFT)
-		nm.AddField(nom.IPv4Src(nom.CIDRToMaskedIPv4(m.NwSrc(), mask)))
+	if wc&of10.PFW_NW_TOS == 0 {
+		nm.AddField(nom.IPDSCP(m.NwTos() >> 2))
+	}
+	// The wildcard of an address is the number of its ignored low-order bits,
+	// and 32 or more ignores the address.
+	srcWC := uint(wc&of10.PFW_NW_SRC_MASK) >> uint(of10.PFW_NW_SRC_SHIFT)
+	if srcWC < 32 && m.NwSrc() != 0 {
+		src := nom.CIDRToMaskedIPv4(m.NwSrc(), 32-srcWC)
+		if isARP {
+			nm.AddField(nom.ARPSpa(src))
+		} else {
+			nm.AddField(nom.IPv4Src(src))
+		}
 	}
-	if wc&of10.PFW_NW_DST_MASK != of10.PFW_NW_DST_ALL && m.NwDst() != 0 {
-		mask := uint(wc&of10.PFW_NW_DST_MASK) >> uint(of10.PFW_NW_DST_SHIFT)
-		nm.AddField(nom.IPv4Dst(nom.CIDRToMaskedIPv4(m.NwDst(), mask)))
+	dstWC := uint(wc&of10.PFW_NW_DST_MASK) >> uint(of10.PFW_NW_DST_SHIFT)
+	if dstWC < 32 && m.NwDst() != 0 {
+		dst := nom.CIDRToMaskedIPv4(m.NwDst(), 32-dstWC)
+		if isARP {
+			nm.AddField(nom.ARPTpa(dst))
+		} else {
+			nm.AddField(nom.IPv4Dst(dst))
+		}
 	}
 	if wc&of10.PFW_TP_SRC == 0 {
-		nm.AddField(nom.TransportPortSrc(m.TpSrc()))
+		if isICMP {
+			nm.AddField(nom.ICMPv4Type(m.TpSrc()))
+		} else {
+			nm.AddField(nom.TransportPortSrc(m.TpSrc()))
+		}
 	}
 	if wc&of10.PFW_TP_DST == 0 {
-		nm.AddField(nom.TransportPortDst(m.TpDst()))
+		if isICMP {
+			nm.AddField(nom.ICMPv4Code(m.TpDst()))
+		} else {
+			nm.AddField(nom.TransportPortDst(m.TpDst()))
+		}
 	}
 	return nm, nil
 }
@@ -407,11 +759,11 @@ func (d *of10Driver) ofMatch(m nom.Match) (of10.Match, error) {
 	for _, f := range m.Fields {
 		switch f := f.(type) {
 		case nom.InPort:
-			p, ok := d.nomPorts[nom.UID(f)]
+			p, ok := d.ports.portNo(nom.UID(f))
 			if !ok {
 				return of10.Match{}, fmt.Errorf("of10Driver: nom port not found %v", f)
 			}
-			ofm.SetInPort(p)
+			ofm.SetInPort(uint16(p))
 			w &= ^of10.PFW_IN_PORT
 
 		case nom.EthDst:
@@ -437,22 +789,14 @@ func (d *of10Driver) ofMatch(m nom.Match) (of10.Match, error) {
 		case nom.IPv4Src:
 			w &= ^of10.PFW_NW_SRC_MASK
 			ofm.SetNwSrc(f.Addr.Uint())
-			mask := f.Mask.PopCount()
-			if mask == 32 {
-				w |= of10.PFW_NW_SRC_MASK
-			} else {
-				w |= of10.FlowWildcards(mask << uint(of10.PFW_NW_SRC_SHIFT))
-			}
+			wc := 32 - f.Mask.PopCount()
+			w |= of10.FlowWildcards(wc << uint(of10.PFW_NW_SRC_SHIFT))
 
 		case nom.IPv4Dst:
 			w &= ^of10.PFW_NW_DST_MASK
 			ofm.SetNwDst(f.Addr.Uint())
-			mask := f.Mask.PopCount()
-			if mask == 32 {
-				w |= of10.PFW_NW_DST_MASK
-			} else {
-				w |= of10.FlowWildcards(mask << uint(of10.PFW_NW_DST_SHIFT))
-			}
+			wc := 32 - f.Mask.PopCount()
+			w |= of10.FlowWildcards(wc << uint(of10.PFW_NW_DST_SHIFT))
 
 		case nom.TransportPortSrc:
 			ofm.SetTpSrc(uint16(f))
@@ -462,344 +806,1311 @@ func (d *of10Driver) ofMatch(m nom.Match) (of10.Match, error) {
 			ofm.SetTpDst(uint16(f))
 			w &= ^of10.PFW_TP_DST
 
+		case nom.VLANID:
+			ofm.SetDlVlan(uint16(f))
+			w &= ^of10.PFW_DL_VLAN
+
+		case nom.VLANPCP:
+			ofm.SetDlVlanPcp(uint8(f))
+			w &= ^of10.PFW_DL_VLAN_PCP
+
+		case nom.IPProto:
+			ofm.SetNwProto(uint8(f))
+			w &= ^of10.PFW_NW_PROTO
+
+		case nom.IPDSCP:
+			// nw_tos holds the DSCP in its 6 most significant bits.
+			ofm.SetNwTos(uint8(f) << 2)
+			w &= ^of10.PFW_NW_TOS
+
+		case nom.ARPOp:
+			// OpenFlow 1.0 matches the lower 8 bits of the ARP opcode in nw_proto.
+			ofm.SetNwProto(uint8(f))
+			w &= ^of10.PFW_NW_PROTO
+
+		case nom.ARPSpa:
+			w &= ^of10.PFW_NW_SRC_MASK
+			ofm.SetNwSrc(f.Addr.Uint())
+			wc := 32 - f.Mask.PopCount()
+			w |= of10.FlowWildcards(wc << uint(of10.PFW_NW_SRC_SHIFT))
+
+		case nom.ARPTpa:
+			w &= ^of10.PFW_NW_DST_MASK
+			ofm.SetNwDst(f.Addr.Uint())
+			wc := 32 - f.Mask.PopCount()
+			w |= of10.FlowWildcards(wc << uint(of10.PFW_NW_DST_SHIFT))
+
+		case nom.ICMPv4Type:
+			// OpenFlow 1.0 matches the ICMP type in tp_src.
+			ofm.SetTpSrc(uint16(f))
+			w &= ^of10.PFW_TP_SRC
+
+		case nom.ICMPv4Code:
+			// OpenFlow 1.0 matches the ICMP code in tp_dst.
+			ofm.SetTpDst(uint16(f))
+			w &= ^of10.PFW_TP_DST
+
 		case nom.IPv6Src, nom.IPv6Dst:
 			return of10.Match{}, fmt.Errorf("of10Driver: IPv6 not supported")
+
+		default:
+			return of10.Match{}, fmt.Errorf("of10Driver: %#v is not supported", f)
 		}
 	}
 	ofm.SetWildcards(uint32(w))
 	return ofm, nil
 }
 
-func (d *of12Driver) convAction(a nom.Action) (of12.Action, error) {
-	switch action := a.(type) {
-	case nom.ActionFlood:
-		flood := of12.NewActionOutput()
-		flood.SetPort(uint32(of12.PP_FLOOD))
-		return flood.Action, nil
-
-	case nom.ActionForward:
-		if len(action.Ports) != 1 {
-			return of12.Action{},
-				errors.New("of12Driver: can forward to only one port")
+func (d *of12Driver) ofMatch(m nom.Match) (of12.Match, error) {
+	ofm := of12.NewOXMatch()
+	proto, _ := m.IPProto()
+	for _, f := range m.Fields {
+		off, err := d.ofOxmField(f, proto)
+		if err != nil {
+			return of12.Match{}, err
 		}
-		p, ok := d.nomPorts[action.Ports[0]]
+		ofm.AddFields(off)
+	}
+	return ofm.Match, nil
+}
+
+// ofOxmField converts a NOM field into an OXM field. Transport ports are
+// converted into UDP ports if proto is UDP, and into TCP ports otherwise.
+func (d *of12Driver) ofOxmField(f nom.Field,
+	proto nom.IPProto) (of12.OxmField, error) {
+	switch f := f.(type) {
+	case nom.InPort:
+		p, ok := d.ports.portNo(nom.UID(f))
 		if !ok {
-			return of12.Action{},
-				fmt.Errorf("of12Driver: port %v no found", action.Ports[0])
+			return of12.OxmField{},
+				fmt.Errorf("of12Driver: nom port not found %v", f)
+		}
+		off := of12.NewOxmInPort()
+		off.SetInPort(p)
+		return off.OxmField, nil
+
+	case nom.EthDst:
+		if f.Mask == nom.MaskNoneMAC {
+			off := of12.NewOxmEthDst()
+			off.SetMacAddr([6]byte(f.Addr))
+			return off.OxmField, nil
+		}
+		off := of12.NewOxmEthDstMasked()
+		off.SetMacAddr([6]byte(f.Addr))
+		off.SetMask([6]byte(f.Mask))
+		return off.OxmField, nil
+
+	case nom.EthSrc:
+		if f.Mask == nom.MaskNoneMAC {
+			off := of12.NewOxmEthSrc()
+			off.SetMacAddr([6]byte(f.Addr))
+			return off.OxmField, nil
 		}
-		out := of12.NewActionOutput()
-		out.SetPort(uint32(p))
-		return out.Action, nil
+		off := of12.NewOxmEthSrcMasked()
+		off.SetMacAddr([6]byte(f.Addr))
+		off.SetMask([6]byte(f.Mask))
+		return off.OxmField, nil
+
+	case nom.EthType:
+		off := of12.NewOxmEthType()
+		off.SetType(uint16(f))
+		return off.OxmField, nil
+
+	case nom.IPProto:
+		off := of12.NewOxmIpProto()
+		off.SetProto(uint8(f))
+		return off.OxmField, nil
+
+	case nom.IPv4Src:
+		if f.Mask == nom.MaskNoneIPV4 {
+			off := of12.NewOxmIpV4Src()
+			off.SetAddr(f.Addr)
+			return off.OxmField, nil
+		}
+		off := of12.NewOxmIpV4SrcMasked()
+		off.SetAddr(f.Addr)
+		off.SetMask(f.Mask)
+		return off.OxmField, nil
+
+	case nom.IPv4Dst:
+		if f.Mask == nom.MaskNoneIPV4 {
+			off := of12.NewOxmIpV4Dst()
+			off.SetAddr(f.Addr)
+			return off.OxmField, nil
+		}
+		off := of12.NewOxmIpV4DstMasked()
+		off.SetAddr(f.Addr)
+		off.SetMask(f.Mask)
+		return off.OxmField, nil
+
+	case nom.IPv6Src:
+		if f.Mask == nom.MaskNoneIPV6 {
+			off := of12.NewOxmIpV6Src()
+			off.SetAddr(f.Addr)
+			return off.OxmField, nil
+		}
+		off := of12.NewOxmIpV6SrcMasked()
+		off.SetAddr(f.Addr)
+		off.SetMask(f.Mask)
+		return off.OxmField, nil
+
+	case nom.IPv6Dst:
+		if f.Mask == nom.MaskNoneIPV6 {
+			off := of12.NewOxmIpV6Dst()
+			off.SetAddr(f.Addr)
+			return off.OxmField, nil
+		}
+		off := of12.NewOxmIpV6DstMasked()
+		off.SetAddr(f.Addr)
+		off.SetMask(f.Mask)
+		return off.OxmField, nil
+
+	case nom.TransportPortSrc:
+		if proto == nom.IPProtoUDP {
+			off := of12.NewOxmUdpSrc()
+			off.SetPort(uint16(f))
+			return off.OxmField, nil
+		}
+		off := of12.NewOxmTcpSrc()
+		off.SetPort(uint16(f))
+		return off.OxmField, nil
+
+	case nom.TransportPortDst:
+		if proto == nom.IPProtoUDP {
+			off := of12.NewOxmUdpDst()
+			off.SetPort(uint16(f))
+			return off.OxmField, nil
+		}
+		off := of12.NewOxmTcpDst()
+		off.SetPort(uint16(f))
+		return off.OxmField, nil
+
+	case nom.VLANID:
+		off := of12.NewOxmVlanVid()
+		if f == nom.VLANNone {
+			off.SetVid(uint16(of12.PVID_NONE))
+		} else {
+			off.SetVid(uint16(f) | uint16(of12.PVID_PRESENT))
+		}
+		return off.OxmField, nil
+
+	case nom.VLANPCP:
+		off := of12.NewOxmVlanPcp()
+		off.SetPcp(uint8(f))
+		return off.OxmField, nil
+
+	case nom.IPDSCP:
+		off := of12.NewOxmIpDscp()
+		off.SetDscp(uint8(f))
+		return off.OxmField, nil
+
+	case nom.IPECN:
+		off := of12.NewOxmIpEcn()
+		off.SetEcn(uint8(f))
+		return off.OxmField, nil
+
+	case nom.ICMPv4Type:
+		off := of12.NewOxmIcmpV4Type()
+		off.SetType(uint8(f))
+		return off.OxmField, nil
+
+	case nom.ICMPv4Code:
+		off := of12.NewOxmIcmpV4Code()
+		off.SetCode(uint8(f))
+		return off.OxmField, nil
+
+	case nom.ICMPv6Type:
+		off := of12.NewOxmIcmpV6Type()
+		off.SetType(uint8(f))
+		return off.OxmField, nil
+
+	case nom.ICMPv6Code:
+		off := of12.NewOxmIcmpV6Code()
+		off.SetCode(uint8(f))
+		return off.OxmField, nil
+
+	case nom.ARPOp:
+		off := of12.NewOxmArpOp()
+		off.SetOp(uint16(f))
+		return off.OxmField, nil
+
+	case nom.ARPSpa:
+		if f.Mask == nom.MaskNoneIPV4 {
+			off := of12.NewOxmArpSpa()
+			off.SetAddr(f.Addr)
+			return off.OxmField, nil
+		}
+		off := of12.NewOxmArpSpaMasked()
+		off.SetAddr(f.Addr)
+		off.SetMask(f.Mask)
+		return off.OxmField, nil
+
+	case nom.ARPTpa:
+		if f.Mask == nom.MaskNoneIPV4 {
+			off := of12.NewOxmArpTpa()
+			off.SetAddr(f.Addr)
+			return off.OxmField, nil
+		}
+		off := of12.NewOxmArpTpaMasked()
+		off.SetAddr(f.Addr)
+		off.SetMask(f.Mask)
+		return off.OxmField, nil
+
+	case nom.MPLSLabel:
+		off := of12.NewOxmMplsLabel()
+		off.SetLabel(uint32(f))
+		return off.OxmField, nil
+
+	case nom.IPv6FlowLabel:
+		if f.Mask == nom.MaskNoneIPv6FlowLabel {
+			off := of12.NewOxmIpV6Flabel()
+			off.SetFlabel(f.Label)
+			return off.OxmField, nil
+		}
+		off := of12.NewOxmIpV6FlabelMasked()
+		off.SetFlabel(f.Label)
+		off.SetMask(f.Mask)
+		return off.OxmField, nil
+
+	case nom.Metadata:
+		if f.Mask == nom.MaskNoneMetadata {
+			off := of12.NewOxmMetadata()
+			off.SetMetadata(f.Data)
+			return off.OxmField, nil
+		}
+		off := of12.NewOxmMetadataMasked()
+		off.SetMetadata(f.Data)
+		off.SetMask(f.Mask)
+		return off.OxmField, nil
 
 	default:
-		return of12.Action{},
-			fmt.Errorf("of12Driver: action not supported %v", action)
+		return of12.OxmField{}, fmt.Errorf("of12Driver: %#v is not supported", f)
 	}
 }
 
-func (d *of12Driver) ofMatch(m nom.Match) (of12.Match, error) {
-	ofm := of12.NewOXMatch()
-	for _, f := range m.Fields {
-		switch f := f.(type) {
-		case nom.InPort:
-			p, ok := d.nomPorts[nom.UID(f)]
-			if !ok {
-				return of12.Match{}, fmt.Errorf("of12Driver: nom port not found %v", f)
-			}
-			off := of12.NewOxmInPort()
-			off.SetInPort(p)
-			ofm.AddFields(off.OxmField)
-
-		case nom.EthDst:
-			if f.Mask == nom.MaskNoneMAC {
-				off := of12.NewOxmEthDst()
-				off.SetMacAddr([6]byte(f.Addr))
-				ofm.AddFields(off.OxmField)
-			} else {
-				off := of12.NewOxmEthDstMasked()
-				off.SetMacAddr([6]byte(f.Addr))
-				off.SetMask([6]byte(f.Mask))
-				ofm.AddFields(off.OxmField)
-			}
+func (d *of12Driver) nomMatch(m of12.Match) (nom.Match, error) {
+	nm := nom.Match{}
 
-		case nom.EthSrc:
-			if f.Mask == nom.MaskNoneMAC {
-				off := of12.NewOxmEthSrc()
-				off.SetMacAddr([6]byte(f.Addr))
-				ofm.AddFields(off.OxmField)
-			} else {
-				off := of12.NewOxmEthSrcMasked()
-				off.SetMacAddr([6]byte(f.Addr))
-				off.SetMask([6]byte(f.Mask))
-				ofm.AddFields(off.OxmField)
-			}
+	if !of12.IsOXMatch(m) {
+		return nm, fmt.Errorf("of12Driver: std math is not supported")
+	}
 
-		case nom.EthType:
-			off := of12.NewOxmEthType()
-			off.SetType(uint16(f))
-			ofm.AddFields(off.OxmField)
+	xm, err := of12.ToOXMatch(m)
+	if err != nil {
+		return nm, err
+	}
 
-		case nom.IPProto:
-			off := of12.NewOxmIpProto()
-			off.SetProto(uint8(f))
-			ofm.AddFields(off.OxmField)
+	for _, f := range xm.Fields() {
+		// Skip the padding and non-standard fields.
+		if f.OxmClass() != uint16(of12.PXMC_OPENFLOW_BASIC) {
+			continue
+		}
 
-		case nom.IPv4Src:
-			if f.Mask == nom.MaskNoneIPV4 {
-				off := of12.NewOxmIpV4Src()
-				off.SetAddr(f.Addr)
-				ofm.AddFields(off.OxmField)
-			} else {
-				off := of12.NewOxmIpV4SrcMasked()
-				off.SetAddr(f.Addr)
-				off.SetMask(f.Mask)
-				ofm.AddFields(off.OxmField)
-			}
+		nf, err := d.nomField(f)
+		if err != nil {
+			return nom.Match{}, err
+		}
+		if nf != nil {
+			nm.AddField(nf)
+		}
+	}
 
-		case nom.IPv4Dst:
-			if f.Mask == nom.MaskNoneIPV4 {
-				off := of12.NewOxmIpV4Dst()
-				off.SetAddr(f.Addr)
-				ofm.AddFields(off.OxmField)
-			} else {
-				off := of12.NewOxmIpV4DstMasked()
-				off.SetAddr(f.Addr)
-				off.SetMask(f.Mask)
-				ofm.AddFields(off.OxmField)
-			}
+	return nm, nil
+}
 
-		case nom.IPv6Src:
-			if f.Mask == nom.MaskNoneIPV6 {
-				off := of12.NewOxmIpV6Src()
-				off.SetAddr(f.Addr)
-				ofm.AddFields(off.OxmField)
-			} else {
-				off := of12.NewOxmIpV6SrcMasked()
-				off.SetAddr(f.Addr)
-				off.SetMask(f.Mask)
-				ofm.AddFields(off.OxmField)
-			}
+// nomField converts an OXM field into a NOM field. It returns nil if the OXM
+// field is not supported.
+func (d *of12Driver) nomField(f of12.OxmField) (nom.Field, error) {
+	switch f.OxmField() {
+	case uint8(of12.PXMT_IN_PORT):
+		xf, err := of12.ToOxmInPort(f)
+		if err != nil {
+			return nil, err
+		}
 
-		case nom.IPv6Dst:
-			if f.Mask == nom.MaskNoneIPV6 {
-				off := of12.NewOxmIpV6Dst()
-				off.SetAddr(f.Addr)
-				ofm.AddFields(off.OxmField)
-			} else {
-				off := of12.NewOxmIpV6DstMasked()
-				off.SetAddr(f.Addr)
-				off.SetMask(f.Mask)
-				ofm.AddFields(off.OxmField)
-			}
+		np, ok := d.ports.ofPort(xf.InPort())
+		if !ok {
+			return nil, fmt.Errorf("of12Driver: cannot find port %v", xf.InPort())
+		}
+		return nom.InPort(np.UID()), nil
 
-		case nom.TransportPortSrc:
-			off := of12.NewOxmTcpSrc()
-			off.SetPort(uint16(f))
-			ofm.AddFields(off.OxmField)
+	case uint8(of12.PXMT_ETH_TYPE):
+		xf, err := of12.ToOxmEthType(f)
+		if err != nil {
+			return nil, err
+		}
+		return nom.EthType(xf.Type()), nil
 
-		case nom.TransportPortDst:
-			off := of12.NewOxmTcpDst()
-			off.SetPort(uint16(f))
-			ofm.AddFields(off.OxmField)
+	case uint8(of12.PXMT_ETH_SRC):
+		xf, err := of12.ToOxmEthSrc(f)
+		if err != nil {
+			return nil, err
+		}
 
-		default:
-			return of12.Match{}, fmt.Errorf("of12Driver: %#v is not supported", f)
+		nf := nom.EthSrc{}
+		nf.Addr = xf.MacAddr()
+		nf.Mask = nom.MaskNoneMAC
+		return nf, nil
+
+	case uint8(of12.PXMT_ETH_SRC_MASKED):
+		xf, err := of12.ToOxmEthSrcMasked(f)
+		if err != nil {
+			return nil, err
+		}
+
+		nf := nom.EthSrc{}
+		nf.Addr = xf.MacAddr()
+		nf.Mask = xf.Mask()
+		return nf, nil
+
+	case uint8(of12.PXMT_ETH_DST):
+		xf, err := of12.ToOxmEthDst(f)
+		if err != nil {
+			return nil, err
+		}
+
+		nf := nom.EthDst{}
+		nf.Addr = xf.MacAddr()
+		nf.Mask = nom.MaskNoneMAC
+		return nf, nil
+
+	case uint8(of12.PXMT_ETH_DST_MASKED):
+		xf, err := of12.ToOxmEthDstMasked(f)
+		if err != nil {
+			return nil, err
+		}
+
+		nf := nom.EthDst{}
+		nf.Addr = xf.MacAddr()
+		nf.Mask = xf.Mask()
+		return nf, nil
+
+	case uint8(of12.PXMT_IP_PROTO):
+		xf, err := of12.ToOxmIpProto(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.IPProto(xf.Proto()), nil
+
+	case uint8(of12.PXMT_IPV4_SRC):
+		xf, err := of12.ToOxmIpV4Src(f)
+		if err != nil {
+			return nil, err
+		}
+
+		nf := nom.IPv4Src{}
+		nf.Addr = nom.IPv4Addr(xf.Addr())
+		nf.Mask = nom.MaskNoneIPV4
+		return nf, nil
+
+	case uint8(of12.PXMT_IPV4_SRC_MASKED):
+		xf, err := of12.ToOxmIpV4SrcMasked(f)
+		if err != nil {
+			return nil, err
+		}
+
+		nf := nom.IPv4Src{}
+		nf.Addr = nom.IPv4Addr(xf.Addr())
+		nf.Mask = nom.IPv4Addr(xf.Mask())
+		return nf, nil
+
+	case uint8(of12.PXMT_IPV4_DST):
+		xf, err := of12.ToOxmIpV4Dst(f)
+		if err != nil {
+			return nil, err
+		}
+
+		nf := nom.IPv4Dst{}
+		nf.Addr = nom.IPv4Addr(xf.Addr())
+		nf.Mask = nom.MaskNoneIPV4
+		return nf, nil
+
+	case uint8(of12.PXMT_IPV4_DST_MASKED):
+		xf, err := of12.ToOxmIpV4DstMasked(f)
+		if err != nil {
+			return nil, err
+		}
+
+		nf := nom.IPv4Dst{}
+		nf.Addr = nom.IPv4Addr(xf.Addr())
+		nf.Mask = nom.IPv4Addr(xf.Mask())
+		return nf, nil
+
+	case uint8(of12.PXMT_IPV6_SRC):
+		xf, err := of12.ToOxmIpV6Src(f)
+		if err != nil {
+			return nil, err
+		}
+
+		nf := nom.IPv6Src{}
+		nf.Addr = nom.IPv6Addr(xf.Addr())
+		nf.Mask = nom.MaskNoneIPV6
+		return nf, nil
+
+	case uint8(of12.PXMT_IPV6_SRC_MASKED):
+		xf, err := of12.ToOxmIpV6SrcMasked(f)
+		if err != nil {
+			return nil, err
+		}
+
+		nf := nom.IPv6Src{}
+		nf.Addr = nom.IPv6Addr(xf.Addr())
+		nf.Mask = nom.IPv6Addr(xf.Mask())
+		return nf, nil
+
+	case uint8(of12.PXMT_IPV6_DST):
+		xf, err := of12.ToOxmIpV6Dst(f)
+		if err != nil {
+			return nil, err
+		}
+
+		nf := nom.IPv6Dst{}
+		nf.Addr = nom.IPv6Addr(xf.Addr())
+		nf.Mask = nom.MaskNoneIPV6
+		return nf, nil
+
+	case uint8(of12.PXMT_IPV6_DST_MASKED):
+		xf, err := of12.ToOxmIpV6DstMasked(f)
+		if err != nil {
+			return nil, err
+		}
+
+		nf := nom.IPv6Dst{}
+		nf.Addr = nom.IPv6Addr(xf.Addr())
+		nf.Mask = nom.IPv6Addr(xf.Mask())
+		return nf, nil
+
+	case uint8(of12.PXMT_TCP_SRC):
+		xf, err := of12.ToOxmTcpSrc(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.TransportPortSrc(xf.Port()), nil
+
+	case uint8(of12.PXMT_TCP_DST):
+		xf, err := of12.ToOxmTcpDst(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.TransportPortDst(xf.Port()), nil
+
+	case uint8(of12.PXMT_UDP_SRC):
+		xf, err := of12.ToOxmUdpSrc(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.TransportPortSrc(xf.Port()), nil
+
+	case uint8(of12.PXMT_UDP_DST):
+		xf, err := of12.ToOxmUdpDst(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.TransportPortDst(xf.Port()), nil
+
+	case uint8(of12.PXMT_VLAN_VID):
+		xf, err := of12.ToOxmVlanVid(f)
+		if err != nil {
+			return nil, err
+		}
+
+		if xf.Vid()&uint16(of12.PVID_PRESENT) == 0 {
+			return nom.VLANNone, nil
+		}
+		return nom.VLANID(xf.Vid() &^ uint16(of12.PVID_PRESENT)), nil
+
+	case uint8(of12.PXMT_VLAN_PCP):
+		xf, err := of12.ToOxmVlanPcp(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.VLANPCP(xf.Pcp()), nil
+
+	case uint8(of12.PXMT_IP_DSCP):
+		xf, err := of12.ToOxmIpDscp(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.IPDSCP(xf.Dscp()), nil
+
+	case uint8(of12.PXMT_IP_ECN):
+		xf, err := of12.ToOxmIpEcn(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.IPECN(xf.Ecn()), nil
+
+	case uint8(of12.PXMT_ICMPV4_TYPE):
+		xf, err := of12.ToOxmIcmpV4Type(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.ICMPv4Type(xf.Type()), nil
+
+	case uint8(of12.PXMT_ICMPV4_CODE):
+		xf, err := of12.ToOxmIcmpV4Code(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.ICMPv4Code(xf.Code()), nil
+
+	case uint8(of12.PXMT_ICMPV6_TYPE):
+		xf, err := of12.ToOxmIcmpV6Type(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.ICMPv6Type(xf.Type()), nil
+
+	case uint8(of12.PXMT_ICMPV6_CODE):
+		xf, err := of12.ToOxmIcmpV6Code(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.ICMPv6Code(xf.Code()), nil
+
+	case uint8(of12.PXMT_ARP_OP):
+		xf, err := of12.ToOxmArpOp(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.ARPOp(xf.Op()), nil
+
+	case uint8(of12.PXMT_ARP_SPA):
+		xf, err := of12.ToOxmArpSpa(f)
+		if err != nil {
+			return nil, err
 		}
+
+		return nom.ARPSpa{
+			Addr: nom.IPv4Addr(xf.Addr()),
+			Mask: nom.MaskNoneIPV4,
+		}, nil
+
+	case uint8(of12.PXMT_ARP_SPA_MASKED):
+		xf, err := of12.ToOxmArpSpaMasked(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.ARPSpa{
+			Addr: nom.IPv4Addr(xf.Addr()),
+			Mask: nom.IPv4Addr(xf.Mask()),
+		}, nil
+
+	case uint8(of12.PXMT_ARP_TPA):
+		xf, err := of12.ToOxmArpTpa(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.ARPTpa{
+			Addr: nom.IPv4Addr(xf.Addr()),
+			Mask: nom.MaskNoneIPV4,
+		}, nil
+
+	case uint8(of12.PXMT_ARP_TPA_MASKED):
+		xf, err := of12.ToOxmArpTpaMasked(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.ARPTpa{
+			Addr: nom.IPv4Addr(xf.Addr()),
+			Mask: nom.IPv4Addr(xf.Mask()),
+		}, nil
+
+	case uint8(of12.PXMT_MPLS_LABEL):
+		xf, err := of12.ToOxmMplsLabel(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.MPLSLabel(xf.Label()), nil
+
+	case uint8(of12.PXMT_IPV6_FLABEL):
+		xf, err := of12.ToOxmIpV6Flabel(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.IPv6FlowLabel{
+			Label: xf.Flabel(),
+			Mask:  nom.MaskNoneIPv6FlowLabel,
+		}, nil
+
+	case uint8(of12.PXMT_IPV6_FLABEL_MASKED):
+		xf, err := of12.ToOxmIpV6FlabelMasked(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.IPv6FlowLabel{
+			Label: xf.Flabel(),
+			Mask:  xf.Mask(),
+		}, nil
+
+	case uint8(of12.PXMT_METADATA):
+		xf, err := of12.ToOxmMetadata(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.Metadata{
+			Data: xf.Metadata(),
+			Mask: nom.MaskNoneMetadata,
+		}, nil
+
+	case uint8(of12.PXMT_METADATA_MASKED):
+		xf, err := of12.ToOxmMetadataMasked(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.Metadata{
+			Data: xf.Metadata(),
+			Mask: xf.Mask(),
+		}, nil
+	}
+
+	return nil, nil
+}
+
+func (d *of13Driver) ofMatch(m nom.Match) (of13.Match, error) {
+	ofm := of13.NewOXMatch()
+	proto, _ := m.IPProto()
+	for _, f := range m.Fields {
+		off, err := d.ofOxmField(f, proto)
+		if err != nil {
+			return of13.Match{}, err
+		}
+		ofm.AddFields(off)
 	}
 	return ofm.Match, nil
 }
 
-func (d *of12Driver) nomMatch(m of12.Match) (nom.Match, error) {
+// ofOxmField converts a NOM field into an OXM field. Transport ports are
+// converted into UDP ports if proto is UDP, and into TCP ports otherwise.
+func (d *of13Driver) ofOxmField(f nom.Field,
+	proto nom.IPProto) (of13.OxmField, error) {
+	switch f := f.(type) {
+	case nom.InPort:
+		p, ok := d.ports.portNo(nom.UID(f))
+		if !ok {
+			return of13.OxmField{},
+				fmt.Errorf("of13Driver: nom port not found %v", f)
+		}
+		off := of13.NewOxmInPort()
+		off.SetInPort(p)
+		return off.OxmField, nil
+
+	case nom.EthDst:
+		if f.Mask == nom.MaskNoneMAC {
+			off := of13.NewOxmEthDst()
+			off.SetMacAddr([6]byte(f.Addr))
+			return off.OxmField, nil
+		}
+		off := of13.NewOxmEthDstMasked()
+		off.SetMacAddr([6]byte(f.Addr))
+		off.SetMask([6]byte(f.Mask))
+		return off.OxmField, nil
+
+	case nom.EthSrc:
+		if f.Mask == nom.MaskNoneMAC {
+			off := of13.NewOxmEthSrc()
+			off.SetMacAddr([6]byte(f.Addr))
+			return off.OxmField, nil
+		}
+		off := of13.NewOxmEthSrcMasked()
+		off.SetMacAddr([6]byte(f.Addr))
+		off.SetMask([6]byte(f.Mask))
+		return off.OxmField, nil
+
+	case nom.EthType:
+		off := of13.NewOxmEthType()
+		off.SetType(uint16(f))
+		return off.OxmField, nil
+
+	case nom.IPProto:
+		off := of13.NewOxmIpProto()
+		off.SetProto(uint8(f))
+		return off.OxmField, nil
+
+	case nom.IPv4Src:
+		if f.Mask == nom.MaskNoneIPV4 {
+			off := of13.NewOxmIpV4Src()
+			off.SetAddr(f.Addr)
+			return off.OxmField, nil
+		}
+		off := of13.NewOxmIpV4SrcMasked()
+		off.SetAddr(f.Addr)
+		off.SetMask(f.Mask)
+		return off.OxmField, nil
+
+	case nom.IPv4Dst:
+		if f.Mask == nom.MaskNoneIPV4 {
+			off := of13.NewOxmIpV4Dst()
+			off.SetAddr(f.Addr)
+			return off.OxmField, nil
+		}
+		off := of13.NewOxmIpV4DstMasked()
+		off.SetAddr(f.Addr)
+		off.SetMask(f.Mask)
+		return off.OxmField, nil
+
+	case nom.IPv6Src:
+		if f.Mask == nom.MaskNoneIPV6 {
+			off := of13.NewOxmIpV6Src()
+			off.SetAddr(f.Addr)
+			return off.OxmField, nil
+		}
+		off := of13.NewOxmIpV6SrcMasked()
+		off.SetAddr(f.Addr)
+		off.SetMask(f.Mask)
+		return off.OxmField, nil
+
+	case nom.IPv6Dst:
+		if f.Mask == nom.MaskNoneIPV6 {
+			off := of13.NewOxmIpV6Dst()
+			off.SetAddr(f.Addr)
+			return off.OxmField, nil
+		}
+		off := of13.NewOxmIpV6DstMasked()
+		off.SetAddr(f.Addr)
+		off.SetMask(f.Mask)
+		return off.OxmField, nil
+
+	case nom.TransportPortSrc:
+		if proto == nom.IPProtoUDP {
+			off := of13.NewOxmUdpSrc()
+			off.SetPort(uint16(f))
+			return off.OxmField, nil
+		}
+		off := of13.NewOxmTcpSrc()
+		off.SetPort(uint16(f))
+		return off.OxmField, nil
+
+	case nom.TransportPortDst:
+		if proto == nom.IPProtoUDP {
+			off := of13.NewOxmUdpDst()
+			off.SetPort(uint16(f))
+			return off.OxmField, nil
+		}
+		off := of13.NewOxmTcpDst()
+		off.SetPort(uint16(f))
+		return off.OxmField, nil
+
+	case nom.VLANID:
+		off := of13.NewOxmVlanVid()
+		if f == nom.VLANNone {
+			off.SetVid(uint16(of13.PVID_NONE))
+		} else {
+			off.SetVid(uint16(f) | uint16(of13.PVID_PRESENT))
+		}
+		return off.OxmField, nil
+
+	case nom.VLANPCP:
+		off := of13.NewOxmVlanPcp()
+		off.SetPcp(uint8(f))
+		return off.OxmField, nil
+
+	case nom.IPDSCP:
+		off := of13.NewOxmIpDscp()
+		off.SetDscp(uint8(f))
+		return off.OxmField, nil
+
+	case nom.IPECN:
+		off := of13.NewOxmIpEcn()
+		off.SetEcn(uint8(f))
+		return off.OxmField, nil
+
+	case nom.ICMPv4Type:
+		off := of13.NewOxmIcmpV4Type()
+		off.SetType(uint8(f))
+		return off.OxmField, nil
+
+	case nom.ICMPv4Code:
+		off := of13.NewOxmIcmpV4Code()
+		off.SetCode(uint8(f))
+		return off.OxmField, nil
+
+	case nom.ICMPv6Type:
+		off := of13.NewOxmIcmpV6Type()
+		off.SetType(uint8(f))
+		return off.OxmField, nil
+
+	case nom.ICMPv6Code:
+		off := of13.NewOxmIcmpV6Code()
+		off.SetCode(uint8(f))
+		return off.OxmField, nil
+
+	case nom.ARPOp:
+		off := of13.NewOxmArpOp()
+		off.SetOp(uint16(f))
+		return off.OxmField, nil
+
+	case nom.ARPSpa:
+		if f.Mask == nom.MaskNoneIPV4 {
+			off := of13.NewOxmArpSpa()
+			off.SetAddr(f.Addr)
+			return off.OxmField, nil
+		}
+		off := of13.NewOxmArpSpaMasked()
+		off.SetAddr(f.Addr)
+		off.SetMask(f.Mask)
+		return off.OxmField, nil
+
+	case nom.ARPTpa:
+		if f.Mask == nom.MaskNoneIPV4 {
+			off := of13.NewOxmArpTpa()
+			off.SetAddr(f.Addr)
+			return off.OxmField, nil
+		}
+		off := of13.NewOxmArpTpaMasked()
+		off.SetAddr(f.Addr)
+		off.SetMask(f.Mask)
+		return off.OxmField, nil
+
+	case nom.MPLSLabel:
+		off := of13.NewOxmMplsLabel()
+		off.SetLabel(uint32(f))
+		return off.OxmField, nil
+
+	case nom.IPv6FlowLabel:
+		if f.Mask == nom.MaskNoneIPv6FlowLabel {
+			off := of13.NewOxmIpV6Flabel()
+			off.SetFlabel(f.Label)
+			return off.OxmField, nil
+		}
+		off := of13.NewOxmIpV6FlabelMasked()
+		off.SetFlabel(f.Label)
+		off.SetMask(f.Mask)
+		return off.OxmField, nil
+
+	case nom.Metadata:
+		if f.Mask == nom.MaskNoneMetadata {
+			off := of13.NewOxmMetadata()
+			off.SetMetadata(f.Data)
+			return off.OxmField, nil
+		}
+		off := of13.NewOxmMetadataMasked()
+		off.SetMetadata(f.Data)
+		off.SetMask(f.Mask)
+		return off.OxmField, nil
+
+	default:
+		return of13.OxmField{}, fmt.Errorf("of13Driver: %#v is not supported", f)
+	}
+}
+
+func (d *of13Driver) nomMatch(m of13.Match) (nom.Match, error) {
 	nm := nom.Match{}
 
-	if !of12.IsOXMatch(m) {
-		return nm, fmt.Errorf("of12Driver: std math is not supported")
+	if !of13.IsOXMatch(m) {
+		return nm, fmt.Errorf("of13Driver: std math is not supported")
 	}
 
-	xm, err := of12.ToOXMatch(m)
+	xm, err := of13.ToOXMatch(m)
 	if err != nil {
 		return nm, err
 	}
 
 	for _, f := range xm.Fields() {
-		switch f.OxmField() {
-		case uint8(of12.PXMT_IN_PORT):
-			xf, err := of12.ToOxmInPort(f)
-			if err != nil {
-				return nom.Match{}, err
-			}
+		// Skip the padding and non-standard fields.
+		if f.OxmClass() != uint16(of13.PXMC_OPENFLOW_BASIC) {
+			continue
+		}
 
-			np, ok := d.ofPorts[xf.InPort()]
-			if !ok {
-				return nom.Match{}, fmt.Errorf("of12Driver: cannot find port %v",
-					xf.InPort())
-			}
-			nm.AddField(nom.InPort(np.UID()))
+		nf, err := d.nomField(f)
+		if err != nil {
+			return nom.Match{}, err
+		}
+		if nf != nil {
+			nm.AddField(nf)
+		}
+	}
 
-		case uint8(of12.PXMT_ETH_TYPE):
-			xf, err := of12.ToOxmEthType(f)
-			if err != nil {
-				return nom.Match{}, err
-			}
-			nm.AddField(nom.EthType(xf.Type()))
+	return nm, nil
+}
 
-		case uint8(of12.PXMT_ETH_SRC):
-			xf, err := of12.ToOxmEthSrc(f)
-			if err != nil {
-				return nom.Match{}, err
-			}
+// nomField converts an OXM field into a NOM field. It returns nil if the OXM
+// field is not supported.
+func (d *of13Driver) nomField(f of13.OxmField) (nom.Field, error) {
+	switch f.OxmField() {
+	case uint8(of13.PXMT_IN_PORT):
+		xf, err := of13.ToOxmInPort(f)
+		if err != nil {
+			return nil, err
+		}
 
-			nf := nom.EthSrc{}
-			nf.Addr = xf.MacAddr()
-			nf.Mask = nom.MaskNoneMAC
-			nm.AddField(nf)
+		np, ok := d.ports.ofPort(xf.InPort())
+		if !ok {
+			return nil, fmt.Errorf("of13Driver: cannot find port %v", xf.InPort())
+		}
+		return nom.InPort(np.UID()), nil
 
-		case uint8(of12.PXMT_ETH_SRC_MASKED):
-			xf, err := of12.ToOxmEthSrcMasked(f)
-			if err != nil {
-				return nom.Match{}, err
-			}
+	case uint8(of13.PXMT_ETH_TYPE):
+		xf, err := of13.ToOxmEthType(f)
+		if err != nil {
+			return nil, err
+		}
+		return nom.EthType(xf.Type()), nil
 
-			nf := nom.EthSrc{}
-			nf.Addr = xf.MacAddr()
-			nf.Mask = xf.Mask()
-			nm.AddField(nf)
+	case uint8(of13.PXMT_ETH_SRC):
+		xf, err := of13.ToOxmEthSrc(f)
+		if err != nil {
+			return nil, err
+		}
 
-		case uint8(of12.PXMT_ETH_DST):
-			xf, err := of12.ToOxmEthDst(f)
-			if err != nil {
-				return nom.Match{}, err
-			}
+		nf := nom.EthSrc{}
+		nf.Addr = xf.MacAddr()
+		nf.Mask = nom.MaskNoneMAC
+		return nf, nil
 
-			nf := nom.EthDst{}
-			nf.Addr = xf.MacAddr()
-			nf.Mask = nom.MaskNoneMAC
-			nm.AddField(nf)
+	case uint8(of13.PXMT_ETH_SRC_MASKED):
+		xf, err := of13.ToOxmEthSrcMasked(f)
+		if err != nil {
+			return nil, err
+		}
 
-		case uint8(of12.PXMT_ETH_DST_MASKED):
-			xf, err := of12.ToOxmEthDstMasked(f)
-			if err != nil {
-				return nom.Match{}, err
-			}
+		nf := nom.EthSrc{}
+		nf.Addr = xf.MacAddr()
+		nf.Mask = xf.Mask()
+		return nf, nil
 
-			nf := nom.EthDst{}
-			nf.Addr = xf.MacAddr()
-			nf.Mask = xf.Mask()
-			nm.AddField(nf)
+	case uint8(of13.PXMT_ETH_DST):
+		xf, err := of13.ToOxmEthDst(f)
+		if err != nil {
+			return nil, err
+		}
 
-		case uint8(of12.PXMT_IP_PROTO):
-			xf, err := of12.ToOxmIpProto(f)
-			if err != nil {
-				return nom.Match{}, err
-			}
+		nf := nom.EthDst{}
+		nf.Addr = xf.MacAddr()
+		nf.Mask = nom.MaskNoneMAC
+		return nf, nil
 
-			nm.AddField(nom.IPProto(xf.Proto()))
+	case uint8(of13.PXMT_ETH_DST_MASKED):
+		xf, err := of13.ToOxmEthDstMasked(f)
+		if err != nil {
+			return nil, err
+		}
 
-		case uint8(of12.PXMT_IPV4_SRC):
-			xf, err := of12.ToOxmIpV4Src(f)
-			if err != nil {
-				return nom.Match{}, err
-			}
+		nf := nom.EthDst{}
+		nf.Addr = xf.MacAddr()
+		nf.Mask = xf.Mask()
+		return nf, nil
 
-			nf := nom.IPv4Src{}
-			nf.Addr = nom.IPv4Addr(xf.Addr())
-			nf.Mask = nom.MaskNoneIPV4
-			nm.AddField(nf)
+	case uint8(of13.PXMT_IP_PROTO):
+		xf, err := of13.ToOxmIpProto(f)
+		if err != nil {
+			return nil, err
+		}
 
-		case uint8(of12.PXMT_IPV4_SRC_MASKED):
-			xf, err := of12.ToOxmIpV4SrcMasked(f)
-			if err != nil {
-				return nom.Match{}, err
-			}
+		return nom.IPProto(xf.Proto()), nil
 
-			nf := nom.IPv4Src{}
-			nf.Addr = nom.IPv4Addr(xf.Addr())
-			nf.Mask = nom.IPv4Addr(xf.Mask())
-			nm.AddField(nf)
+	case uint8(of13.PXMT_IPV4_SRC):
+		xf, err := of13.ToOxmIpV4Src(f)
+		if err != nil {
+			return nil, err
+		}
 
-		case uint8(of12.PXMT_IPV4_DST):
-			xf, err := of12.ToOxmIpV4Dst(f)
-			if err != nil {
-				return nom.Match{}, err
-			}
+		nf := nom.IPv4Src{}
+		nf.Addr = nom.IPv4Addr(xf.Addr())
+		nf.Mask = nom.MaskNoneIPV4
+		return nf, nil
 
-			nf := nom.IPv4Dst{}
-			nf.Addr = nom.IPv4Addr(xf.Addr())
-			nf.Mask = nom.MaskNoneIPV4
-			nm.AddField(nf)
+	case uint8(of13.PXMT_IPV4_SRC_MASKED):
+		xf, err := of13.ToOxmIpV4SrcMasked(f)
+		if err != nil {
+			return nil, err
+		}
 
-		case uint8(of12.PXMT_IPV4_DST_MASKED):
-			xf, err := of12.ToOxmIpV4DstMasked(f)
-			if err != nil {
-				return nom.Match{}, err
-			}
+		nf := nom.IPv4Src{}
+		nf.Addr = nom.IPv4Addr(xf.Addr())
+		nf.Mask = nom.IPv4Addr(xf.Mask())
+		return nf, nil
 
-			nf := nom.IPv4Dst{}
-			nf.Addr = nom.IPv4Addr(xf.Addr())
-			nf.Mask = nom.IPv4Addr(xf.Mask())
-			nm.AddField(nf)
+	case uint8(of13.PXMT_IPV4_DST):
+		xf, err := of13.ToOxmIpV4Dst(f)
+		if err != nil {
+			return nil, err
+		}
 
-		case uint8(of12.PXMT_IPV6_SRC):
-			xf, err := of12.ToOxmIpV6Src(f)
-			if err != nil {
-				return nom.Match{}, err
-			}
+		nf := nom.IPv4Dst{}
+		nf.Addr = nom.IPv4Addr(xf.Addr())
+		nf.Mask = nom.MaskNoneIPV4
+		return nf, nil
 
-			nf := nom.IPv6Src{}
-			nf.Addr = nom.IPv6Addr(xf.Addr())
-			nf.Mask = nom.MaskNoneIPV6
-			nm.AddField(nf)
+	case uint8(of13.PXMT_IPV4_DST_MASKED):
+		xf, err := of13.ToOxmIpV4DstMasked(f)
+		if err != nil {
+			return nil, err
+		}
 
-		case uint8(of12.PXMT_IPV6_SRC_MASKED):
-			xf, err := of12.ToOxmIpV6SrcMasked(f)
-			if err != nil {
-				return nom.Match{}, err
-			}
+		nf := nom.IPv4Dst{}
+		nf.Addr = nom.IPv4Addr(xf.Addr())
+		nf.Mask = nom.IPv4Addr(xf.Mask())
+		return nf, nil
 
-			nf := nom.IPv6Src{}
-			nf.Addr = nom.IPv6Addr(xf.Addr())
-			nf.Mask = nom.IPv6Addr(xf.Mask())
-			nm.AddField(nf)
+	case uint8(of13.PXMT_IPV6_SRC):
+		xf, err := of13.ToOxmIpV6Src(f)
+		if err != nil {
+			return nil, err
+		}
 
-		case uint8(of12.PXMT_IPV6_DST):
-			xf, err := of12.ToOxmIpV6Dst(f)
-			if err != nil {
-				return nom.Match{}, err
-			}
+		nf := nom.IPv6Src{}
+		nf.Addr = nom.IPv6Addr(xf.Addr())
+		nf.Mask = nom.MaskNoneIPV6
+		return nf, nil
 
-			nf := nom.IPv6Dst{}
-			nf.Addr = nom.IPv6Addr(xf.Addr())
-			nf.Mask = nom.MaskNoneIPV6
-			nm.AddField(nf)
+	case uint8(of13.PXMT_IPV6_SRC_MASKED):
+		xf, err := of13.ToOxmIpV6SrcMasked(f)
+		if err != nil {
+			return nil, err
+		}
 
-		case uint8(of12.PXMT_IPV6_DST_MASKED):
-			xf, err := of12.ToOxmIpV6DstMasked(f)
-			if err != nil {
-				return nom.Match{}, err
-			}
+		nf := nom.IPv6Src{}
+		nf.Addr = nom.IPv6Addr(xf.Addr())
+		nf.Mask = nom.IPv6Addr(xf.Mask())
+		return nf, nil
 
-			nf := nom.IPv6Dst{}
-			nf.Addr = nom.IPv6Addr(xf.Addr())
-			nf.Mask = nom.IPv6Addr(xf.Mask())
-			nm.AddField(nf)
+	case uint8(of13.PXMT_IPV6_DST):
+		xf, err := of13.ToOxmIpV6Dst(f)
+		if err != nil {
+			return nil, err
+		}
 
-		case uint8(of12.PXMT_TCP_SRC):
-			xf, err := of12.ToOxmTcpSrc(f)
-			if err != nil {
-				return nom.Match{}, err
-			}
+		nf := nom.IPv6Dst{}
+		nf.Addr = nom.IPv6Addr(xf.Addr())
+		nf.Mask = nom.MaskNoneIPV6
+		return nf, nil
 
-			nm.AddField(nom.TransportPortSrc(xf.Port()))
+	case uint8(of13.PXMT_IPV6_DST_MASKED):
+		xf, err := of13.ToOxmIpV6DstMasked(f)
+		if err != nil {
+			return nil, err
+		}
 
-		case uint8(of12.PXMT_TCP_DST):
-			xf, err := of12.ToOxmTcpDst(f)
-			if err != nil {
-				return nom.Match{}, err
-			}
+		nf := nom.IPv6Dst{}
+		nf.Addr = nom.IPv6Addr(xf.Addr())
+		nf.Mask = nom.IPv6Addr(xf.Mask())
+		return nf, nil
+
+	case uint8(of13.PXMT_TCP_SRC):
+		xf, err := of13.ToOxmTcpSrc(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.TransportPortSrc(xf.Port()), nil
+
+	case uint8(of13.PXMT_TCP_DST):
+		xf, err := of13.ToOxmTcpDst(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.TransportPortDst(xf.Port()), nil
+
+	case uint8(of13.PXMT_UDP_SRC):
+		xf, err := of13.ToOxmUdpSrc(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.TransportPortSrc(xf.Port()), nil
+
+	case uint8(of13.PXMT_UDP_DST):
+		xf, err := of13.ToOxmUdpDst(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.TransportPortDst(xf.Port()), nil
+
+	case uint8(of13.PXMT_VLAN_VID):
+		xf, err := of13.ToOxmVlanVid(f)
+		if err != nil {
+			return nil, err
+		}
+
+		if xf.Vid()&uint16(of13.PVID_PRESENT) == 0 {
+			return nom.VLANNone, nil
+		}
+		return nom.VLANID(xf.Vid() &^ uint16(of13.PVID_PRESENT)), nil
+
+	case uint8(of13.PXMT_VLAN_PCP):
+		xf, err := of13.ToOxmVlanPcp(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.VLANPCP(xf.Pcp()), nil
+
+	case uint8(of13.PXMT_IP_DSCP):
+		xf, err := of13.ToOxmIpDscp(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.IPDSCP(xf.Dscp()), nil
 
-			nm.AddField(nom.TransportPortDst(xf.Port()))
+	case uint8(of13.PXMT_IP_ECN):
+		xf, err := of13.ToOxmIpEcn(f)
+		if err != nil {
+			return nil, err
 		}
+
+		return nom.IPECN(xf.Ecn()), nil
+
+	case uint8(of13.PXMT_ICMPV4_TYPE):
+		xf, err := of13.ToOxmIcmpV4Type(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.ICMPv4Type(xf.Type()), nil
+
+	case uint8(of13.PXMT_ICMPV4_CODE):
+		xf, err := of13.ToOxmIcmpV4Code(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.ICMPv4Code(xf.Code()), nil
+
+	case uint8(of13.PXMT_ICMPV6_TYPE):
+		xf, err := of13.ToOxmIcmpV6Type(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.ICMPv6Type(xf.Type()), nil
+
+	case uint8(of13.PXMT_ICMPV6_CODE):
+		xf, err := of13.ToOxmIcmpV6Code(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.ICMPv6Code(xf.Code()), nil
+
+	case uint8(of13.PXMT_ARP_OP):
+		xf, err := of13.ToOxmArpOp(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.ARPOp(xf.Op()), nil
+
+	case uint8(of13.PXMT_ARP_SPA):
+		xf, err := of13.ToOxmArpSpa(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.ARPSpa{
+			Addr: nom.IPv4Addr(xf.Addr()),
+			Mask: nom.MaskNoneIPV4,
+		}, nil
+
+	case uint8(of13.PXMT_ARP_SPA_MASKED):
+		xf, err := of13.ToOxmArpSpaMasked(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.ARPSpa{
+			Addr: nom.IPv4Addr(xf.Addr()),
+			Mask: nom.IPv4Addr(xf.Mask()),
+		}, nil
+
+	case uint8(of13.PXMT_ARP_TPA):
+		xf, err := of13.ToOxmArpTpa(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.ARPTpa{
+			Addr: nom.IPv4Addr(xf.Addr()),
+			Mask: nom.MaskNoneIPV4,
+		}, nil
+
+	case uint8(of13.PXMT_ARP_TPA_MASKED):
+		xf, err := of13.ToOxmArpTpaMasked(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.ARPTpa{
+			Addr: nom.IPv4Addr(xf.Addr()),
+			Mask: nom.IPv4Addr(xf.Mask()),
+		}, nil
+
+	case uint8(of13.PXMT_MPLS_LABEL):
+		xf, err := of13.ToOxmMplsLabel(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.MPLSLabel(xf.Label()), nil
+
+	case uint8(of13.PXMT_IPV6_FLABEL):
+		xf, err := of13.ToOxmIpV6Flabel(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.IPv6FlowLabel{
+			Label: xf.Flabel(),
+			Mask:  nom.MaskNoneIPv6FlowLabel,
+		}, nil
+
+	case uint8(of13.PXMT_IPV6_FLABEL_MASKED):
+		xf, err := of13.ToOxmIpV6FlabelMasked(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.IPv6FlowLabel{
+			Label: xf.Flabel(),
+			Mask:  xf.Mask(),
+		}, nil
+
+	case uint8(of13.PXMT_METADATA):
+		xf, err := of13.ToOxmMetadata(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.Metadata{
+			Data: xf.Metadata(),
+			Mask: nom.MaskNoneMetadata,
+		}, nil
+
+	case uint8(of13.PXMT_METADATA_MASKED):
+		xf, err := of13.ToOxmMetadataMasked(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return nom.Metadata{
+			Data: xf.Metadata(),
+			Mask: xf.Mask(),
+		}, nil
 	}
 
-	return nm, nil
+	return nil, nil
 }
@@ -1,9 +1,16 @@
 package openflow
 
 import (
+	"net"
 	"testing"
+	"time"
 
+	bh "github.com/kandoo/beehive"
 	"github.com/kandoo/beehive-netctrl/nom"
+	"github.com/kandoo/beehive-netctrl/openflow/of"
+	"github.com/kandoo/beehive-netctrl/openflow/of10"
+	"github.com/kandoo/beehive-netctrl/openflow/of12"
+	"github.com/kandoo/beehive-netctrl/openflow/of13"
 )
 
 func TestOF10Match(t *testing.T) {
@@ -41,6 +48,33 @@ func TestOF10Match(t *testing.T) {
 				},
 			},
 		},
+		{
+			Fields: []nom.Field{
+				nom.VLANID(10),
+				nom.VLANPCP(3),
+				nom.EthType(0x0800),
+				nom.IPProto(6),
+				nom.IPDSCP(46),
+			},
+		},
+		{
+			Fields: []nom.Field{
+				nom.EthType(nom.EthTypeARP),
+				nom.ARPOp(1),
+				nom.ARPSpa{
+					Addr: nom.IPv4Addr{10, 0, 0, 1},
+					Mask: nom.IPv4Addr{255, 255, 255, 0},
+				},
+			},
+		},
+		{
+			Fields: []nom.Field{
+				nom.EthType(nom.EthTypeIPv4),
+				nom.IPProto(nom.IPProtoICMP),
+				nom.ICMPv4Type(8),
+				nom.ICMPv4Code(0),
+			},
+		},
 	}
 	for _, m := range matches {
 		ofm, err := driver.ofMatch(m)
@@ -56,3 +90,763 @@ func TestOF10Match(t *testing.T) {
 		}
 	}
 }
+
+func TestOF10IPv4Wildcards(t *testing.T) {
+	driver := of10Driver{}
+	tests := []struct {
+		mask nom.IPv4Addr
+		wc   uint32
+	}{
+		{nom.IPv4Addr{255, 255, 255, 0}, 8},
+		{nom.MaskNoneIPV4, 0},
+	}
+	for _, test := range tests {
+		m := nom.Match{
+			Fields: []nom.Field{
+				nom.EthType(nom.EthTypeIPv4),
+				nom.IPv4Src{Addr: nom.IPv4Addr{10, 0, 1, 0}, Mask: test.mask},
+				nom.IPv4Dst{Addr: nom.IPv4Addr{10, 0, 2, 0}, Mask: test.mask},
+			},
+		}
+		ofm, err := driver.ofMatch(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wc := ofm.Wildcards()
+		src := (wc & uint32(of10.PFW_NW_SRC_MASK)) >> uint(of10.PFW_NW_SRC_SHIFT)
+		dst := (wc & uint32(of10.PFW_NW_DST_MASK)) >> uint(of10.PFW_NW_DST_SHIFT)
+		if src != test.wc || dst != test.wc {
+			t.Errorf("invalid wildcards for %v: actual=%v/%v want=%v", test.mask,
+				src, dst, test.wc)
+		}
+		nm, err := driver.nomMatch(ofm)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !nm.Equals(m) {
+			t.Errorf("invalid match conversion:\n\tactual=%v\n\twant=%v", nm, m)
+		}
+	}
+}
+
+func TestOF12Match(t *testing.T) {
+	port := nom.Port{ID: "1", Node: "n1"}
+	driver := of12Driver{}
+	addTestPorts(&driver.ports, map[uint32]nom.Port{1: port})
+	matches := []nom.Match{
+		{
+			Fields: []nom.Field{
+				nom.InPort(port.UID()),
+				nom.VLANID(10),
+				nom.VLANPCP(3),
+				nom.EthType(0x0800),
+				nom.IPProto(6),
+				nom.IPDSCP(46),
+				nom.IPECN(1),
+			},
+		},
+		{
+			Fields: []nom.Field{
+				nom.EthType(nom.EthTypeARP),
+				nom.ARPOp(1),
+				nom.ARPSpa{
+					Addr: nom.IPv4Addr{10, 0, 0, 1},
+					Mask: nom.MaskNoneIPV4,
+				},
+				nom.ARPTpa{
+					Addr: nom.IPv4Addr{10, 0, 0, 0},
+					Mask: nom.IPv4Addr{255, 255, 255, 0},
+				},
+			},
+		},
+		{
+			Fields: []nom.Field{
+				nom.IPProto(nom.IPProtoICMP),
+				nom.ICMPv4Type(8),
+				nom.ICMPv4Code(0),
+			},
+		},
+		{
+			Fields: []nom.Field{
+				nom.IPProto(nom.IPProtoICMPv6),
+				nom.ICMPv6Type(135),
+				nom.ICMPv6Code(0),
+				nom.IPv6FlowLabel{Label: 0x12345, Mask: nom.MaskNoneIPv6FlowLabel},
+			},
+		},
+		{
+			Fields: []nom.Field{
+				nom.EthType(0x8847),
+				nom.MPLSLabel(100),
+				nom.Metadata{Data: 0x1200, Mask: 0xFF00},
+			},
+		},
+	}
+	for _, m := range matches {
+		ofm, err := driver.ofMatch(m)
+		if err != nil {
+			t.Error(err)
+		}
+		nm, err := driver.nomMatch(ofm)
+		if err != nil {
+			t.Error(err)
+		}
+		if !nm.Equals(m) {
+			t.Errorf("invalid match conversion:\n\tactual=%#v\n\twant=%#v", nm, m)
+		}
+	}
+}
+
+func TestOF13Match(t *testing.T) {
+	port := nom.Port{ID: "1", Node: "n1"}
+	driver := of13Driver{}
+	addTestPorts(&driver.ports, map[uint32]nom.Port{1: port})
+	matches := []nom.Match{
+		{
+			Fields: []nom.Field{
+				nom.InPort(port.UID()),
+				nom.EthType(0x0800),
+			},
+		},
+		{
+			Fields: []nom.Field{
+				nom.EthSrc{
+					Addr: nom.MACAddr{1, 2, 3, 4, 5, 6},
+					Mask: nom.MACAddr{0xFF, 0xFF, 0xFF, 0, 0, 0},
+				},
+				nom.EthDst{
+					Addr: nom.MACAddr{1, 2, 3, 4, 5, 6},
+					Mask: nom.MaskNoneMAC,
+				},
+			},
+		},
+		{
+			Fields: []nom.Field{
+				nom.IPv4Src{
+					Addr: nom.IPv4Addr{1, 2, 3, 4},
+					Mask: nom.MaskNoneIPV4,
+				},
+				nom.IPv4Dst{
+					Addr: nom.IPv4Addr{127, 0, 0, 1},
+					Mask: nom.IPv4Addr{255, 255, 255, 128},
+				},
+			},
+		},
+		{
+			Fields: []nom.Field{
+				nom.IPv6Src{
+					Addr: nom.IPv6Addr{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15,
+						16},
+					Mask: nom.MaskNoneIPV6,
+				},
+				nom.IPProto(6),
+				nom.TransportPortDst(80),
+			},
+		},
+		{
+			Fields: []nom.Field{
+				nom.VLANID(10),
+				nom.VLANPCP(3),
+				nom.EthType(0x0800),
+				nom.IPDSCP(46),
+				nom.IPECN(1),
+			},
+		},
+		{
+			Fields: []nom.Field{
+				nom.EthType(nom.EthTypeARP),
+				nom.ARPOp(2),
+				nom.ARPSpa{
+					Addr: nom.IPv4Addr{10, 0, 0, 0},
+					Mask: nom.IPv4Addr{255, 255, 255, 0},
+				},
+				nom.ARPTpa{
+					Addr: nom.IPv4Addr{10, 0, 0, 1},
+					Mask: nom.MaskNoneIPV4,
+				},
+			},
+		},
+		{
+			Fields: []nom.Field{
+				nom.IPProto(nom.IPProtoICMPv6),
+				nom.ICMPv6Type(135),
+				nom.ICMPv6Code(0),
+				nom.IPv6FlowLabel{Label: 0x12345, Mask: 0xFFFF0},
+			},
+		},
+		{
+			Fields: []nom.Field{
+				nom.EthType(0x8847),
+				nom.MPLSLabel(100),
+				nom.Metadata{Data: 0x42, Mask: nom.MaskNoneMetadata},
+			},
+		},
+	}
+	for _, m := range matches {
+		ofm, err := driver.ofMatch(m)
+		if err != nil {
+			t.Error(err)
+		}
+		nm, err := driver.nomMatch(ofm)
+		if err != nil {
+			t.Error(err)
+		}
+		if !nm.Equals(m) {
+			t.Errorf("invalid match conversion:\n\tactual=%#v\n\twant=%#v", nm, m)
+		}
+	}
+}
+
+func TestOF13TableMiss(t *testing.T) {
+	sw, ctrl := net.Pipe()
+	defer sw.Close()
+	c := &ofConn{
+		HeaderConn: of.NewHeaderConn(ctrl),
+		ctx:        &bh.MockRcvContext{},
+	}
+	driver := of13Driver{}
+	go driver.addTableMiss(c)
+
+	swConn := of.NewHeaderConn(sw)
+	h, err := swConn.ReadHeader()
+	if err != nil {
+		t.Fatal(err)
+	}
+	h13, err := of13.ToHeader13(h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mod, err := of13.ToFlowMod(h13)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mod.Command() != uint8(of13.PFC_ADD) || mod.Priority() != 0 {
+		t.Errorf("invalid table-miss flow-mod: command=%v priority=%v",
+			mod.Command(), mod.Priority())
+	}
+	m, err := driver.nomMatch(mod.Match())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(m.Fields) != 0 {
+		t.Errorf("table-miss flow has a non-empty match: %v", m)
+	}
+	insts := mod.Instructions()
+	if len(insts) != 1 {
+		t.Fatalf("invalid number of instructions: actual=%v want=1", len(insts))
+	}
+	apply, err := of13.ToApplyActions(insts[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	actions := apply.Actions()
+	if len(actions) != 1 {
+		t.Fatalf("invalid number of actions: actual=%v want=1", len(actions))
+	}
+	out, err := of13.ToActionOutput(actions[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.Port() != uint32(of13.PP_CONTROLLER) {
+		t.Errorf("table-miss flow does not output to the controller: port=%v",
+			out.Port())
+	}
+}
+
+func TestOF10DelFlowEntry(t *testing.T) {
+	driver := of10Driver{}
+	for _, exact := range []bool{false, true} {
+		msg := &bh.MockMsg{
+			MsgData: nom.DelFlowEntry{
+				Match:    nom.Match{Fields: []nom.Field{nom.EthType(0x0800)}},
+				Priority: 10,
+				Exact:    exact,
+			},
+		}
+		h, err := driver.convToOF(msg, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		mod := of10.NewFlowModWithBuf(h.Buf)
+		want := of10.PFC_DELETE
+		if exact {
+			want = of10.PFC_DELETE_STRICT
+		}
+		if mod.Command() != uint16(want) {
+			t.Errorf("invalid command for exact=%v: actual=%v want=%v", exact,
+				mod.Command(), want)
+		}
+		if mod.OutPort() != uint16(of10.PP_NONE) {
+			t.Errorf("invalid out port: actual=%v want=%v", mod.OutPort(),
+				of10.PP_NONE)
+		}
+	}
+}
+
+func TestOF12DelFlowEntry(t *testing.T) {
+	driver := of12Driver{}
+	for _, exact := range []bool{false, true} {
+		msg := &bh.MockMsg{
+			MsgData: nom.DelFlowEntry{
+				Match:    nom.Match{Fields: []nom.Field{nom.EthType(0x0800)}},
+				Priority: 10,
+				Exact:    exact,
+			},
+		}
+		h, err := driver.convToOF(msg, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		mod := of12.NewFlowModWithBuf(h.Buf)
+		want := of12.PFC_DELETE
+		if exact {
+			want = of12.PFC_DELETE_STRICT
+		}
+		if mod.Command() != uint8(want) {
+			t.Errorf("invalid command for exact=%v: actual=%v want=%v", exact,
+				mod.Command(), want)
+		}
+		if mod.CookieMask() != 0 {
+			t.Errorf("deletion without cookie has a cookie mask: %v",
+				mod.CookieMask())
+		}
+	}
+
+	msg := &bh.MockMsg{
+		MsgData: nom.DelFlowEntry{
+			Match:    nom.Match{Fields: []nom.Field{nom.EthType(0x0800)}},
+			Priority: 10,
+			Exact:    true,
+			Cookie:   0x1234,
+		},
+	}
+	h, err := driver.convToOF(msg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mod := of12.NewFlowModWithBuf(h.Buf)
+	if mod.Cookie() != 0x1234 || mod.CookieMask() != ^uint64(0) {
+		t.Errorf("invalid cookie: actual=%v/%v want=%v/%v", mod.Cookie(),
+			mod.CookieMask(), 0x1234, ^uint64(0))
+	}
+}
+
+func TestOF10AddFlowEntry(t *testing.T) {
+	driver := of10Driver{}
+	msg := &bh.MockMsg{
+		MsgData: nom.AddFlowEntry{
+			Flow: nom.FlowEntry{
+				Cookie:      0x42,
+				Match:       nom.Match{Fields: []nom.Field{nom.EthType(0x0800)}},
+				Priority:    10,
+				IdleTimeout: 5 * time.Second,
+			},
+		},
+	}
+	h, err := driver.convToOF(msg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mod := of10.NewFlowModWithBuf(h.Buf)
+	if mod.Flags()&uint16(of10.PFF_SEND_FLOW_REM) == 0 {
+		t.Errorf("flow-mod does not request flow removed messages: flags=%v",
+			mod.Flags())
+	}
+	if mod.IdleTimeout() != 5 {
+		t.Errorf("invalid idle timeout: actual=%v want=5", mod.IdleTimeout())
+	}
+	if mod.Cookie() != 0x42 {
+		t.Errorf("invalid cookie: actual=%#x want=0x42", mod.Cookie())
+	}
+}
+
+func TestNOMFlowRemovedReason(t *testing.T) {
+	reasons := []struct {
+		of  uint8
+		nom nom.FlowRemovedReason
+	}{
+		{uint8(of12.PRR_IDLE_TIMEOUT), nom.FlowRemovedIdleTimeout},
+		{uint8(of12.PRR_HARD_TIMEOUT), nom.FlowRemovedHardTimeout},
+		{uint8(of12.PRR_DELETE), nom.FlowRemovedDeleted},
+		{uint8(of12.PRR_GROUP_DELETE), nom.FlowRemovedDeleted},
+	}
+	for _, r := range reasons {
+		if actual := nomFlowRemovedReason(r.of); actual != r.nom {
+			t.Errorf("invalid reason for %v: actual=%v want=%v", r.of, actual,
+				r.nom)
+		}
+	}
+}
+
+func TestOF12PortStatsQuery(t *testing.T) {
+	driver := of12Driver{}
+	addTestPorts(&driver.ports, map[uint32]nom.Port{
+		2: {ID: "2", Node: "n1"},
+	})
+	queries := []struct {
+		port nom.UID
+		want uint32
+	}{
+		{"", uint32(of12.PP_ANY)},
+		{"n1$$2", 2},
+	}
+	for _, q := range queries {
+		msg := &bh.MockMsg{
+			MsgData: nom.PortStatsQuery{Node: "n1", Port: q.port},
+		}
+		h, err := driver.convToOF(msg, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req := of12.NewPortStatsRequestWithBuf(h.Buf)
+		if req.PortNo() != q.want {
+			t.Errorf("invalid port for %v: actual=%v want=%v", q.port, req.PortNo(),
+				q.want)
+		}
+	}
+
+	msg := &bh.MockMsg{MsgData: nom.PortStatsQuery{Node: "n1", Port: "n1$$3"}}
+	if _, err := driver.convToOF(msg, nil); err == nil {
+		t.Error("no error for a non-existing port")
+	}
+}
+
+func testActions() []nom.Action {
+	return []nom.Action{
+		nom.ActionForward{Ports: []nom.UID{"n1$$1", "n1$$2"}},
+		nom.ActionFlood{},
+		nom.ActionSendToController{},
+		nom.ActionNormal{},
+		nom.ActionPushVLAN{ID: 10},
+		nom.ActionPopVLAN{},
+		nom.ActionWriteFields{
+			Fields: []nom.Field{
+				nom.EthSrc{
+					Addr: nom.MACAddr{1, 2, 3, 4, 5, 6},
+					Mask: nom.MaskNoneMAC,
+				},
+				nom.EthDst{
+					Addr: nom.MACAddr{6, 5, 4, 3, 2, 1},
+					Mask: nom.MaskNoneMAC,
+				},
+				nom.VLANPCP(3),
+				nom.IPv4Src{
+					Addr: nom.IPv4Addr{10, 0, 0, 1},
+					Mask: nom.MaskNoneIPV4,
+				},
+				nom.IPv4Dst{
+					Addr: nom.IPv4Addr{10, 0, 0, 2},
+					Mask: nom.MaskNoneIPV4,
+				},
+				nom.TransportPortSrc(1234),
+				nom.TransportPortDst(80),
+			},
+		},
+		nom.ActionForward{Ports: []nom.UID{"n1$$1"}},
+	}
+}
+
+func testActionsEqual(t *testing.T, actual, want []nom.Action) {
+	if len(actual) != len(want) {
+		t.Fatalf("invalid actions:\n\tactual=%#v\n\twant=%#v", actual, want)
+	}
+	for i := range want {
+		if !actual[i].Equals(want[i]) {
+			t.Errorf("invalid action conversion:\n\tactual=%#v\n\twant=%#v",
+				actual[i], want[i])
+		}
+	}
+}
+
+func TestOF10Actions(t *testing.T) {
+	p1 := nom.Port{ID: "1", Node: "n1"}
+	p2 := nom.Port{ID: "2", Node: "n1"}
+	driver := of10Driver{}
+	addTestPorts(&driver.ports, map[uint32]nom.Port{1: p1, 2: p2})
+	actions := testActions()
+	ofas, err := driver.ofActions(actions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mod := of10.NewFlowMod()
+	for _, ofa := range ofas {
+		mod.AddActions(ofa)
+	}
+	nas, err := driver.nomActions(mod.Actions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	testActionsEqual(t, nas, actions)
+
+	ofas, err = driver.ofActions([]nom.Action{nom.ActionDrop{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ofas) != 0 {
+		t.Errorf("drop is converted to %d actions", len(ofas))
+	}
+	nas, err = driver.nomActions(ofas)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testActionsEqual(t, nas, []nom.Action{nom.ActionDrop{}})
+}
+
+func TestOF12Actions(t *testing.T) {
+	p1 := nom.Port{ID: "1", Node: "n1"}
+	p2 := nom.Port{ID: "2", Node: "n1"}
+	driver := of12Driver{}
+	addTestPorts(&driver.ports, map[uint32]nom.Port{1: p1, 2: p2})
+	actions := testActions()
+	ofas, err := driver.ofActions(actions, nom.IPProtoTCP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	inst := of12.NewApplyActions()
+	for _, ofa := range ofas {
+		if ofa.Size()%8 != 0 {
+			t.Errorf("action %v is not 64-bit aligned: size=%v", ofa.Type(),
+				ofa.Size())
+		}
+		inst.AddActions(ofa)
+	}
+	nas, err := driver.nomActions(inst.Actions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	testActionsEqual(t, nas, actions)
+
+	masked := nom.ActionWriteFields{
+		Fields: []nom.Field{
+			nom.IPv4Src{
+				Addr: nom.IPv4Addr{10, 0, 0, 0},
+				Mask: nom.IPv4Addr{255, 0, 0, 0},
+			},
+		},
+	}
+	if _, err := driver.ofActions([]nom.Action{masked}, 0); err == nil {
+		t.Error("no error for writing a masked field")
+	}
+}
+
+func TestOF13Actions(t *testing.T) {
+	p1 := nom.Port{ID: "1", Node: "n1"}
+	p2 := nom.Port{ID: "2", Node: "n1"}
+	driver := of13Driver{}
+	addTestPorts(&driver.ports, map[uint32]nom.Port{1: p1, 2: p2})
+	actions := testActions()
+	ofas, err := driver.ofActions(actions, nom.IPProtoTCP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	inst := of13.NewApplyActions()
+	for _, ofa := range ofas {
+		if ofa.Size()%8 != 0 {
+			t.Errorf("action %v is not 64-bit aligned: size=%v", ofa.Type(),
+				ofa.Size())
+		}
+		inst.AddActions(ofa)
+	}
+	nas, err := driver.nomActions(inst.Actions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	testActionsEqual(t, nas, actions)
+
+	masked := nom.ActionWriteFields{
+		Fields: []nom.Field{
+			nom.IPv4Src{
+				Addr: nom.IPv4Addr{10, 0, 0, 0},
+				Mask: nom.IPv4Addr{255, 0, 0, 0},
+			},
+		},
+	}
+	if _, err := driver.ofActions([]nom.Action{masked}, 0); err == nil {
+		t.Error("no error for writing a masked field")
+	}
+}
+
+func TestOF12TransportPorts(t *testing.T) {
+	driver := of12Driver{}
+	protos := []struct {
+		proto    nom.IPProto
+		src, dst of12.OXMatchFields
+	}{
+		{nom.IPProtoTCP, of12.PXMT_TCP_SRC, of12.PXMT_TCP_DST},
+		{nom.IPProtoUDP, of12.PXMT_UDP_SRC, of12.PXMT_UDP_DST},
+	}
+	for _, p := range protos {
+		m := nom.Match{
+			Fields: []nom.Field{
+				nom.EthType(nom.EthTypeIPv4),
+				nom.IPProto(p.proto),
+				nom.TransportPortSrc(53),
+				nom.TransportPortDst(1234),
+			},
+		}
+		ofm, err := driver.ofMatch(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		xm, err := of12.ToOXMatch(ofm)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fields := xm.Fields()
+		if fields[2].OxmField() != uint8(p.src) ||
+			fields[3].OxmField() != uint8(p.dst) {
+
+			t.Errorf("invalid transport fields for %v: actual=%v,%v want=%v,%v",
+				p.proto, fields[2].OxmField(), fields[3].OxmField(), p.src, p.dst)
+		}
+		nm, err := driver.nomMatch(ofm)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !nm.Equals(m) {
+			t.Errorf("invalid match conversion:\n\tactual=%#v\n\twant=%#v", nm, m)
+		}
+
+		w := []nom.Action{
+			nom.ActionWriteFields{Fields: []nom.Field{nom.TransportPortDst(80)}},
+		}
+		ofas, err := driver.ofActions(w, p.proto)
+		if err != nil {
+			t.Fatal(err)
+		}
+		set, err := of12.ToActionSetField(ofas[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if set.Field().OxmField() != uint8(p.dst) {
+			t.Errorf("invalid set-field for %v: actual=%v want=%v", p.proto,
+				set.Field().OxmField(), p.dst)
+		}
+	}
+}
+
+func TestOF13TransportPorts(t *testing.T) {
+	driver := of13Driver{}
+	protos := []struct {
+		proto    nom.IPProto
+		src, dst of13.OXMatchFields
+	}{
+		{nom.IPProtoTCP, of13.PXMT_TCP_SRC, of13.PXMT_TCP_DST},
+		{nom.IPProtoUDP, of13.PXMT_UDP_SRC, of13.PXMT_UDP_DST},
+	}
+	for _, p := range protos {
+		m := nom.Match{
+			Fields: []nom.Field{
+				nom.EthType(nom.EthTypeIPv4),
+				nom.IPProto(p.proto),
+				nom.TransportPortSrc(53),
+				nom.TransportPortDst(1234),
+			},
+		}
+		ofm, err := driver.ofMatch(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		nm, err := driver.nomMatch(ofm)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !nm.Equals(m) {
+			t.Errorf("invalid match conversion:\n\tactual=%#v\n\twant=%#v", nm, m)
+		}
+
+		w := []nom.Action{
+			nom.ActionWriteFields{Fields: []nom.Field{nom.TransportPortDst(80)}},
+		}
+		ofas, err := driver.ofActions(w, p.proto)
+		if err != nil {
+			t.Fatal(err)
+		}
+		set, err := of13.ToActionSetField(ofas[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if set.Field().OxmField() != uint8(p.dst) {
+			t.Errorf("invalid set-field for %v: actual=%v want=%v", p.proto,
+				set.Field().OxmField(), p.dst)
+		}
+	}
+}
+
+func TestOF12FloodNoFloodPorts(t *testing.T) {
+	p1 := nom.Port{ID: "1", Node: "n1"}
+	p2 := nom.Port{ID: "2", Node: "n1", Config: nom.PortConfigNoFlood}
+	p3 := nom.Port{ID: "3", Node: "n1"}
+	driver := of12Driver{}
+	addTestPorts(&driver.ports, map[uint32]nom.Port{1: p1, 2: p2, 3: p3})
+	ofas, err := driver.ofActions([]nom.Action{nom.ActionFlood{}}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	inst := of12.NewApplyActions()
+	for _, ofa := range ofas {
+		inst.AddActions(ofa)
+	}
+	nas, err := driver.nomActions(inst.Actions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []nom.Action{nom.ActionForward{Ports: []nom.UID{p1.UID(), p3.UID()}}}
+	testActionsEqual(t, nas, want)
+
+	driver.ports.modify(nom.ModifyPort{
+		Port: p2.UID(),
+		Mask: nom.PortConfigNoFlood,
+	})
+	ofas, err = driver.ofActions([]nom.Action{nom.ActionFlood{}}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := of12.ToActionOutput(ofas[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ofas) != 1 || out.Port() != uint32(of12.PP_FLOOD) {
+		t.Errorf("flood is not converted to an output on PP_FLOOD: %v", ofas)
+	}
+}
+
+func TestVLANNoneMatch(t *testing.T) {
+	m := nom.Match{Fields: []nom.Field{nom.VLANNone}}
+	d12 := of12Driver{}
+	d13 := of13Driver{}
+
+	ofm12, err := d12.ofMatch(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	xm12, err := of12.ToOXMatch(ofm12)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vid12, err := of12.ToOxmVlanVid(xm12.Fields()[0])
+	if err != nil || vid12.Vid() != uint16(of12.PVID_NONE) {
+		t.Errorf("invalid of12 vlan for %v: %v", m, vid12.Vid())
+	}
+	nm, err := d12.nomMatch(ofm12)
+	if err != nil || !nm.Equals(m) {
+		t.Errorf("invalid of12 match conversion: actual=%v want=%v", nm, m)
+	}
+
+	ofm13, err := d13.ofMatch(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	xm13, err := of13.ToOXMatch(ofm13)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vid13, err := of13.ToOxmVlanVid(xm13.Fields()[0])
+	if err != nil || vid13.Vid() != uint16(of13.PVID_NONE) {
+		t.Errorf("invalid of13 vlan for %v: %v", m, vid13.Vid())
+	}
+	nm, err = d13.nomMatch(ofm13)
+	if err != nil || !nm.Equals(m) {
+		t.Errorf("invalid of13 match conversion: actual=%v want=%v", nm, m)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/kandoo/beehive-netctrl/openflow/of"
 	"github.com/kandoo/beehive-netctrl/openflow/of10"
 	"github.com/kandoo/beehive-netctrl/openflow/of12"
+	"github.com/kandoo/beehive-netctrl/openflow/of13"
 	"github.com/kandoo/beehive/Godeps/_workspace/src/github.com/golang/glog"
 )
 
@@ -15,6 +16,10 @@ func (d *of12Driver) handleEchoRequest(req of12.EchoRequest, c *ofConn) error {
 	return doHandleEchoRequest(req.Header, of12.NewEchoReply().Header, c)
 }
 
+func (d *of13Driver) handleEchoRequest(req of13.EchoRequest, c *ofConn) error {
+	return doHandleEchoRequest(req.Header, of13.NewEchoReply().Header, c)
+}
+
 func doHandleEchoRequest(req of.Header, res of.Header, c *ofConn) error {
 	glog.V(2).Infof("Received echo request from %v", c.node)
 	res.SetXid(req.Xid())
@@ -1,19 +1,171 @@
 package openflow
 
 import (
-	"github.com/kandoo/beehive/Godeps/_workspace/src/github.com/golang/glog"
+	"fmt"
+
+	"github.com/kandoo/beehive-netctrl/nom"
 	"github.com/kandoo/beehive-netctrl/openflow/of10"
 	"github.com/kandoo/beehive-netctrl/openflow/of12"
+	"github.com/kandoo/beehive-netctrl/openflow/of13"
+	"github.com/kandoo/beehive/Godeps/_workspace/src/github.com/golang/glog"
 )
 
 func (of *of10Driver) handleErrorMsg(err of10.ErrorMsg, c *ofConn) error {
-	glog.Errorf("Error from switch %s: type=%d code=%d", c.node, err.ErrType(),
-		err.Code())
+	code := of10DriverErrorCode(of10.ErrorType(err.ErrType()), err.Code())
+	desc := fmt.Sprintf("of10: type=%d code=%d", err.ErrType(), err.Code())
+	if !c.failRequest(err.Xid(), code, desc) {
+		glog.Errorf("Error from switch %s: type=%d code=%d", c.node, err.ErrType(),
+			err.Code())
+	}
 	return nil
 }
 
 func (of *of12Driver) handleErrorMsg(err of12.ErrorMsg, c *ofConn) error {
-	glog.Errorf("Error from switch %s: type=%d code=%d", c.node, err.ErrType(),
-		err.Code())
+	code := of12DriverErrorCode(of12.ErrorType(err.ErrType()), err.Code())
+	desc := fmt.Sprintf("of12: type=%d code=%d", err.ErrType(), err.Code())
+	if !c.failRequest(err.Xid(), code, desc) {
+		glog.Errorf("Error from switch %s: type=%d code=%d", c.node, err.ErrType(),
+			err.Code())
+	}
+	return nil
+}
+
+func (of *of13Driver) handleErrorMsg(err of13.ErrorMsg, c *ofConn) error {
+	// Error types and codes of OpenFlow 1.3 are a superset of OpenFlow 1.2.
+	code := of12DriverErrorCode(of12.ErrorType(err.ErrType()), err.Code())
+	desc := fmt.Sprintf("of13: type=%d code=%d", err.ErrType(), err.Code())
+	if !c.failRequest(err.Xid(), code, desc) {
+		glog.Errorf("Error from switch %s: type=%d code=%d", c.node, err.ErrType(),
+			err.Code())
+	}
 	return nil
 }
+
+func of10DriverErrorCode(t of10.ErrorType, code uint16) nom.DriverErrorCode {
+	switch t {
+	case of10.PET_BAD_REQUEST:
+		switch of10.BadRequestCode(code) {
+		case of10.PBRC_BAD_VERSION, of10.PBRC_BAD_TYPE, of10.PBRC_BAD_STAT,
+			of10.PBRC_BAD_VENDOR, of10.PBRC_BAD_SUBTYPE:
+			return nom.DriverErrUnsupported
+		case of10.PBRC_EPERM:
+			return nom.DriverErrPermissionDenied
+		case of10.PBRC_BUFFER_EMPTY, of10.PBRC_BUFFER_UNKNOWN:
+			return nom.DriverErrBadBuffer
+		}
+		return nom.DriverErrBadRequest
+
+	case of10.PET_BAD_ACTION:
+		switch of10.BadActionCode(code) {
+		case of10.PBAC_BAD_OUT_PORT:
+			return nom.DriverErrBadPort
+		case of10.PBAC_EPERM:
+			return nom.DriverErrPermissionDenied
+		}
+		return nom.DriverErrBadAction
+
+	case of10.PET_FLOW_MOD_FAILED:
+		switch of10.Flow_modFailedCode(code) {
+		case of10.PFMFC_ALL_TABLES_FULL:
+			return nom.DriverErrTableFull
+		case of10.PFMFC_OVERLAP:
+			return nom.DriverErrOverlap
+		case of10.PFMFC_EPERM:
+			return nom.DriverErrPermissionDenied
+		case of10.PFMFC_UNSUPPORTED:
+			return nom.DriverErrBadAction
+		}
+		return nom.DriverErrBadRequest
+
+	case of10.PET_PORT_MOD_FAILED:
+		return nom.DriverErrBadPort
+
+	case of10.PET_QUEUE_OP_FAILED:
+		switch of10.QueueOpFailedCode(code) {
+		case of10.PQC_BAD_PORT:
+			return nom.DriverErrBadPort
+		case of10.PQC_EPERM:
+			return nom.DriverErrPermissionDenied
+		}
+		return nom.DriverErrBadRequest
+	}
+	return nom.DriverErrUnknown
+}
+
+func of12DriverErrorCode(t of12.ErrorType, code uint16) nom.DriverErrorCode {
+	switch t {
+	case of12.PET_BAD_REQUEST:
+		switch of12.BadRequestCode(code) {
+		case of12.PBRC_BAD_VERSION, of12.PBRC_BAD_TYPE, of12.PBRC_BAD_STAT,
+			of12.PBRC_BAD_EXPERIMENTER, of12.PBRC_BAD_EXP_TYPE:
+			return nom.DriverErrUnsupported
+		case of12.PBRC_EPERM:
+			return nom.DriverErrPermissionDenied
+		case of12.PBRC_BUFFER_EMPTY, of12.PBRC_BUFFER_UNKNOWN:
+			return nom.DriverErrBadBuffer
+		case of12.PBRC_IS_SLAVE:
+			return nom.DriverErrIsSlave
+		case of12.PBRC_BAD_PORT:
+			return nom.DriverErrBadPort
+		}
+		return nom.DriverErrBadRequest
+
+	case of12.PET_BAD_INSTRUCTION:
+		return nom.DriverErrBadAction
+
+	case of12.PET_BAD_ACTION:
+		switch of12.BadActionCode(code) {
+		case of12.PBAC_BAD_OUT_PORT:
+			return nom.DriverErrBadPort
+		case of12.PBAC_EPERM:
+			return nom.DriverErrPermissionDenied
+		}
+		return nom.DriverErrBadAction
+
+	case of12.PET_BAD_MATCH:
+		if of12.BadMatchCode(code) == of12.PBMC_EPERM {
+			return nom.DriverErrPermissionDenied
+		}
+		return nom.DriverErrBadMatch
+
+	case of12.PET_FLOW_MOD_FAILED:
+		switch of12.FlowModFailedCode(code) {
+		case of12.PFMFC_TABLE_FULL:
+			return nom.DriverErrTableFull
+		case of12.PFMFC_OVERLAP:
+			return nom.DriverErrOverlap
+		case of12.PFMFC_EPERM:
+			return nom.DriverErrPermissionDenied
+		}
+		return nom.DriverErrBadRequest
+
+	case of12.PET_PORT_MOD_FAILED:
+		if of12.PortModFailedCode(code) == of12.PPMFC_EPERM {
+			return nom.DriverErrPermissionDenied
+		}
+		return nom.DriverErrBadPort
+
+	case of12.PET_QUEUE_OP_FAILED:
+		switch of12.QueueOpFailedCode(code) {
+		case of12.PQC_BAD_PORT:
+			return nom.DriverErrBadPort
+		case of12.PQC_EPERM:
+			return nom.DriverErrPermissionDenied
+		}
+		return nom.DriverErrBadRequest
+
+	case of12.PET_ROLE_REQUEST_FAILED:
+		switch of12.RoleRequestFailedCode(code) {
+		case of12.PRRFC_STALE:
+			return nom.DriverErrStaleRole
+		case of12.PRRFC_UNSUP:
+			return nom.DriverErrUnsupported
+		}
+		return nom.DriverErrBadRequest
+
+	case of12.PET_GROUP_MOD_FAILED, of12.PET_TABLE_MOD_FAILED,
+		of12.PET_SWITCH_CONFIG_FAILED:
+		return nom.DriverErrBadRequest
+	}
+	return nom.DriverErrUnknown
+}
@@ -0,0 +1,54 @@
+package openflow
+
+import (
+	"testing"
+
+	"github.com/kandoo/beehive-netctrl/nom"
+	"github.com/kandoo/beehive-netctrl/openflow/of10"
+	"github.com/kandoo/beehive-netctrl/openflow/of12"
+)
+
+func TestOF10DriverErrorCode(t *testing.T) {
+	errs := []struct {
+		t    of10.ErrorType
+		code uint16
+		want nom.DriverErrorCode
+	}{
+		{of10.PET_FLOW_MOD_FAILED, uint16(of10.PFMFC_ALL_TABLES_FULL),
+			nom.DriverErrTableFull},
+		{of10.PET_FLOW_MOD_FAILED, uint16(of10.PFMFC_OVERLAP), nom.DriverErrOverlap},
+		{of10.PET_BAD_ACTION, uint16(of10.PBAC_BAD_OUT_PORT), nom.DriverErrBadPort},
+		{of10.PET_BAD_REQUEST, uint16(of10.PBRC_BUFFER_UNKNOWN),
+			nom.DriverErrBadBuffer},
+		{of10.PET_HELLO_FAILED, uint16(of10.PHFC_INCOMPATIBLE),
+			nom.DriverErrUnknown},
+	}
+	for _, e := range errs {
+		if actual := of10DriverErrorCode(e.t, e.code); actual != e.want {
+			t.Errorf("invalid code for type=%v code=%v: actual=%v want=%v", e.t,
+				e.code, actual, e.want)
+		}
+	}
+}
+
+func TestOF12DriverErrorCode(t *testing.T) {
+	errs := []struct {
+		t    of12.ErrorType
+		code uint16
+		want nom.DriverErrorCode
+	}{
+		{of12.PET_FLOW_MOD_FAILED, uint16(of12.PFMFC_TABLE_FULL),
+			nom.DriverErrTableFull},
+		{of12.PET_BAD_REQUEST, uint16(of12.PBRC_IS_SLAVE), nom.DriverErrIsSlave},
+		{of12.PET_BAD_MATCH, uint16(of12.PBMC_BAD_FIELD), nom.DriverErrBadMatch},
+		{of12.PET_ROLE_REQUEST_FAILED, uint16(of12.PRRFC_STALE),
+			nom.DriverErrStaleRole},
+		{of12.PET_BAD_INSTRUCTION, 0, nom.DriverErrBadAction},
+	}
+	for _, e := range errs {
+		if actual := of12DriverErrorCode(e.t, e.code); actual != e.want {
+			t.Errorf("invalid code for type=%v code=%v: actual=%v want=%v", e.t,
+				e.code, actual, e.want)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/kandoo/beehive-netctrl/openflow/of10"
 	"github.com/kandoo/beehive-netctrl/openflow/of12"
+	"github.com/kandoo/beehive-netctrl/openflow/of13"
 )
 
 func (of *of10Driver) handleFeaturesReply(rep of10.FeaturesReply,
@@ -17,6 +18,11 @@ func (of *of12Driver) handleFeaturesReply(rep of12.FeaturesReply,
 	return lateFeaturesReplyError()
 }
 
+func (of *of13Driver) handleFeaturesReply(rep of13.FeaturesReply,
+	c *ofConn) error {
+	return lateFeaturesReplyError()
+}
+
 func lateFeaturesReplyError() error {
 	return errors.New("Cannot receive a features reply after handshake.")
 }
@@ -0,0 +1,98 @@
+package openflow
+
+import (
+	"time"
+
+	"github.com/kandoo/beehive-netctrl/nom"
+	"github.com/kandoo/beehive-netctrl/openflow/of10"
+	"github.com/kandoo/beehive-netctrl/openflow/of12"
+	"github.com/kandoo/beehive-netctrl/openflow/of13"
+	"github.com/kandoo/beehive/Godeps/_workspace/src/github.com/golang/glog"
+)
+
+func (d *of10Driver) handleFlowRemoved(r of10.FlowRemoved, c *ofConn) error {
+	m, err := d.nomMatch(r.Match())
+	if err != nil {
+		return err
+	}
+	rem := nom.FlowEntryRemoved{
+		Flow: nom.FlowEntry{
+			Node:        c.node.UID(),
+			Cookie:      r.Cookie(),
+			Match:       m,
+			Priority:    r.Priority(),
+			IdleTimeout: time.Duration(r.IdleTimeout()) * time.Second,
+		},
+		Reason: nomFlowRemovedReason(r.Reason()),
+		Duration: time.Duration(r.DurationSec())*time.Second +
+			time.Duration(r.DurationNsec()),
+		Packets: r.PacketCount(),
+		Bytes:   r.ByteCount(),
+	}
+	glog.V(2).Infof("flow removed from %v: %v", c.node.UID(), rem.Flow)
+	c.ctx.Emit(rem)
+	return nil
+}
+
+func (d *of12Driver) handleFlowRemoved(r of12.FlowRemoved, c *ofConn) error {
+	m, err := d.nomMatch(r.Match())
+	if err != nil {
+		return err
+	}
+	rem := nom.FlowEntryRemoved{
+		Flow: nom.FlowEntry{
+			Node:        c.node.UID(),
+			Cookie:      r.Cookie(),
+			Match:       m,
+			Priority:    r.Priority(),
+			IdleTimeout: time.Duration(r.IdleTimeout()) * time.Second,
+			HardTimeout: time.Duration(r.HardTimeout()) * time.Second,
+		},
+		Reason: nomFlowRemovedReason(r.Reason()),
+		Duration: time.Duration(r.DurationSec())*time.Second +
+			time.Duration(r.DurationNsec()),
+		Packets: r.PacketCount(),
+		Bytes:   r.ByteCount(),
+	}
+	glog.V(2).Infof("flow removed from %v: %v", c.node.UID(), rem.Flow)
+	c.ctx.Emit(rem)
+	return nil
+}
+
+func (d *of13Driver) handleFlowRemoved(r of13.FlowRemoved, c *ofConn) error {
+	m, err := d.nomMatch(r.Match())
+	if err != nil {
+		return err
+	}
+	rem := nom.FlowEntryRemoved{
+		Flow: nom.FlowEntry{
+			Node:        c.node.UID(),
+			Cookie:      r.Cookie(),
+			Match:       m,
+			Priority:    r.Priority(),
+			IdleTimeout: time.Duration(r.IdleTimeout()) * time.Second,
+			HardTimeout: time.Duration(r.HardTimeout()) * time.Second,
+		},
+		Reason: nomFlowRemovedReason(r.Reason()),
+		Duration: time.Duration(r.DurationSec())*time.Second +
+			time.Duration(r.DurationNsec()),
+		Packets: r.PacketCount(),
+		Bytes:   r.ByteCount(),
+	}
+	glog.V(2).Infof("flow removed from %v: %v", c.node.UID(), rem.Flow)
+	c.ctx.Emit(rem)
+	return nil
+}
+
+func nomFlowRemovedReason(r uint8) nom.FlowRemovedReason {
+	// Idle timeout, hard timeout, and delete have the same values in all
+	// OpenFlow versions.
+	switch of10.FlowRemovedReason(r) {
+	case of10.PRR_IDLE_TIMEOUT:
+		return nom.FlowRemovedIdleTimeout
+	case of10.PRR_HARD_TIMEOUT:
+		return nom.FlowRemovedHardTimeout
+	default:
+		return nom.FlowRemovedDeleted
+	}
+}
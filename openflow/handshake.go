@@ -1,12 +1,15 @@
 package openflow
 
 import (
+	"encoding/binary"
 	"errors"
+	"fmt"
 
 	"github.com/kandoo/beehive-netctrl/nom"
 	"github.com/kandoo/beehive-netctrl/openflow/of"
 	"github.com/kandoo/beehive-netctrl/openflow/of10"
 	"github.com/kandoo/beehive-netctrl/openflow/of12"
+	"github.com/kandoo/beehive-netctrl/openflow/of13"
 	"github.com/kandoo/beehive/Godeps/_workspace/src/github.com/golang/glog"
 )
 
@@ -24,13 +27,12 @@ func (c *ofConn) handshake() (ofDriver, error) {
 	glog.V(2).Info("%v received hello from a switch with OFv%v", c.ctx,
 		h.Version())
 
-	version := of.OPENFLOW_1_0
-	if h.Version() >= uint8(of.OPENFLOW_1_2) {
-		version = of.OPENFLOW_1_2
+	version, err := negotiateVersion(h)
+	if err != nil {
+		return nil, err
 	}
-	h.SetVersion(uint8(version))
 
-	if err = c.WriteHeader(h.Header); err != nil {
+	if err = c.WriteHeader(newHello(version).Header); err != nil {
 		return nil, err
 	}
 	c.Flush()
@@ -43,6 +45,8 @@ func (c *ofConn) handshake() (ofDriver, error) {
 		driver = &of10Driver{}
 	case of.OPENFLOW_1_2:
 		driver = &of12Driver{}
+	case of.OPENFLOW_1_3:
+		driver = &of13Driver{}
 	}
 
 	if err = driver.handshake(c); err != nil {
@@ -56,6 +60,74 @@ func (c *ofConn) handshake() (ofDriver, error) {
 	return driver, nil
 }
 
+// helloElemVersionBitmap is the type of the hello element that lists the
+// versions supported by the sender of the hello (OFPHET_VERSIONBITMAP).
+const helloElemVersionBitmap = 1
+
+// supportedVersions is the bitmap of the OpenFlow versions that have a driver.
+const supportedVersions = 1<<uint(of.OPENFLOW_1_0) |
+	1<<uint(of.OPENFLOW_1_2) | 1<<uint(of.OPENFLOW_1_3)
+
+// helloVersions returns the bitmap of the versions listed in the version
+// bitmap element of the hello, and whether the hello has such an element.
+func helloVersions(h of.Hello) (uint32, bool) {
+	size := h.Size()
+	if size > len(h.Buf) {
+		size = len(h.Buf)
+	}
+	for b := h.Buf[8:size]; len(b) >= 4; {
+		t := binary.BigEndian.Uint16(b)
+		l := int(binary.BigEndian.Uint16(b[2:]))
+		if l < 4 || l > len(b) {
+			break
+		}
+		if t == helloElemVersionBitmap && l >= 8 {
+			// The first bitmap has the versions 0 to 31.
+			return binary.BigEndian.Uint32(b[4:]), true
+		}
+		// Elements are padded to a multiple of 8 bytes.
+		l = (l + 7) / 8 * 8
+		if l > len(b) {
+			break
+		}
+		b = b[l:]
+	}
+	return 0, false
+}
+
+// negotiateVersion returns the highest version that is supported by both the
+// controller and the switch that has sent the hello. If the hello has no
+// version bitmap, the switch supports the versions up to the version of the
+// hello.
+func negotiateVersion(h of.Hello) (of.Versions, error) {
+	versions := uint32(supportedVersions)
+	if bitmap, ok := helloVersions(h); ok {
+		versions &= bitmap
+	} else if h.Version() < 32 {
+		versions &= 1<<(uint(h.Version())+1) - 1
+	}
+	for v := of.OPENFLOW_1_3; v >= of.OPENFLOW_1_0; v-- {
+		if versions&(1<<uint(v)) != 0 {
+			return v, nil
+		}
+	}
+	return 0, fmt.Errorf("ofConn: no common OpenFlow version with the switch "+
+		"(hello version %v)", h.Version())
+}
+
+// newHello returns a hello of the given version, with a version bitmap that
+// lists the versions supported by the controller.
+func newHello(version of.Versions) of.Hello {
+	h := of.NewHelloWithBuf(make([]byte, 16))
+	h.Init()
+	h.SetVersion(uint8(version))
+	h.SetLength(16)
+	binary.BigEndian.PutUint16(h.Buf[8:], helloElemVersionBitmap)
+	binary.BigEndian.PutUint16(h.Buf[10:], 8)
+	binary.BigEndian.PutUint32(h.Buf[12:], supportedVersions)
+	return h
+}
+
 func (d *of10Driver) handshake(c *ofConn) error {
 	freq := of10.NewFeaturesRequest()
 	if err := c.WriteHeader(freq.Header); err != nil {
@@ -107,18 +179,10 @@ func (d *of10Driver) handshake(c *ofConn) error {
 		Driver: nomDriver,
 	})
 
-	d.ofPorts = make(map[uint16]*nom.Port)
-	d.nomPorts = make(map[nom.UID]uint16)
+	d.ports.reset()
 	for _, p := range frep.Ports() {
-		name := p.Name()
-		port := nom.Port{
-			ID:      portNoToPortID(uint32(p.PortNo())),
-			Name:    string(name[:]),
-			MACAddr: p.HwAddr(),
-			Node:    c.NodeUID(),
-		}
-		d.ofPorts[p.PortNo()] = &port
-		d.nomPorts[port.UID()] = p.PortNo()
+		port := d.nomPort(p, c)
+		d.ports.update(uint32(p.PortNo()), port, nom.PortStatusAdded, 0)
 		glog.Infof("%v added", port)
 		if p.PortNo() <= uint16(of10.PP_MAX) {
 			c.ctx.Emit(nom.PortStatusChanged{
@@ -179,21 +243,13 @@ func (d *of12Driver) handshake(c *ofConn) error {
 		Driver: nomDriver,
 	})
 
-	d.ofPorts = make(map[uint32]*nom.Port)
-	d.nomPorts = make(map[nom.UID]uint32)
+	d.ports.reset()
 	for _, p := range frep.Ports() {
 		if p.PortNo() > uint32(of12.PP_MAX) {
 			continue
 		}
-		name := p.Name()
-		port := nom.Port{
-			ID:      portNoToPortID(p.PortNo()),
-			Name:    string(name[:]),
-			MACAddr: p.HwAddr(),
-			Node:    c.NodeUID(),
-		}
-		d.ofPorts[p.PortNo()] = &port
-		d.nomPorts[port.UID()] = p.PortNo()
+		port := d.nomPort(p, c)
+		d.ports.update(p.PortNo(), port, nom.PortStatusAdded, 0)
 		glog.Infof("%v added", port)
 		c.ctx.Emit(nom.PortStatusChanged{
 			Port:   port,
@@ -203,3 +259,135 @@ func (d *of12Driver) handshake(c *ofConn) error {
 
 	return nil
 }
+
+func (d *of13Driver) handshake(c *ofConn) error {
+	freq := of13.NewFeaturesRequest()
+	if err := c.WriteHeader(freq.Header); err != nil {
+		return err
+	}
+	c.Flush()
+
+	glog.V(2).Info("Sent features request to the switch")
+
+	hdr, err := c.ReadHeader()
+	if err != nil {
+		return err
+	}
+
+	v13, err := of13.ToHeader13(hdr)
+	if err != nil {
+		return err
+	}
+
+	frep, err := of13.ToFeaturesReply(v13)
+	if err != nil {
+		return err
+	}
+
+	glog.Infof("Handshake completed for switch %016x", frep.DatapathId())
+
+	glog.Infof("Disabling packet buffers in the switch.")
+	cfg := of13.NewSwitchSetConfig()
+	cfg.SetMissSendLen(0xFFFF)
+	c.WriteHeader(cfg.Header)
+
+	// In OpenFlow 1.3, ports are not included in the features reply and we
+	// need to explicitly ask for port descriptions.
+	preq := of13.NewPortDescRequest()
+	if err := c.WriteHeader(preq.Header); err != nil {
+		return err
+	}
+	c.Flush()
+
+	glog.V(2).Info("Sent port description request to the switch")
+
+	nodeID := datapathIDToNodeID(frep.DatapathId())
+	c.node = nom.Node{
+		ID:      nodeID,
+		MACAddr: datapathIDToMACAddr(frep.DatapathId()),
+		Capabilities: []nom.NodeCapability{
+			nom.CapDriverRole,
+		},
+	}
+
+	nomDriver := nom.Driver{
+		BeeID: c.ctx.ID(),
+		Role:  nom.DriverRoleDefault,
+	}
+	c.ctx.Emit(nom.NodeConnected{
+		Node:   c.node,
+		Driver: nomDriver,
+	})
+
+	d.ports.reset()
+	for {
+		hdr, err := c.ReadHeader()
+		if err != nil {
+			return err
+		}
+
+		v13, err := of13.ToHeader13(hdr)
+		if err != nil {
+			return err
+		}
+
+		mrep, err := of13.ToMultipartReply(v13)
+		if err != nil || !of13.IsPortDescReply(mrep) {
+			// The switch may send other messages before replying to the port
+			// description request.
+			if err := d.handlePkt(hdr, c); err != nil {
+				return err
+			}
+			continue
+		}
+
+		prep := of13.NewPortDescReplyWithBuf(mrep.Buf)
+
+		for _, p := range prep.Ports() {
+			if p.PortNo() > uint32(of13.PP_MAX) {
+				continue
+			}
+			port := d.nomPort(p, c)
+			d.ports.update(p.PortNo(), port, nom.PortStatusAdded, 0)
+			glog.Infof("%v added", port)
+			c.ctx.Emit(nom.PortStatusChanged{
+				Port:   port,
+				Driver: nomDriver,
+			})
+		}
+
+		if mrep.Flags()&uint16(of13.PMPF_REPLY_MORE) == 0 {
+			break
+		}
+	}
+
+	return d.addTableMiss(c)
+}
+
+// addTableMiss installs a table-miss flow entry that sends unmatched packets to
+// the controller. Unlike OpenFlow 1.2, OpenFlow 1.3 switches drop packets that
+// miss all flow entries.
+func (d *of13Driver) addTableMiss(c *ofConn) error {
+	out := of13.NewActionOutput()
+	out.SetPort(uint32(of13.PP_CONTROLLER))
+	out.SetMaxLen(uint16(of13.PCML_NO_BUFFER))
+	inst := of13.NewApplyActions()
+	inst.AddActions(out.Action)
+
+	mod := of13.NewFlowMod()
+	mod.SetCommand(uint8(of13.PFC_ADD))
+	mod.SetPriority(0)
+	mod.SetBufferId(uint32(of13.P_NO_BUFFER))
+	mod.SetOutPort(uint32(of13.PP_ANY))
+	mod.SetOutGroup(uint32(of13.PG_ANY))
+	mod.SetMatch(of13.NewOXMatch().Match)
+	mod.AddInstructions(inst.Instruction)
+	if err := c.WriteHeader(mod.Header); err != nil {
+		return err
+	}
+	c.Flush()
+
+	glog.V(2).Infof("%v installed the table-miss flow entry in %v", c.ctx,
+		c.node)
+	return nil
+}
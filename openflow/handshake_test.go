@@ -0,0 +1,70 @@
+package openflow
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/kandoo/beehive-netctrl/openflow/of"
+)
+
+// testHello returns a hello of the given version with a version bitmap, if
+// bitmap is not zero.
+func testHello(version of.Versions, bitmap uint32) of.Hello {
+	if bitmap == 0 {
+		h := of.NewHello()
+		h.SetVersion(uint8(version))
+		return h
+	}
+	// The element is preceded by an unknown element with padding.
+	h := of.NewHelloWithBuf(make([]byte, 32))
+	h.Init()
+	h.SetVersion(uint8(version))
+	h.SetLength(32)
+	binary.BigEndian.PutUint16(h.Buf[8:], 0xFF)
+	binary.BigEndian.PutUint16(h.Buf[10:], 6)
+	binary.BigEndian.PutUint16(h.Buf[16:], helloElemVersionBitmap)
+	binary.BigEndian.PutUint16(h.Buf[18:], 8)
+	binary.BigEndian.PutUint32(h.Buf[20:], bitmap)
+	return h
+}
+
+func TestNegotiateVersion(t *testing.T) {
+	tests := []struct {
+		hello   of.Hello
+		version of.Versions
+	}{
+		{testHello(of.OPENFLOW_1_0, 0), of.OPENFLOW_1_0},
+		{testHello(of.OPENFLOW_1_1, 0), of.OPENFLOW_1_0},
+		{testHello(of.OPENFLOW_1_2, 0), of.OPENFLOW_1_2},
+		{testHello(of.OPENFLOW_1_3, 0), of.OPENFLOW_1_3},
+		{testHello(5, 0), of.OPENFLOW_1_3},
+		{testHello(5, 1<<5|1<<3|1<<1), of.OPENFLOW_1_2},
+		{testHello(of.OPENFLOW_1_3, 1<<4|1<<1), of.OPENFLOW_1_3},
+		{testHello(of.OPENFLOW_1_3, 1<<1), of.OPENFLOW_1_0},
+	}
+	for i, test := range tests {
+		v, err := negotiateVersion(test.hello)
+		if err != nil {
+			t.Errorf("cannot negotiate version #%d: %v", i, err)
+			continue
+		}
+		if v != test.version {
+			t.Errorf("invalid version #%d: actual=%v want=%v", i, v, test.version)
+		}
+	}
+
+	if _, err := negotiateVersion(testHello(5, 1<<5|1<<2)); err == nil {
+		t.Error("no error for a switch without a common version")
+	}
+}
+
+func TestNewHello(t *testing.T) {
+	h := newHello(of.OPENFLOW_1_2)
+	if h.Version() != uint8(of.OPENFLOW_1_2) || h.Size() != 16 {
+		t.Errorf("invalid hello: version=%v size=%v", h.Version(), h.Size())
+	}
+	bitmap, ok := helloVersions(h)
+	if want := uint32(1<<1 | 1<<3 | 1<<4); !ok || bitmap != want {
+		t.Errorf("invalid version bitmap: actual=%b want=%b", bitmap, want)
+	}
+}
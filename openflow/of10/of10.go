@@ -94,7 +94,7 @@ const (
 type PortFeatures int
 
 const (
-	PPF_10MB_HD    PortFeatures = 0
+	PPF_10MB_HD    PortFeatures = 1
 	PPF_10MB_FD    PortFeatures = 2
 	PPF_100MB_HD   PortFeatures = 4
 	PPF_100MB_FD   PortFeatures = 8
@@ -3618,6 +3618,194 @@ func (this ActionVlanVid) PadOffset() int {
 	return offset
 }
 
+func NewActionStripVlanWithBuf(b []byte) ActionStripVlan {
+	return ActionStripVlan{Action{packet.Packet{Buf: b}}}
+}
+
+func NewActionStripVlan() ActionStripVlan {
+	s := 8
+	b := make([]byte, s)
+	p := ActionStripVlan{Action{packet.Packet{Buf: b}}}
+	p.Init()
+	return p
+}
+
+type ActionStripVlan struct {
+	Action
+}
+
+func (this ActionStripVlan) minSize() int {
+	return 8
+}
+
+func (this ActionStripVlan) Clone() (ActionStripVlan, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewActionStripVlan(), err
+	}
+
+	return NewActionStripVlanWithBuf(newBuf.Bytes()), nil
+}
+
+type ActionStripVlanConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewActionStripVlanConn(c net.Conn) ActionStripVlanConn {
+	return ActionStripVlanConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *ActionStripVlanConn) WriteActionStripVlan(pkt ActionStripVlan) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *ActionStripVlanConn) WriteActionStripVlans(pkts []ActionStripVlan) error {
+	for _, p := range pkts {
+		if err := c.WriteActionStripVlan(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *ActionStripVlanConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *ActionStripVlanConn) ReadActionStripVlan() (ActionStripVlan, error) {
+	pkts := make([]ActionStripVlan, 1)
+	_, err := c.ReadActionStripVlans(pkts)
+	if err != nil {
+		return NewActionStripVlan(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *ActionStripVlanConn) ReadActionStripVlans(pkts []ActionStripVlan) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewActionStripVlanWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *ActionStripVlan) Init() {
+	this.Action.Init()
+	this.SetLen(uint16(this.minSize()))
+	// Invariants.
+	this.SetType(uint16(3)) // type
+}
+
+func (this ActionStripVlan) Size() int {
+	if len(this.Buf) < this.minSize() {
+		return 0
+	}
+
+	size := int(this.Len())
+	return size
+}
+
+func ToActionStripVlan(p Action) (ActionStripVlan, error) {
+	if !IsActionStripVlan(p) {
+		return NewActionStripVlanWithBuf(nil), errors.New("Cannot convert to of10.ActionStripVlan")
+	}
+
+	return NewActionStripVlanWithBuf(p.Buf), nil
+}
+
+func IsActionStripVlan(p Action) bool {
+	return p.Type() == 3 && true
+}
+
+func (this ActionStripVlan) Pad() [4]uint8 {
+	offset := this.PadOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := 4
+	i := 0
+	var res [4]uint8
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := uint8(this.Buf[offset])
+		if size < 1 {
+			break
+		}
+		size -= 1
+		offset += 1
+		count--
+		res[i] = elem
+		i++
+	}
+	return res
+}
+
+func (this *ActionStripVlan) SetPad(p [4]uint8) {
+	offset := this.PadOffset()
+	for _, e := range p {
+		this.Buf[offset] = byte(e)
+		offset++
+	}
+}
+
+func (this ActionStripVlan) PadOffset() int {
+	offset := 4
+	return offset
+}
+
 func NewActionVlanPcpWithBuf(b []byte) ActionVlanPcp {
 	return ActionVlanPcp{Action{packet.Packet{Buf: b}}}
 }
@@ -9638,23 +9826,23 @@ func (this PortStatsRequest) PadOffset() int {
 }
 
 func NewPortStatsWithBuf(b []byte) PortStats {
-	return PortStats{StatsReply{Header10{of.Header{packet.Packet{Buf: b}}}}}
+	return PortStats{packet.Packet{Buf: b}}
 }
 
 func NewPortStats() PortStats {
-	s := 116
+	s := 104
 	b := make([]byte, s)
-	p := PortStats{StatsReply{Header10{of.Header{packet.Packet{Buf: b}}}}}
+	p := PortStats{packet.Packet{Buf: b}}
 	p.Init()
 	return p
 }
 
 type PortStats struct {
-	StatsReply
+	packet.Packet
 }
 
 func (this PortStats) minSize() int {
-	return 116
+	return 104
 }
 
 func (this PortStats) Clone() (PortStats, error) {
@@ -9764,24 +9952,14 @@ func (c *PortStatsConn) ReadPortStatss(pkts []PortStats) (int, error) {
 }
 
 func (this *PortStats) Init() {
-	this.StatsReply.Init()
-	this.SetLength(uint16(this.minSize()))
 	// Invariants.
-	this.SetStatsType(uint16(4)) // stats_type
-	this.SetType(uint8(17))      // type
-	this.SetVersion(uint8(1))    // version
 }
 
 func (this PortStats) Size() int {
-	if len(this.Buf) < this.minSize() {
-		return 0
-	}
-
-	size := int(this.Length())
-	return size
+	return 104
 }
 
-func ToPortStats(p StatsReply) (PortStats, error) {
+func ToPortStats(p packet.Packet) (PortStats, error) {
 	if !IsPortStats(p) {
 		return NewPortStatsWithBuf(nil), errors.New("Cannot convert to of10.PortStats")
 	}
@@ -9789,8 +9967,8 @@ func ToPortStats(p StatsReply) (PortStats, error) {
 	return NewPortStatsWithBuf(p.Buf), nil
 }
 
-func IsPortStats(p StatsReply) bool {
-	return p.StatsType() == 4 && true
+func IsPortStats(p packet.Packet) bool {
+	return true
 }
 
 func (this PortStats) PortNo() uint16 {
@@ -9806,7 +9984,7 @@ func (this *PortStats) SetPortNo(p uint16) {
 }
 
 func (this PortStats) PortNoOffset() int {
-	offset := 12
+	offset := 0
 	return offset
 }
 
@@ -9840,7 +10018,7 @@ func (this *PortStats) SetPad(p [6]uint8) {
 }
 
 func (this PortStats) PadOffset() int {
-	offset := 14
+	offset := 2
 	return offset
 }
 
@@ -9857,7 +10035,7 @@ func (this *PortStats) SetRxPackets(r uint64) {
 }
 
 func (this PortStats) RxPacketsOffset() int {
-	offset := 20
+	offset := 8
 	return offset
 }
 
@@ -9874,7 +10052,7 @@ func (this *PortStats) SetTxPackets(t uint64) {
 }
 
 func (this PortStats) TxPacketsOffset() int {
-	offset := 28
+	offset := 16
 	return offset
 }
 
@@ -9891,7 +10069,7 @@ func (this *PortStats) SetRxBytes(r uint64) {
 }
 
 func (this PortStats) RxBytesOffset() int {
-	offset := 36
+	offset := 24
 	return offset
 }
 
@@ -9908,7 +10086,7 @@ func (this *PortStats) SetTxBytes(t uint64) {
 }
 
 func (this PortStats) TxBytesOffset() int {
-	offset := 44
+	offset := 32
 	return offset
 }
 
@@ -9925,7 +10103,7 @@ func (this *PortStats) SetRxDropped(r uint64) {
 }
 
 func (this PortStats) RxDroppedOffset() int {
-	offset := 52
+	offset := 40
 	return offset
 }
 
@@ -9942,7 +10120,7 @@ func (this *PortStats) SetTxDropped(t uint64) {
 }
 
 func (this PortStats) TxDroppedOffset() int {
-	offset := 60
+	offset := 48
 	return offset
 }
 
@@ -9959,7 +10137,7 @@ func (this *PortStats) SetRxErrors(r uint64) {
 }
 
 func (this PortStats) RxErrorsOffset() int {
-	offset := 68
+	offset := 56
 	return offset
 }
 
@@ -9976,7 +10154,7 @@ func (this *PortStats) SetTxErrors(t uint64) {
 }
 
 func (this PortStats) TxErrorsOffset() int {
-	offset := 76
+	offset := 64
 	return offset
 }
 
@@ -9993,7 +10171,7 @@ func (this *PortStats) SetRxFrameErr(r uint64) {
 }
 
 func (this PortStats) RxFrameErrOffset() int {
-	offset := 84
+	offset := 72
 	return offset
 }
 
@@ -10010,7 +10188,7 @@ func (this *PortStats) SetRxOverErr(r uint64) {
 }
 
 func (this PortStats) RxOverErrOffset() int {
-	offset := 92
+	offset := 80
 	return offset
 }
 
@@ -10027,7 +10205,7 @@ func (this *PortStats) SetRxCrcErr(r uint64) {
 }
 
 func (this PortStats) RxCrcErrOffset() int {
-	offset := 100
+	offset := 88
 	return offset
 }
 
@@ -10044,56 +10222,56 @@ func (this *PortStats) SetCollisions(c uint64) {
 }
 
 func (this PortStats) CollisionsOffset() int {
-	offset := 108
+	offset := 96
 	return offset
 }
 
-func NewVendorHeaderWithBuf(b []byte) VendorHeader {
-	return VendorHeader{Header10{of.Header{packet.Packet{Buf: b}}}}
+func NewPortStatsReplyWithBuf(b []byte) PortStatsReply {
+	return PortStatsReply{StatsReply{Header10{of.Header{packet.Packet{Buf: b}}}}}
 }
 
-func NewVendorHeader() VendorHeader {
+func NewPortStatsReply() PortStatsReply {
 	s := 12
 	b := make([]byte, s)
-	p := VendorHeader{Header10{of.Header{packet.Packet{Buf: b}}}}
+	p := PortStatsReply{StatsReply{Header10{of.Header{packet.Packet{Buf: b}}}}}
 	p.Init()
 	return p
 }
 
-type VendorHeader struct {
-	Header10
+type PortStatsReply struct {
+	StatsReply
 }
 
-func (this VendorHeader) minSize() int {
+func (this PortStatsReply) minSize() int {
 	return 12
 }
 
-func (this VendorHeader) Clone() (VendorHeader, error) {
+func (this PortStatsReply) Clone() (PortStatsReply, error) {
 	var newBuf bytes.Buffer
 	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
 	if err != nil {
-		return NewVendorHeader(), err
+		return NewPortStatsReply(), err
 	}
 
-	return NewVendorHeaderWithBuf(newBuf.Bytes()), nil
+	return NewPortStatsReplyWithBuf(newBuf.Bytes()), nil
 }
 
-type VendorHeaderConn struct {
+type PortStatsReplyConn struct {
 	net.Conn
 	w      *bufio.Writer
 	buf    []byte
 	offset int
 }
 
-func NewVendorHeaderConn(c net.Conn) VendorHeaderConn {
-	return VendorHeaderConn{
+func NewPortStatsReplyConn(c net.Conn) PortStatsReplyConn {
+	return PortStatsReplyConn{
 		Conn: c,
 		w:    bufio.NewWriter(c),
 		buf:  make([]byte, packet.DefaultBufSize),
 	}
 }
 
-func (c *VendorHeaderConn) WriteVendorHeader(pkt VendorHeader) error {
+func (c *PortStatsReplyConn) WritePortStatsReply(pkt PortStatsReply) error {
 	s := pkt.Size()
 	b := pkt.Buffer()[:s]
 	n := 0
@@ -10108,30 +10286,30 @@ func (c *VendorHeaderConn) WriteVendorHeader(pkt VendorHeader) error {
 	return nil
 }
 
-func (c *VendorHeaderConn) WriteVendorHeaders(pkts []VendorHeader) error {
+func (c *PortStatsReplyConn) WritePortStatsReplys(pkts []PortStatsReply) error {
 	for _, p := range pkts {
-		if err := c.WriteVendorHeader(p); err != nil {
+		if err := c.WritePortStatsReply(p); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (c *VendorHeaderConn) Flush() error {
+func (c *PortStatsReplyConn) Flush() error {
 	return c.w.Flush()
 }
 
-func (c *VendorHeaderConn) ReadVendorHeader() (VendorHeader, error) {
-	pkts := make([]VendorHeader, 1)
-	_, err := c.ReadVendorHeaders(pkts)
+func (c *PortStatsReplyConn) ReadPortStatsReply() (PortStatsReply, error) {
+	pkts := make([]PortStatsReply, 1)
+	_, err := c.ReadPortStatsReplys(pkts)
 	if err != nil {
-		return NewVendorHeader(), err
+		return NewPortStatsReply(), err
 	}
 
 	return pkts[0], nil
 }
 
-func (c *VendorHeaderConn) ReadVendorHeaders(pkts []VendorHeader) (int, error) {
+func (c *PortStatsReplyConn) ReadPortStatsReplys(pkts []PortStatsReply) (int, error) {
 	if len(c.buf) == c.offset {
 		newSize := packet.DefaultBufSize
 		if newSize < len(c.buf) {
@@ -10153,7 +10331,7 @@ func (c *VendorHeaderConn) ReadVendorHeaders(pkts []VendorHeader) (int, error) {
 	s := 0
 	n := 0
 	for i := range pkts {
-		p := NewVendorHeaderWithBuf(c.buf[s:])
+		p := NewPortStatsReplyWithBuf(c.buf[s:])
 
 		pSize := p.Size()
 		if pSize == 0 || r < s+pSize {
@@ -10174,15 +10352,16 @@ func (c *VendorHeaderConn) ReadVendorHeaders(pkts []VendorHeader) (int, error) {
 	return n, nil
 }
 
-func (this *VendorHeader) Init() {
-	this.Header10.Init()
+func (this *PortStatsReply) Init() {
+	this.StatsReply.Init()
 	this.SetLength(uint16(this.minSize()))
 	// Invariants.
-	this.SetType(uint8(4))    // type
-	this.SetVersion(uint8(1)) // version
+	this.SetStatsType(uint16(4)) // stats_type
+	this.SetType(uint8(17))      // type
+	this.SetVersion(uint8(1))    // version
 }
 
-func (this VendorHeader) Size() int {
+func (this PortStatsReply) Size() int {
 	if len(this.Buf) < this.minSize() {
 		return 0
 	}
@@ -10191,33 +10370,229 @@ func (this VendorHeader) Size() int {
 	return size
 }
 
-func ToVendorHeader(p Header10) (VendorHeader, error) {
-	if !IsVendorHeader(p) {
-		return NewVendorHeaderWithBuf(nil), errors.New("Cannot convert to of10.VendorHeader")
+func ToPortStatsReply(p StatsReply) (PortStatsReply, error) {
+	if !IsPortStatsReply(p) {
+		return NewPortStatsReplyWithBuf(nil), errors.New("Cannot convert to of10.PortStatsReply")
 	}
 
-	return NewVendorHeaderWithBuf(p.Buf), nil
+	return NewPortStatsReplyWithBuf(p.Buf), nil
 }
 
-func IsVendorHeader(p Header10) bool {
-	return p.Type() == 4 && true
+func IsPortStatsReply(p StatsReply) bool {
+	return p.StatsType() == 4 && true
 }
 
-func (this VendorHeader) Vendor() uint32 {
-	offset := this.VendorOffset()
-	res := binary.BigEndian.Uint32(this.Buf[offset:])
+func (this PortStatsReply) PortStats() []PortStats {
+	offset := this.PortStatsOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := this.Size() - offset
+	var res []PortStats
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := NewPortStatsWithBuf(this.Buf[offset:])
+		if elem.Size() > size {
+			break
+		}
+		size -= elem.Size()
+		offset += elem.Size()
+		count--
+		res = append(res, elem)
+	}
 	return res
 }
 
-func (this *VendorHeader) SetVendor(v uint32) {
-	offset := this.VendorOffset()
-	binary.BigEndian.PutUint32(this.Buf[offset:], v)
-	offset += 4
-}
-
-func (this VendorHeader) VendorOffset() int {
-	offset := 8
-	return offset
+func (this *PortStatsReply) AddPortStats(p PortStats) {
+	offset := this.PortStatsOffset()
+	offset += this.PortStatsSize()
+	size := p.Size()
+	pSize := this.Size()
+	this.OpenGap(offset, size, pSize)
+	this.SetLength(uint16(pSize + size))
+	copy(this.Buf[offset:], p.Buf[:size])
+	offset += size
+}
+
+func (this PortStatsReply) PortStatsOffset() int {
+	offset := 12
+	return offset
+}
+
+func (this PortStatsReply) PortStatsSize() int {
+	offset := this.PortStatsOffset()
+	size := this.Size()
+	return size - offset
+}
+
+func NewVendorHeaderWithBuf(b []byte) VendorHeader {
+	return VendorHeader{Header10{of.Header{packet.Packet{Buf: b}}}}
+}
+
+func NewVendorHeader() VendorHeader {
+	s := 12
+	b := make([]byte, s)
+	p := VendorHeader{Header10{of.Header{packet.Packet{Buf: b}}}}
+	p.Init()
+	return p
+}
+
+type VendorHeader struct {
+	Header10
+}
+
+func (this VendorHeader) minSize() int {
+	return 12
+}
+
+func (this VendorHeader) Clone() (VendorHeader, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewVendorHeader(), err
+	}
+
+	return NewVendorHeaderWithBuf(newBuf.Bytes()), nil
+}
+
+type VendorHeaderConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewVendorHeaderConn(c net.Conn) VendorHeaderConn {
+	return VendorHeaderConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *VendorHeaderConn) WriteVendorHeader(pkt VendorHeader) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *VendorHeaderConn) WriteVendorHeaders(pkts []VendorHeader) error {
+	for _, p := range pkts {
+		if err := c.WriteVendorHeader(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *VendorHeaderConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *VendorHeaderConn) ReadVendorHeader() (VendorHeader, error) {
+	pkts := make([]VendorHeader, 1)
+	_, err := c.ReadVendorHeaders(pkts)
+	if err != nil {
+		return NewVendorHeader(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *VendorHeaderConn) ReadVendorHeaders(pkts []VendorHeader) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewVendorHeaderWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *VendorHeader) Init() {
+	this.Header10.Init()
+	this.SetLength(uint16(this.minSize()))
+	// Invariants.
+	this.SetType(uint8(4))    // type
+	this.SetVersion(uint8(1)) // version
+}
+
+func (this VendorHeader) Size() int {
+	if len(this.Buf) < this.minSize() {
+		return 0
+	}
+
+	size := int(this.Length())
+	return size
+}
+
+func ToVendorHeader(p Header10) (VendorHeader, error) {
+	if !IsVendorHeader(p) {
+		return NewVendorHeaderWithBuf(nil), errors.New("Cannot convert to of10.VendorHeader")
+	}
+
+	return NewVendorHeaderWithBuf(p.Buf), nil
+}
+
+func IsVendorHeader(p Header10) bool {
+	return p.Type() == 4 && true
+}
+
+func (this VendorHeader) Vendor() uint32 {
+	offset := this.VendorOffset()
+	res := binary.BigEndian.Uint32(this.Buf[offset:])
+	return res
+}
+
+func (this *VendorHeader) SetVendor(v uint32) {
+	offset := this.VendorOffset()
+	binary.BigEndian.PutUint32(this.Buf[offset:], v)
+	offset += 4
+}
+
+func (this VendorHeader) VendorOffset() int {
+	offset := 8
+	return offset
 }
 
 func NewQueuePropHeaderWithBuf(b []byte) QueuePropHeader {
@@ -11354,6 +11729,316 @@ func (this QueueGetConfigReply) QueuesSize() int {
 	return size - offset
 }
 
+func NewBarrierRequestWithBuf(b []byte) BarrierRequest {
+	return BarrierRequest{Header10{of.Header{packet.Packet{Buf: b}}}}
+}
+
+func NewBarrierRequest() BarrierRequest {
+	s := 8
+	b := make([]byte, s)
+	p := BarrierRequest{Header10{of.Header{packet.Packet{Buf: b}}}}
+	p.Init()
+	return p
+}
+
+type BarrierRequest struct {
+	Header10
+}
+
+func (this BarrierRequest) minSize() int {
+	return 8
+}
+
+func (this BarrierRequest) Clone() (BarrierRequest, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewBarrierRequest(), err
+	}
+
+	return NewBarrierRequestWithBuf(newBuf.Bytes()), nil
+}
+
+type BarrierRequestConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewBarrierRequestConn(c net.Conn) BarrierRequestConn {
+	return BarrierRequestConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *BarrierRequestConn) WriteBarrierRequest(pkt BarrierRequest) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *BarrierRequestConn) WriteBarrierRequests(pkts []BarrierRequest) error {
+	for _, p := range pkts {
+		if err := c.WriteBarrierRequest(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *BarrierRequestConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *BarrierRequestConn) ReadBarrierRequest() (BarrierRequest, error) {
+	pkts := make([]BarrierRequest, 1)
+	_, err := c.ReadBarrierRequests(pkts)
+	if err != nil {
+		return NewBarrierRequest(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *BarrierRequestConn) ReadBarrierRequests(pkts []BarrierRequest) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewBarrierRequestWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *BarrierRequest) Init() {
+	this.Header10.Init()
+	this.SetLength(uint16(this.minSize()))
+	// Invariants.
+	this.SetType(uint8(18))   // type
+	this.SetVersion(uint8(1)) // version
+}
+
+func (this BarrierRequest) Size() int {
+	if len(this.Buf) < this.minSize() {
+		return 0
+	}
+
+	size := int(this.Length())
+	return size
+}
+
+func ToBarrierRequest(p Header10) (BarrierRequest, error) {
+	if !IsBarrierRequest(p) {
+		return NewBarrierRequestWithBuf(nil), errors.New("Cannot convert to of10.BarrierRequest")
+	}
+
+	return NewBarrierRequestWithBuf(p.Buf), nil
+}
+
+func IsBarrierRequest(p Header10) bool {
+	return p.Type() == 18 && true
+}
+
+func NewBarrierReplyWithBuf(b []byte) BarrierReply {
+	return BarrierReply{Header10{of.Header{packet.Packet{Buf: b}}}}
+}
+
+func NewBarrierReply() BarrierReply {
+	s := 8
+	b := make([]byte, s)
+	p := BarrierReply{Header10{of.Header{packet.Packet{Buf: b}}}}
+	p.Init()
+	return p
+}
+
+type BarrierReply struct {
+	Header10
+}
+
+func (this BarrierReply) minSize() int {
+	return 8
+}
+
+func (this BarrierReply) Clone() (BarrierReply, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewBarrierReply(), err
+	}
+
+	return NewBarrierReplyWithBuf(newBuf.Bytes()), nil
+}
+
+type BarrierReplyConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewBarrierReplyConn(c net.Conn) BarrierReplyConn {
+	return BarrierReplyConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *BarrierReplyConn) WriteBarrierReply(pkt BarrierReply) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *BarrierReplyConn) WriteBarrierReplys(pkts []BarrierReply) error {
+	for _, p := range pkts {
+		if err := c.WriteBarrierReply(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *BarrierReplyConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *BarrierReplyConn) ReadBarrierReply() (BarrierReply, error) {
+	pkts := make([]BarrierReply, 1)
+	_, err := c.ReadBarrierReplys(pkts)
+	if err != nil {
+		return NewBarrierReply(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *BarrierReplyConn) ReadBarrierReplys(pkts []BarrierReply) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewBarrierReplyWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *BarrierReply) Init() {
+	this.Header10.Init()
+	this.SetLength(uint16(this.minSize()))
+	// Invariants.
+	this.SetType(uint8(19))   // type
+	this.SetVersion(uint8(1)) // version
+}
+
+func (this BarrierReply) Size() int {
+	if len(this.Buf) < this.minSize() {
+		return 0
+	}
+
+	size := int(this.Length())
+	return size
+}
+
+func ToBarrierReply(p Header10) (BarrierReply, error) {
+	if !IsBarrierReply(p) {
+		return NewBarrierReplyWithBuf(nil), errors.New("Cannot convert to of10.BarrierReply")
+	}
+
+	return NewBarrierReplyWithBuf(p.Buf), nil
+}
+
+func IsBarrierReply(p Header10) bool {
+	return p.Type() == 19 && true
+}
+
 func NewActionEnqueueWithBuf(b []byte) ActionEnqueue {
 	return ActionEnqueue{Action{packet.Packet{Buf: b}}}
 }
@@ -147,6 +147,13 @@ const (
 	PAT_EXPERIMENTER ActionType = 65535
 )
 
+type ControllerMaxLen int
+
+const (
+	PCML_MAX       ControllerMaxLen = 65509
+	PCML_NO_BUFFER ControllerMaxLen = 65535
+)
+
 type InstructionType int
 
 const (
@@ -210,48 +217,59 @@ const (
 type OXMatchFields int
 
 const (
-	PXMT_IN_PORT         OXMatchFields = 0
-	PXMT_IN_PHY_PORT     OXMatchFields = 2
-	PXMT_METADATA        OXMatchFields = 4
-	PXMT_ETH_DST         OXMatchFields = 6
-	PXMT_ETH_DST_MASKED  OXMatchFields = 7
-	PXMT_ETH_SRC         OXMatchFields = 8
-	PXMT_ETH_SRC_MASKED  OXMatchFields = 9
-	PXMT_ETH_TYPE        OXMatchFields = 10
-	PXMT_VLAN_VID        OXMatchFields = 12
-	PXMT_VLAN_PCP        OXMatchFields = 14
-	PXMT_IP_DSCP         OXMatchFields = 16
-	PXMT_IP_ECN          OXMatchFields = 18
-	PXMT_IP_PROTO        OXMatchFields = 20
-	PXMT_IPV4_SRC        OXMatchFields = 22
-	PXMT_IPV4_SRC_MASKED OXMatchFields = 23
-	PXMT_IPV4_DST        OXMatchFields = 24
-	PXMT_IPV4_DST_MASKED OXMatchFields = 25
-	PXMT_TCP_SRC         OXMatchFields = 26
-	PXMT_TCP_DST         OXMatchFields = 28
-	PXMT_UDP_SRC         OXMatchFields = 30
-	PXMT_UDP_DST         OXMatchFields = 32
-	PXMT_SCTP_SRC        OXMatchFields = 34
-	PXMT_SCTP_DST        OXMatchFields = 36
-	PXMT_ICMPV4_TYPE     OXMatchFields = 38
-	PXMT_ICMPV4_CODE     OXMatchFields = 40
-	PXMT_ARP_OP          OXMatchFields = 42
-	PXMT_ARP_SPA         OXMatchFields = 44
-	PXMT_ARP_TPA         OXMatchFields = 46
-	PXMT_ARP_SHA         OXMatchFields = 48
-	PXMT_ARP_THA         OXMatchFields = 50
-	PXMT_IPV6_SRC        OXMatchFields = 52
-	PXMT_IPV6_SRC_MASKED OXMatchFields = 53
-	PXMT_IPV6_DST        OXMatchFields = 54
-	PXMT_IPV6_DST_MASKED OXMatchFields = 55
-	PXMT_IPV6_FLABEL     OXMatchFields = 56
-	PXMT_ICMPV6_TYPE     OXMatchFields = 58
-	PXMT_ICMPV6_CODE     OXMatchFields = 60
-	PXMT_IPV6_ND_TARGET  OXMatchFields = 62
-	PXMT_IPV6_ND_SLL     OXMatchFields = 64
-	PXMT_IPV6_ND_TLL     OXMatchFields = 66
-	PXMT_MPLS_LABEL      OXMatchFields = 68
-	PXMT_MPLS_TC         OXMatchFields = 70
+	PXMT_IN_PORT            OXMatchFields = 0
+	PXMT_IN_PHY_PORT        OXMatchFields = 2
+	PXMT_METADATA           OXMatchFields = 4
+	PXMT_METADATA_MASKED    OXMatchFields = 5
+	PXMT_ETH_DST            OXMatchFields = 6
+	PXMT_ETH_DST_MASKED     OXMatchFields = 7
+	PXMT_ETH_SRC            OXMatchFields = 8
+	PXMT_ETH_SRC_MASKED     OXMatchFields = 9
+	PXMT_ETH_TYPE           OXMatchFields = 10
+	PXMT_VLAN_VID           OXMatchFields = 12
+	PXMT_VLAN_PCP           OXMatchFields = 14
+	PXMT_IP_DSCP            OXMatchFields = 16
+	PXMT_IP_ECN             OXMatchFields = 18
+	PXMT_IP_PROTO           OXMatchFields = 20
+	PXMT_IPV4_SRC           OXMatchFields = 22
+	PXMT_IPV4_SRC_MASKED    OXMatchFields = 23
+	PXMT_IPV4_DST           OXMatchFields = 24
+	PXMT_IPV4_DST_MASKED    OXMatchFields = 25
+	PXMT_TCP_SRC            OXMatchFields = 26
+	PXMT_TCP_DST            OXMatchFields = 28
+	PXMT_UDP_SRC            OXMatchFields = 30
+	PXMT_UDP_DST            OXMatchFields = 32
+	PXMT_SCTP_SRC           OXMatchFields = 34
+	PXMT_SCTP_DST           OXMatchFields = 36
+	PXMT_ICMPV4_TYPE        OXMatchFields = 38
+	PXMT_ICMPV4_CODE        OXMatchFields = 40
+	PXMT_ARP_OP             OXMatchFields = 42
+	PXMT_ARP_SPA            OXMatchFields = 44
+	PXMT_ARP_SPA_MASKED     OXMatchFields = 45
+	PXMT_ARP_TPA            OXMatchFields = 46
+	PXMT_ARP_TPA_MASKED     OXMatchFields = 47
+	PXMT_ARP_SHA            OXMatchFields = 48
+	PXMT_ARP_THA            OXMatchFields = 50
+	PXMT_IPV6_SRC           OXMatchFields = 52
+	PXMT_IPV6_SRC_MASKED    OXMatchFields = 53
+	PXMT_IPV6_DST           OXMatchFields = 54
+	PXMT_IPV6_DST_MASKED    OXMatchFields = 55
+	PXMT_IPV6_FLABEL        OXMatchFields = 56
+	PXMT_IPV6_FLABEL_MASKED OXMatchFields = 57
+	PXMT_ICMPV6_TYPE        OXMatchFields = 58
+	PXMT_ICMPV6_CODE        OXMatchFields = 60
+	PXMT_IPV6_ND_TARGET     OXMatchFields = 62
+	PXMT_IPV6_ND_SLL        OXMatchFields = 64
+	PXMT_IPV6_ND_TLL        OXMatchFields = 66
+	PXMT_MPLS_LABEL         OXMatchFields = 68
+	PXMT_MPLS_TC            OXMatchFields = 70
+)
+
+type VlanId int
+
+const (
+	PVID_PRESENT VlanId = 4096
+	PVID_NONE    VlanId = 0
 )
 
 type MatchType int
@@ -282,17 +300,25 @@ const (
 	PRR_IDLE_TIMEOUT FlowRemovedReason = 0
 	PRR_HARD_TIMEOUT FlowRemovedReason = 1
 	PRR_DELETE       FlowRemovedReason = 2
+	PRR_GROUP_DELETE FlowRemovedReason = 3
 )
 
 type ErrorType int
 
 const (
-	PET_HELLO_FAILED    ErrorType = 0
-	PET_BAD_REQUEST     ErrorType = 1
-	PET_BAD_ACTION      ErrorType = 2
-	PET_FLOW_MOD_FAILED ErrorType = 3
-	PET_PORT_MOD_FAILED ErrorType = 4
-	PET_QUEUE_OP_FAILED ErrorType = 5
+	PET_HELLO_FAILED         ErrorType = 0
+	PET_BAD_REQUEST          ErrorType = 1
+	PET_BAD_ACTION           ErrorType = 2
+	PET_BAD_INSTRUCTION      ErrorType = 3
+	PET_BAD_MATCH            ErrorType = 4
+	PET_FLOW_MOD_FAILED      ErrorType = 5
+	PET_GROUP_MOD_FAILED     ErrorType = 6
+	PET_PORT_MOD_FAILED      ErrorType = 7
+	PET_TABLE_MOD_FAILED     ErrorType = 8
+	PET_QUEUE_OP_FAILED      ErrorType = 9
+	PET_SWITCH_CONFIG_FAILED ErrorType = 10
+	PET_ROLE_REQUEST_FAILED  ErrorType = 11
+	PET_EXPERIMENTER         ErrorType = 65535
 )
 
 type HelloFailedCode int
@@ -305,47 +331,80 @@ const (
 type BadRequestCode int
 
 const (
-	PBRC_BAD_VERSION    BadRequestCode = 0
-	PBRC_BAD_TYPE       BadRequestCode = 1
-	PBRC_BAD_STAT       BadRequestCode = 2
-	PBRC_BAD_VENDOR     BadRequestCode = 3
-	PBRC_BAD_SUBTYPE    BadRequestCode = 4
-	PBRC_EPERM          BadRequestCode = 5
-	PBRC_BAD_LEN        BadRequestCode = 6
-	PBRC_BUFFER_EMPTY   BadRequestCode = 7
-	PBRC_BUFFER_UNKNOWN BadRequestCode = 8
+	PBRC_BAD_VERSION      BadRequestCode = 0
+	PBRC_BAD_TYPE         BadRequestCode = 1
+	PBRC_BAD_STAT         BadRequestCode = 2
+	PBRC_BAD_EXPERIMENTER BadRequestCode = 3
+	PBRC_BAD_EXP_TYPE     BadRequestCode = 4
+	PBRC_EPERM            BadRequestCode = 5
+	PBRC_BAD_LEN          BadRequestCode = 6
+	PBRC_BUFFER_EMPTY     BadRequestCode = 7
+	PBRC_BUFFER_UNKNOWN   BadRequestCode = 8
+	PBRC_BAD_TABLE_ID     BadRequestCode = 9
+	PBRC_IS_SLAVE         BadRequestCode = 10
+	PBRC_BAD_PORT         BadRequestCode = 11
+	PBRC_BAD_PACKET       BadRequestCode = 12
 )
 
 type BadActionCode int
 
 const (
-	PBAC_BAD_TYPE        BadActionCode = 0
-	PBAC_BAD_LEN         BadActionCode = 1
-	PBAC_BAD_VENDOR      BadActionCode = 2
-	PBAC_BAD_VENDOR_TYPE BadActionCode = 3
-	PBAC_BAD_OUT_PORT    BadActionCode = 4
-	PBAC_BAD_ARGUMENT    BadActionCode = 5
-	PBAC_EPERM           BadActionCode = 6
-	PBAC_TOO_MANY        BadActionCode = 7
-	PBAC_BAD_QUEUE       BadActionCode = 8
+	PBAC_BAD_TYPE           BadActionCode = 0
+	PBAC_BAD_LEN            BadActionCode = 1
+	PBAC_BAD_EXPERIMENTER   BadActionCode = 2
+	PBAC_BAD_EXP_TYPE       BadActionCode = 3
+	PBAC_BAD_OUT_PORT       BadActionCode = 4
+	PBAC_BAD_ARGUMENT       BadActionCode = 5
+	PBAC_EPERM              BadActionCode = 6
+	PBAC_TOO_MANY           BadActionCode = 7
+	PBAC_BAD_QUEUE          BadActionCode = 8
+	PBAC_BAD_OUT_GROUP      BadActionCode = 9
+	PBAC_MATCH_INCONSISTENT BadActionCode = 10
+	PBAC_UNSUPPORTED_ORDER  BadActionCode = 11
+	PBAC_BAD_TAG            BadActionCode = 12
+	PBAC_BAD_SET_TYPE       BadActionCode = 13
+	PBAC_BAD_SET_LEN        BadActionCode = 14
+	PBAC_BAD_SET_ARGUMENT   BadActionCode = 15
 )
 
-type Flow_modFailedCode int
+type BadMatchCode int
 
 const (
-	PFMFC_ALL_TABLES_FULL   Flow_modFailedCode = 0
-	PFMFC_OVERLAP           Flow_modFailedCode = 1
-	PFMFC_EPERM             Flow_modFailedCode = 2
-	PFMFC_BAD_EMERG_TIMEOUT Flow_modFailedCode = 3
-	PFMFC_BAD_COMMAND       Flow_modFailedCode = 4
-	PFMFC_UNSUPPORTED       Flow_modFailedCode = 5
+	PBMC_BAD_TYPE         BadMatchCode = 0
+	PBMC_BAD_LEN          BadMatchCode = 1
+	PBMC_BAD_TAG          BadMatchCode = 2
+	PBMC_BAD_DL_ADDR_MASK BadMatchCode = 3
+	PBMC_BAD_NW_ADDR_MASK BadMatchCode = 4
+	PBMC_BAD_WILDCARDS    BadMatchCode = 5
+	PBMC_BAD_FIELD        BadMatchCode = 6
+	PBMC_BAD_VALUE        BadMatchCode = 7
+	PBMC_BAD_MASK         BadMatchCode = 8
+	PBMC_BAD_PREREQ       BadMatchCode = 9
+	PBMC_DUP_FIELD        BadMatchCode = 10
+	PBMC_EPERM            BadMatchCode = 11
 )
 
-type Port_modFailedCode int
+type FlowModFailedCode int
 
 const (
-	PPMFC_BAD_PORT    Port_modFailedCode = 0
-	PPMFC_BAD_HW_ADDR Port_modFailedCode = 1
+	PFMFC_UNKNOWN      FlowModFailedCode = 0
+	PFMFC_TABLE_FULL   FlowModFailedCode = 1
+	PFMFC_BAD_TABLE_ID FlowModFailedCode = 2
+	PFMFC_OVERLAP      FlowModFailedCode = 3
+	PFMFC_EPERM        FlowModFailedCode = 4
+	PFMFC_BAD_TIMEOUT  FlowModFailedCode = 5
+	PFMFC_BAD_COMMAND  FlowModFailedCode = 6
+	PFMFC_BAD_FLAGS    FlowModFailedCode = 7
+)
+
+type PortModFailedCode int
+
+const (
+	PPMFC_BAD_PORT      PortModFailedCode = 0
+	PPMFC_BAD_HW_ADDR   PortModFailedCode = 1
+	PPMFC_BAD_CONFIG    PortModFailedCode = 2
+	PPMFC_BAD_ADVERTISE PortModFailedCode = 3
+	PPMFC_EPERM         PortModFailedCode = 4
 )
 
 type QueueOpFailedCode int
@@ -356,6 +415,14 @@ const (
 	PQC_EPERM     QueueOpFailedCode = 2
 )
 
+type RoleRequestFailedCode int
+
+const (
+	PRRFC_STALE    RoleRequestFailedCode = 0
+	PRRFC_UNSUP    RoleRequestFailedCode = 1
+	PRRFC_BAD_ROLE RoleRequestFailedCode = 2
+)
+
 type StatsTypes int
 
 const (
@@ -2684,7 +2751,7 @@ func NewPortModWithBuf(b []byte) PortMod {
 }
 
 func NewPortMod() PortMod {
-	s := 32
+	s := 40
 	b := make([]byte, s)
 	p := PortMod{Header12{of.Header{packet.Packet{Buf: b}}}}
 	p.Init()
@@ -2696,7 +2763,7 @@ type PortMod struct {
 }
 
 func (this PortMod) minSize() int {
-	return 32
+	return 40
 }
 
 func (this PortMod) Clone() (PortMod, error) {
@@ -2834,16 +2901,16 @@ func IsPortMod(p Header12) bool {
 	return p.Type() == 16 && true
 }
 
-func (this PortMod) PortNo() uint16 {
+func (this PortMod) PortNo() uint32 {
 	offset := this.PortNoOffset()
-	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	res := binary.BigEndian.Uint32(this.Buf[offset:])
 	return res
 }
 
-func (this *PortMod) SetPortNo(p uint16) {
+func (this *PortMod) SetPortNo(p uint32) {
 	offset := this.PortNoOffset()
-	binary.BigEndian.PutUint16(this.Buf[offset:], p)
-	offset += 2
+	binary.BigEndian.PutUint32(this.Buf[offset:], p)
+	offset += 4
 }
 
 func (this PortMod) PortNoOffset() int {
@@ -2851,6 +2918,40 @@ func (this PortMod) PortNoOffset() int {
 	return offset
 }
 
+func (this PortMod) Pad() [4]uint8 {
+	offset := this.PadOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := 4
+	i := 0
+	var res [4]uint8
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := uint8(this.Buf[offset])
+		if size < 1 {
+			break
+		}
+		size -= 1
+		offset += 1
+		count--
+		res[i] = elem
+		i++
+	}
+	return res
+}
+
+func (this *PortMod) SetPad(p [4]uint8) {
+	offset := this.PadOffset()
+	for _, e := range p {
+		this.Buf[offset] = byte(e)
+		offset++
+	}
+}
+
+func (this PortMod) PadOffset() int {
+	offset := 12
+	return offset
+}
+
 func (this PortMod) HwAddr() [6]uint8 {
 	offset := this.HwAddrOffset()
 	packet_size := this.Size()
@@ -2881,7 +2982,41 @@ func (this *PortMod) SetHwAddr(h [6]uint8) {
 }
 
 func (this PortMod) HwAddrOffset() int {
-	offset := 10
+	offset := 16
+	return offset
+}
+
+func (this PortMod) Pad2() [2]uint8 {
+	offset := this.Pad2Offset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := 2
+	i := 0
+	var res [2]uint8
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := uint8(this.Buf[offset])
+		if size < 1 {
+			break
+		}
+		size -= 1
+		offset += 1
+		count--
+		res[i] = elem
+		i++
+	}
+	return res
+}
+
+func (this *PortMod) SetPad2(p [2]uint8) {
+	offset := this.Pad2Offset()
+	for _, e := range p {
+		this.Buf[offset] = byte(e)
+		offset++
+	}
+}
+
+func (this PortMod) Pad2Offset() int {
+	offset := 22
 	return offset
 }
 
@@ -2898,7 +3033,7 @@ func (this *PortMod) SetConfig(c uint32) {
 }
 
 func (this PortMod) ConfigOffset() int {
-	offset := 16
+	offset := 24
 	return offset
 }
 
@@ -2915,7 +3050,7 @@ func (this *PortMod) SetMask(m uint32) {
 }
 
 func (this PortMod) MaskOffset() int {
-	offset := 20
+	offset := 28
 	return offset
 }
 
@@ -2932,12 +3067,12 @@ func (this *PortMod) SetAdvertise(a uint32) {
 }
 
 func (this PortMod) AdvertiseOffset() int {
-	offset := 24
+	offset := 32
 	return offset
 }
 
-func (this PortMod) Pad() [4]uint8 {
-	offset := this.PadOffset()
+func (this PortMod) Pad3() [4]uint8 {
+	offset := this.Pad3Offset()
 	packet_size := this.Size()
 	size := packet_size - offset
 	count := 4
@@ -2957,16 +3092,16 @@ func (this PortMod) Pad() [4]uint8 {
 	return res
 }
 
-func (this *PortMod) SetPad(p [4]uint8) {
-	offset := this.PadOffset()
+func (this *PortMod) SetPad3(p [4]uint8) {
+	offset := this.Pad3Offset()
 	for _, e := range p {
 		this.Buf[offset] = byte(e)
 		offset++
 	}
 }
 
-func (this PortMod) PadOffset() int {
-	offset := 28
+func (this PortMod) Pad3Offset() int {
+	offset := 36
 	return offset
 }
 
@@ -3378,52 +3513,52 @@ func (this ActionOutput) PadOffset() int {
 	return offset
 }
 
-func NewInstructionWithBuf(b []byte) Instruction {
-	return Instruction{packet.Packet{Buf: b}}
+func NewActionPushVlanWithBuf(b []byte) ActionPushVlan {
+	return ActionPushVlan{Action{packet.Packet{Buf: b}}}
 }
 
-func NewInstruction() Instruction {
-	s := 4
+func NewActionPushVlan() ActionPushVlan {
+	s := 8
 	b := make([]byte, s)
-	p := Instruction{packet.Packet{Buf: b}}
+	p := ActionPushVlan{Action{packet.Packet{Buf: b}}}
 	p.Init()
 	return p
 }
 
-type Instruction struct {
-	packet.Packet
+type ActionPushVlan struct {
+	Action
 }
 
-func (this Instruction) minSize() int {
-	return 4
+func (this ActionPushVlan) minSize() int {
+	return 8
 }
 
-func (this Instruction) Clone() (Instruction, error) {
+func (this ActionPushVlan) Clone() (ActionPushVlan, error) {
 	var newBuf bytes.Buffer
 	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
 	if err != nil {
-		return NewInstruction(), err
+		return NewActionPushVlan(), err
 	}
 
-	return NewInstructionWithBuf(newBuf.Bytes()), nil
+	return NewActionPushVlanWithBuf(newBuf.Bytes()), nil
 }
 
-type InstructionConn struct {
+type ActionPushVlanConn struct {
 	net.Conn
 	w      *bufio.Writer
 	buf    []byte
 	offset int
 }
 
-func NewInstructionConn(c net.Conn) InstructionConn {
-	return InstructionConn{
+func NewActionPushVlanConn(c net.Conn) ActionPushVlanConn {
+	return ActionPushVlanConn{
 		Conn: c,
 		w:    bufio.NewWriter(c),
 		buf:  make([]byte, packet.DefaultBufSize),
 	}
 }
 
-func (c *InstructionConn) WriteInstruction(pkt Instruction) error {
+func (c *ActionPushVlanConn) WriteActionPushVlan(pkt ActionPushVlan) error {
 	s := pkt.Size()
 	b := pkt.Buffer()[:s]
 	n := 0
@@ -3438,30 +3573,30 @@ func (c *InstructionConn) WriteInstruction(pkt Instruction) error {
 	return nil
 }
 
-func (c *InstructionConn) WriteInstructions(pkts []Instruction) error {
+func (c *ActionPushVlanConn) WriteActionPushVlans(pkts []ActionPushVlan) error {
 	for _, p := range pkts {
-		if err := c.WriteInstruction(p); err != nil {
+		if err := c.WriteActionPushVlan(p); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (c *InstructionConn) Flush() error {
+func (c *ActionPushVlanConn) Flush() error {
 	return c.w.Flush()
 }
 
-func (c *InstructionConn) ReadInstruction() (Instruction, error) {
-	pkts := make([]Instruction, 1)
-	_, err := c.ReadInstructions(pkts)
+func (c *ActionPushVlanConn) ReadActionPushVlan() (ActionPushVlan, error) {
+	pkts := make([]ActionPushVlan, 1)
+	_, err := c.ReadActionPushVlans(pkts)
 	if err != nil {
-		return NewInstruction(), err
+		return NewActionPushVlan(), err
 	}
 
 	return pkts[0], nil
 }
 
-func (c *InstructionConn) ReadInstructions(pkts []Instruction) (int, error) {
+func (c *ActionPushVlanConn) ReadActionPushVlans(pkts []ActionPushVlan) (int, error) {
 	if len(c.buf) == c.offset {
 		newSize := packet.DefaultBufSize
 		if newSize < len(c.buf) {
@@ -3483,7 +3618,7 @@ func (c *InstructionConn) ReadInstructions(pkts []Instruction) (int, error) {
 	s := 0
 	n := 0
 	for i := range pkts {
-		p := NewInstructionWithBuf(c.buf[s:])
+		p := NewActionPushVlanWithBuf(c.buf[s:])
 
 		pSize := p.Size()
 		if pSize == 0 || r < s+pSize {
@@ -3504,12 +3639,14 @@ func (c *InstructionConn) ReadInstructions(pkts []Instruction) (int, error) {
 	return n, nil
 }
 
-func (this *Instruction) Init() {
+func (this *ActionPushVlan) Init() {
+	this.Action.Init()
 	this.SetLen(uint16(this.minSize()))
 	// Invariants.
+	this.SetType(uint16(17)) // type
 }
 
-func (this Instruction) Size() int {
+func (this ActionPushVlan) Size() int {
 	if len(this.Buf) < this.minSize() {
 		return 0
 	}
@@ -3518,98 +3655,115 @@ func (this Instruction) Size() int {
 	return size
 }
 
-func ToInstruction(p packet.Packet) (Instruction, error) {
-	if !IsInstruction(p) {
-		return NewInstructionWithBuf(nil), errors.New("Cannot convert to of12.Instruction")
+func ToActionPushVlan(p Action) (ActionPushVlan, error) {
+	if !IsActionPushVlan(p) {
+		return NewActionPushVlanWithBuf(nil), errors.New("Cannot convert to of12.ActionPushVlan")
 	}
 
-	return NewInstructionWithBuf(p.Buf), nil
+	return NewActionPushVlanWithBuf(p.Buf), nil
 }
 
-func IsInstruction(p packet.Packet) bool {
-	return true
+func IsActionPushVlan(p Action) bool {
+	return p.Type() == 17 && true
 }
 
-func (this Instruction) Type() uint16 {
-	offset := this.TypeOffset()
+func (this ActionPushVlan) Ethertype() uint16 {
+	offset := this.EthertypeOffset()
 	res := binary.BigEndian.Uint16(this.Buf[offset:])
 	return res
 }
 
-func (this *Instruction) SetType(t uint16) {
-	offset := this.TypeOffset()
-	binary.BigEndian.PutUint16(this.Buf[offset:], t)
+func (this *ActionPushVlan) SetEthertype(e uint16) {
+	offset := this.EthertypeOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], e)
 	offset += 2
 }
 
-func (this Instruction) TypeOffset() int {
-	offset := 0
+func (this ActionPushVlan) EthertypeOffset() int {
+	offset := 4
 	return offset
 }
 
-func (this Instruction) Len() uint16 {
-	offset := this.LenOffset()
-	res := binary.BigEndian.Uint16(this.Buf[offset:])
+func (this ActionPushVlan) Pad() [2]uint8 {
+	offset := this.PadOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := 2
+	i := 0
+	var res [2]uint8
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := uint8(this.Buf[offset])
+		if size < 1 {
+			break
+		}
+		size -= 1
+		offset += 1
+		count--
+		res[i] = elem
+		i++
+	}
 	return res
 }
 
-func (this *Instruction) SetLen(l uint16) {
-	offset := this.LenOffset()
-	binary.BigEndian.PutUint16(this.Buf[offset:], l)
-	offset += 2
+func (this *ActionPushVlan) SetPad(p [2]uint8) {
+	offset := this.PadOffset()
+	for _, e := range p {
+		this.Buf[offset] = byte(e)
+		offset++
+	}
 }
 
-func (this Instruction) LenOffset() int {
-	offset := 2
+func (this ActionPushVlan) PadOffset() int {
+	offset := 6
 	return offset
 }
 
-func NewApplyActionsWithBuf(b []byte) ApplyActions {
-	return ApplyActions{Instruction{packet.Packet{Buf: b}}}
+func NewActionPopVlanWithBuf(b []byte) ActionPopVlan {
+	return ActionPopVlan{Action{packet.Packet{Buf: b}}}
 }
 
-func NewApplyActions() ApplyActions {
+func NewActionPopVlan() ActionPopVlan {
 	s := 8
 	b := make([]byte, s)
-	p := ApplyActions{Instruction{packet.Packet{Buf: b}}}
+	p := ActionPopVlan{Action{packet.Packet{Buf: b}}}
 	p.Init()
 	return p
 }
 
-type ApplyActions struct {
-	Instruction
+type ActionPopVlan struct {
+	Action
 }
 
-func (this ApplyActions) minSize() int {
+func (this ActionPopVlan) minSize() int {
 	return 8
 }
 
-func (this ApplyActions) Clone() (ApplyActions, error) {
+func (this ActionPopVlan) Clone() (ActionPopVlan, error) {
 	var newBuf bytes.Buffer
 	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
 	if err != nil {
-		return NewApplyActions(), err
+		return NewActionPopVlan(), err
 	}
 
-	return NewApplyActionsWithBuf(newBuf.Bytes()), nil
+	return NewActionPopVlanWithBuf(newBuf.Bytes()), nil
 }
 
-type ApplyActionsConn struct {
+type ActionPopVlanConn struct {
 	net.Conn
 	w      *bufio.Writer
 	buf    []byte
 	offset int
 }
 
-func NewApplyActionsConn(c net.Conn) ApplyActionsConn {
-	return ApplyActionsConn{
+func NewActionPopVlanConn(c net.Conn) ActionPopVlanConn {
+	return ActionPopVlanConn{
 		Conn: c,
 		w:    bufio.NewWriter(c),
 		buf:  make([]byte, packet.DefaultBufSize),
 	}
 }
 
-func (c *ApplyActionsConn) WriteApplyActions(pkt ApplyActions) error {
+func (c *ActionPopVlanConn) WriteActionPopVlan(pkt ActionPopVlan) error {
 	s := pkt.Size()
 	b := pkt.Buffer()[:s]
 	n := 0
@@ -3624,30 +3778,30 @@ func (c *ApplyActionsConn) WriteApplyActions(pkt ApplyActions) error {
 	return nil
 }
 
-func (c *ApplyActionsConn) WriteApplyActionss(pkts []ApplyActions) error {
+func (c *ActionPopVlanConn) WriteActionPopVlans(pkts []ActionPopVlan) error {
 	for _, p := range pkts {
-		if err := c.WriteApplyActions(p); err != nil {
+		if err := c.WriteActionPopVlan(p); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (c *ApplyActionsConn) Flush() error {
+func (c *ActionPopVlanConn) Flush() error {
 	return c.w.Flush()
 }
 
-func (c *ApplyActionsConn) ReadApplyActions() (ApplyActions, error) {
-	pkts := make([]ApplyActions, 1)
-	_, err := c.ReadApplyActionss(pkts)
+func (c *ActionPopVlanConn) ReadActionPopVlan() (ActionPopVlan, error) {
+	pkts := make([]ActionPopVlan, 1)
+	_, err := c.ReadActionPopVlans(pkts)
 	if err != nil {
-		return NewApplyActions(), err
+		return NewActionPopVlan(), err
 	}
 
 	return pkts[0], nil
 }
 
-func (c *ApplyActionsConn) ReadApplyActionss(pkts []ApplyActions) (int, error) {
+func (c *ActionPopVlanConn) ReadActionPopVlans(pkts []ActionPopVlan) (int, error) {
 	if len(c.buf) == c.offset {
 		newSize := packet.DefaultBufSize
 		if newSize < len(c.buf) {
@@ -3669,7 +3823,7 @@ func (c *ApplyActionsConn) ReadApplyActionss(pkts []ApplyActions) (int, error) {
 	s := 0
 	n := 0
 	for i := range pkts {
-		p := NewApplyActionsWithBuf(c.buf[s:])
+		p := NewActionPopVlanWithBuf(c.buf[s:])
 
 		pSize := p.Size()
 		if pSize == 0 || r < s+pSize {
@@ -3690,14 +3844,14 @@ func (c *ApplyActionsConn) ReadApplyActionss(pkts []ApplyActions) (int, error) {
 	return n, nil
 }
 
-func (this *ApplyActions) Init() {
-	this.Instruction.Init()
+func (this *ActionPopVlan) Init() {
+	this.Action.Init()
 	this.SetLen(uint16(this.minSize()))
 	// Invariants.
-	this.SetType(uint16(4)) // type
+	this.SetType(uint16(18)) // type
 }
 
-func (this ApplyActions) Size() int {
+func (this ActionPopVlan) Size() int {
 	if len(this.Buf) < this.minSize() {
 		return 0
 	}
@@ -3706,19 +3860,19 @@ func (this ApplyActions) Size() int {
 	return size
 }
 
-func ToApplyActions(p Instruction) (ApplyActions, error) {
-	if !IsApplyActions(p) {
-		return NewApplyActionsWithBuf(nil), errors.New("Cannot convert to of12.ApplyActions")
+func ToActionPopVlan(p Action) (ActionPopVlan, error) {
+	if !IsActionPopVlan(p) {
+		return NewActionPopVlanWithBuf(nil), errors.New("Cannot convert to of12.ActionPopVlan")
 	}
 
-	return NewApplyActionsWithBuf(p.Buf), nil
+	return NewActionPopVlanWithBuf(p.Buf), nil
 }
 
-func IsApplyActions(p Instruction) bool {
-	return p.Type() == 4 && true
+func IsActionPopVlan(p Action) bool {
+	return p.Type() == 18 && true
 }
 
-func (this ApplyActions) Pad() [4]uint8 {
+func (this ActionPopVlan) Pad() [4]uint8 {
 	offset := this.PadOffset()
 	packet_size := this.Size()
 	size := packet_size - offset
@@ -3739,7 +3893,7 @@ func (this ApplyActions) Pad() [4]uint8 {
 	return res
 }
 
-func (this *ApplyActions) SetPad(p [4]uint8) {
+func (this *ActionPopVlan) SetPad(p [4]uint8) {
 	offset := this.PadOffset()
 	for _, e := range p {
 		this.Buf[offset] = byte(e)
@@ -3747,98 +3901,57 @@ func (this *ApplyActions) SetPad(p [4]uint8) {
 	}
 }
 
-func (this ApplyActions) PadOffset() int {
+func (this ActionPopVlan) PadOffset() int {
 	offset := 4
 	return offset
 }
 
-func (this ApplyActions) Actions() []Action {
-	offset := this.ActionsOffset()
-	packet_size := this.Size()
-	size := packet_size - offset
-	count := this.Size() - offset
-	var res []Action
-	for size > 0 && count > 0 && packet_size > offset {
-		elem := NewActionWithBuf(this.Buf[offset:])
-		if elem.Size() > size {
-			break
-		}
-		size -= elem.Size()
-		offset += elem.Size()
-		count--
-		res = append(res, elem)
-	}
-	return res
+func NewActionSetFieldWithBuf(b []byte) ActionSetField {
+	return ActionSetField{Action{packet.Packet{Buf: b}}}
 }
 
-func (this *ApplyActions) AddActions(a Action) {
-	offset := this.ActionsOffset()
-	offset += this.ActionsSize()
-	size := a.Size()
-	pSize := this.Size()
-	this.OpenGap(offset, size, pSize)
-	this.SetLen(uint16(pSize + size))
-	copy(this.Buf[offset:], a.Buf[:size])
-	offset += size
+func NewActionSetField() ActionSetField {
+	s := 4
+	b := make([]byte, s)
+	p := ActionSetField{Action{packet.Packet{Buf: b}}}
+	p.Init()
+	return p
 }
 
-func (this ApplyActions) ActionsOffset() int {
-	offset := 8
-	return offset
+type ActionSetField struct {
+	Action
 }
 
-func (this ApplyActions) ActionsSize() int {
-	offset := this.ActionsOffset()
-	size := this.Size()
-	return size - offset
-}
-
-func NewPacketOutWithBuf(b []byte) PacketOut {
-	return PacketOut{Header12{of.Header{packet.Packet{Buf: b}}}}
-}
-
-func NewPacketOut() PacketOut {
-	s := 24
-	b := make([]byte, s)
-	p := PacketOut{Header12{of.Header{packet.Packet{Buf: b}}}}
-	p.Init()
-	return p
-}
-
-type PacketOut struct {
-	Header12
-}
-
-func (this PacketOut) minSize() int {
-	return 24
+func (this ActionSetField) minSize() int {
+	return 4
 }
 
-func (this PacketOut) Clone() (PacketOut, error) {
+func (this ActionSetField) Clone() (ActionSetField, error) {
 	var newBuf bytes.Buffer
 	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
 	if err != nil {
-		return NewPacketOut(), err
+		return NewActionSetField(), err
 	}
 
-	return NewPacketOutWithBuf(newBuf.Bytes()), nil
+	return NewActionSetFieldWithBuf(newBuf.Bytes()), nil
 }
 
-type PacketOutConn struct {
+type ActionSetFieldConn struct {
 	net.Conn
 	w      *bufio.Writer
 	buf    []byte
 	offset int
 }
 
-func NewPacketOutConn(c net.Conn) PacketOutConn {
-	return PacketOutConn{
+func NewActionSetFieldConn(c net.Conn) ActionSetFieldConn {
+	return ActionSetFieldConn{
 		Conn: c,
 		w:    bufio.NewWriter(c),
 		buf:  make([]byte, packet.DefaultBufSize),
 	}
 }
 
-func (c *PacketOutConn) WritePacketOut(pkt PacketOut) error {
+func (c *ActionSetFieldConn) WriteActionSetField(pkt ActionSetField) error {
 	s := pkt.Size()
 	b := pkt.Buffer()[:s]
 	n := 0
@@ -3853,30 +3966,30 @@ func (c *PacketOutConn) WritePacketOut(pkt PacketOut) error {
 	return nil
 }
 
-func (c *PacketOutConn) WritePacketOuts(pkts []PacketOut) error {
+func (c *ActionSetFieldConn) WriteActionSetFields(pkts []ActionSetField) error {
 	for _, p := range pkts {
-		if err := c.WritePacketOut(p); err != nil {
+		if err := c.WriteActionSetField(p); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (c *PacketOutConn) Flush() error {
+func (c *ActionSetFieldConn) Flush() error {
 	return c.w.Flush()
 }
 
-func (c *PacketOutConn) ReadPacketOut() (PacketOut, error) {
-	pkts := make([]PacketOut, 1)
-	_, err := c.ReadPacketOuts(pkts)
+func (c *ActionSetFieldConn) ReadActionSetField() (ActionSetField, error) {
+	pkts := make([]ActionSetField, 1)
+	_, err := c.ReadActionSetFields(pkts)
 	if err != nil {
-		return NewPacketOut(), err
+		return NewActionSetField(), err
 	}
 
 	return pkts[0], nil
 }
 
-func (c *PacketOutConn) ReadPacketOuts(pkts []PacketOut) (int, error) {
+func (c *ActionSetFieldConn) ReadActionSetFields(pkts []ActionSetField) (int, error) {
 	if len(c.buf) == c.offset {
 		newSize := packet.DefaultBufSize
 		if newSize < len(c.buf) {
@@ -3898,7 +4011,7 @@ func (c *PacketOutConn) ReadPacketOuts(pkts []PacketOut) (int, error) {
 	s := 0
 	n := 0
 	for i := range pkts {
-		p := NewPacketOutWithBuf(c.buf[s:])
+		p := NewActionSetFieldWithBuf(c.buf[s:])
 
 		pSize := p.Size()
 		if pSize == 0 || r < s+pSize {
@@ -3919,140 +4032,771 @@ func (c *PacketOutConn) ReadPacketOuts(pkts []PacketOut) (int, error) {
 	return n, nil
 }
 
-func (this *PacketOut) Init() {
-	this.Header12.Init()
-	this.SetLength(uint16(this.minSize()))
+func (this *ActionSetField) Init() {
+	this.Action.Init()
+	this.SetLen(uint16(this.minSize()))
 	// Invariants.
-	this.SetType(uint8(13))   // type
-	this.SetVersion(uint8(3)) // version
+	this.SetType(uint16(25)) // type
 }
 
-func (this PacketOut) Size() int {
+func (this ActionSetField) Size() int {
 	if len(this.Buf) < this.minSize() {
 		return 0
 	}
 
-	size := int(this.Length())
+	size := int(this.Len())
 	return size
 }
 
-func ToPacketOut(p Header12) (PacketOut, error) {
-	if !IsPacketOut(p) {
-		return NewPacketOutWithBuf(nil), errors.New("Cannot convert to of12.PacketOut")
+func ToActionSetField(p Action) (ActionSetField, error) {
+	if !IsActionSetField(p) {
+		return NewActionSetFieldWithBuf(nil), errors.New("Cannot convert to of12.ActionSetField")
 	}
 
-	return NewPacketOutWithBuf(p.Buf), nil
+	return NewActionSetFieldWithBuf(p.Buf), nil
 }
 
-func IsPacketOut(p Header12) bool {
-	return p.Type() == 13 && true
+func IsActionSetField(p Action) bool {
+	return p.Type() == 25 && true
 }
 
-func (this PacketOut) BufferId() uint32 {
-	offset := this.BufferIdOffset()
-	res := binary.BigEndian.Uint32(this.Buf[offset:])
+func (this ActionSetField) Field() OxmField {
+	offset := this.FieldOffset()
+	res := NewOxmFieldWithBuf(this.Buf[offset:])
 	return res
 }
 
-func (this *PacketOut) SetBufferId(b uint32) {
-	offset := this.BufferIdOffset()
-	binary.BigEndian.PutUint32(this.Buf[offset:], b)
-	offset += 4
+func (this *ActionSetField) SetField(f OxmField) {
+	offset := this.FieldOffset()
+	if this.FieldSize() != 0 {
+		panic("Repeated field field is already set.")
+	}
+	size := f.Size()
+	pSize := this.Size()
+	this.OpenGap(offset, size, pSize)
+	this.SetLen(uint16(pSize + size))
+	copy(this.Buf[offset:], f.Buf[:f.Size()])
+	offset += f.Size()
 }
 
-func (this PacketOut) BufferIdOffset() int {
-	offset := 8
+func (this ActionSetField) FieldOffset() int {
+	offset := 4
 	return offset
 }
 
-func (this PacketOut) InPort() uint32 {
-	offset := this.InPortOffset()
-	res := binary.BigEndian.Uint32(this.Buf[offset:])
-	return res
+func (this ActionSetField) FieldSize() int {
+	offset := this.FieldOffset()
+	if offset >= this.Size() {
+		return 0
+	}
+	return this.Field().Size()
 }
 
-func (this *PacketOut) SetInPort(i uint32) {
-	offset := this.InPortOffset()
-	binary.BigEndian.PutUint32(this.Buf[offset:], i)
-	offset += 4
+func (this ActionSetField) Pad() []uint8 {
+	offset := this.PadOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	return []uint8(this.Buf[offset : offset+size])
 }
 
-func (this PacketOut) InPortOffset() int {
-	offset := 12
+func (this *ActionSetField) AddPad(p uint8) {
+	offset := this.PadOffset()
+	offset += this.PadSize()
+	size := 1
+	pSize := this.Size()
+	this.OpenGap(offset, size, pSize)
+	this.SetLen(uint16(pSize + size))
+	this.Buf[offset] = byte(p)
+	offset++
+}
+
+func (this ActionSetField) PadOffset() int {
+	offset := 4
+	offset += this.FieldSize()
 	return offset
 }
 
-func (this PacketOut) ActionsLen() uint16 {
-	offset := this.ActionsLenOffset()
-	res := binary.BigEndian.Uint16(this.Buf[offset:])
-	return res
+func (this ActionSetField) PadSize() int {
+	offset := this.PadOffset()
+	size := this.Size()
+	return size - offset
 }
 
-func (this *PacketOut) SetActionsLen(a uint16) {
-	offset := this.ActionsLenOffset()
-	binary.BigEndian.PutUint16(this.Buf[offset:], a)
-	offset += 2
+func NewInstructionWithBuf(b []byte) Instruction {
+	return Instruction{packet.Packet{Buf: b}}
 }
 
-func (this PacketOut) ActionsLenOffset() int {
-	offset := 16
-	return offset
+func NewInstruction() Instruction {
+	s := 4
+	b := make([]byte, s)
+	p := Instruction{packet.Packet{Buf: b}}
+	p.Init()
+	return p
 }
 
-func (this PacketOut) Pad() [6]uint8 {
-	offset := this.PadOffset()
-	packet_size := this.Size()
-	size := packet_size - offset
-	count := 6
-	i := 0
-	var res [6]uint8
-	for size > 0 && count > 0 && packet_size > offset {
-		elem := uint8(this.Buf[offset])
-		if size < 1 {
-			break
+type Instruction struct {
+	packet.Packet
+}
+
+func (this Instruction) minSize() int {
+	return 4
+}
+
+func (this Instruction) Clone() (Instruction, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewInstruction(), err
+	}
+
+	return NewInstructionWithBuf(newBuf.Bytes()), nil
+}
+
+type InstructionConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewInstructionConn(c net.Conn) InstructionConn {
+	return InstructionConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *InstructionConn) WriteInstruction(pkt Instruction) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
 		}
-		size -= 1
-		offset += 1
-		count--
-		res[i] = elem
-		i++
+		s -= n
 	}
-	return res
+
+	return nil
 }
 
-func (this *PacketOut) SetPad(p [6]uint8) {
-	offset := this.PadOffset()
-	for _, e := range p {
-		this.Buf[offset] = byte(e)
-		offset++
+func (c *InstructionConn) WriteInstructions(pkts []Instruction) error {
+	for _, p := range pkts {
+		if err := c.WriteInstruction(p); err != nil {
+			return err
+		}
 	}
+	return nil
 }
 
-func (this PacketOut) PadOffset() int {
-	offset := 18
-	return offset
+func (c *InstructionConn) Flush() error {
+	return c.w.Flush()
 }
 
-func (this PacketOut) Actions() []Action {
-	offset := this.ActionsOffset()
-	packet_size := this.Size()
-	size := int(this.ActionsLen())
-	count := this.Size() - offset
-	var res []Action
-	for size > 0 && count > 0 && packet_size > offset {
-		elem := NewActionWithBuf(this.Buf[offset:])
-		if elem.Size() > size {
+func (c *InstructionConn) ReadInstruction() (Instruction, error) {
+	pkts := make([]Instruction, 1)
+	_, err := c.ReadInstructions(pkts)
+	if err != nil {
+		return NewInstruction(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *InstructionConn) ReadInstructions(pkts []Instruction) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewInstructionWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
 			break
 		}
-		size -= elem.Size()
-		offset += elem.Size()
-		count--
-		res = append(res, elem)
+
+		pkts[i] = p
+		s += pSize
+		n++
 	}
-	return res
-}
 
-func (this *PacketOut) AddActions(a Action) {
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *Instruction) Init() {
+	this.SetLen(uint16(this.minSize()))
+	// Invariants.
+}
+
+func (this Instruction) Size() int {
+	if len(this.Buf) < this.minSize() {
+		return 0
+	}
+
+	size := int(this.Len())
+	return size
+}
+
+func ToInstruction(p packet.Packet) (Instruction, error) {
+	if !IsInstruction(p) {
+		return NewInstructionWithBuf(nil), errors.New("Cannot convert to of12.Instruction")
+	}
+
+	return NewInstructionWithBuf(p.Buf), nil
+}
+
+func IsInstruction(p packet.Packet) bool {
+	return true
+}
+
+func (this Instruction) Type() uint16 {
+	offset := this.TypeOffset()
+	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	return res
+}
+
+func (this *Instruction) SetType(t uint16) {
+	offset := this.TypeOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], t)
+	offset += 2
+}
+
+func (this Instruction) TypeOffset() int {
+	offset := 0
+	return offset
+}
+
+func (this Instruction) Len() uint16 {
+	offset := this.LenOffset()
+	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	return res
+}
+
+func (this *Instruction) SetLen(l uint16) {
+	offset := this.LenOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], l)
+	offset += 2
+}
+
+func (this Instruction) LenOffset() int {
+	offset := 2
+	return offset
+}
+
+func NewApplyActionsWithBuf(b []byte) ApplyActions {
+	return ApplyActions{Instruction{packet.Packet{Buf: b}}}
+}
+
+func NewApplyActions() ApplyActions {
+	s := 8
+	b := make([]byte, s)
+	p := ApplyActions{Instruction{packet.Packet{Buf: b}}}
+	p.Init()
+	return p
+}
+
+type ApplyActions struct {
+	Instruction
+}
+
+func (this ApplyActions) minSize() int {
+	return 8
+}
+
+func (this ApplyActions) Clone() (ApplyActions, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewApplyActions(), err
+	}
+
+	return NewApplyActionsWithBuf(newBuf.Bytes()), nil
+}
+
+type ApplyActionsConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewApplyActionsConn(c net.Conn) ApplyActionsConn {
+	return ApplyActionsConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *ApplyActionsConn) WriteApplyActions(pkt ApplyActions) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *ApplyActionsConn) WriteApplyActionss(pkts []ApplyActions) error {
+	for _, p := range pkts {
+		if err := c.WriteApplyActions(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *ApplyActionsConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *ApplyActionsConn) ReadApplyActions() (ApplyActions, error) {
+	pkts := make([]ApplyActions, 1)
+	_, err := c.ReadApplyActionss(pkts)
+	if err != nil {
+		return NewApplyActions(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *ApplyActionsConn) ReadApplyActionss(pkts []ApplyActions) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewApplyActionsWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *ApplyActions) Init() {
+	this.Instruction.Init()
+	this.SetLen(uint16(this.minSize()))
+	// Invariants.
+	this.SetType(uint16(4)) // type
+}
+
+func (this ApplyActions) Size() int {
+	if len(this.Buf) < this.minSize() {
+		return 0
+	}
+
+	size := int(this.Len())
+	return size
+}
+
+func ToApplyActions(p Instruction) (ApplyActions, error) {
+	if !IsApplyActions(p) {
+		return NewApplyActionsWithBuf(nil), errors.New("Cannot convert to of12.ApplyActions")
+	}
+
+	return NewApplyActionsWithBuf(p.Buf), nil
+}
+
+func IsApplyActions(p Instruction) bool {
+	return p.Type() == 4 && true
+}
+
+func (this ApplyActions) Pad() [4]uint8 {
+	offset := this.PadOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := 4
+	i := 0
+	var res [4]uint8
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := uint8(this.Buf[offset])
+		if size < 1 {
+			break
+		}
+		size -= 1
+		offset += 1
+		count--
+		res[i] = elem
+		i++
+	}
+	return res
+}
+
+func (this *ApplyActions) SetPad(p [4]uint8) {
+	offset := this.PadOffset()
+	for _, e := range p {
+		this.Buf[offset] = byte(e)
+		offset++
+	}
+}
+
+func (this ApplyActions) PadOffset() int {
+	offset := 4
+	return offset
+}
+
+func (this ApplyActions) Actions() []Action {
+	offset := this.ActionsOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := this.Size() - offset
+	var res []Action
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := NewActionWithBuf(this.Buf[offset:])
+		if elem.Size() > size {
+			break
+		}
+		size -= elem.Size()
+		offset += elem.Size()
+		count--
+		res = append(res, elem)
+	}
+	return res
+}
+
+func (this *ApplyActions) AddActions(a Action) {
+	offset := this.ActionsOffset()
+	offset += this.ActionsSize()
+	size := a.Size()
+	pSize := this.Size()
+	this.OpenGap(offset, size, pSize)
+	this.SetLen(uint16(pSize + size))
+	copy(this.Buf[offset:], a.Buf[:size])
+	offset += size
+}
+
+func (this ApplyActions) ActionsOffset() int {
+	offset := 8
+	return offset
+}
+
+func (this ApplyActions) ActionsSize() int {
+	offset := this.ActionsOffset()
+	size := this.Size()
+	return size - offset
+}
+
+func NewPacketOutWithBuf(b []byte) PacketOut {
+	return PacketOut{Header12{of.Header{packet.Packet{Buf: b}}}}
+}
+
+func NewPacketOut() PacketOut {
+	s := 24
+	b := make([]byte, s)
+	p := PacketOut{Header12{of.Header{packet.Packet{Buf: b}}}}
+	p.Init()
+	return p
+}
+
+type PacketOut struct {
+	Header12
+}
+
+func (this PacketOut) minSize() int {
+	return 24
+}
+
+func (this PacketOut) Clone() (PacketOut, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewPacketOut(), err
+	}
+
+	return NewPacketOutWithBuf(newBuf.Bytes()), nil
+}
+
+type PacketOutConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewPacketOutConn(c net.Conn) PacketOutConn {
+	return PacketOutConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *PacketOutConn) WritePacketOut(pkt PacketOut) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *PacketOutConn) WritePacketOuts(pkts []PacketOut) error {
+	for _, p := range pkts {
+		if err := c.WritePacketOut(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *PacketOutConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *PacketOutConn) ReadPacketOut() (PacketOut, error) {
+	pkts := make([]PacketOut, 1)
+	_, err := c.ReadPacketOuts(pkts)
+	if err != nil {
+		return NewPacketOut(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *PacketOutConn) ReadPacketOuts(pkts []PacketOut) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewPacketOutWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *PacketOut) Init() {
+	this.Header12.Init()
+	this.SetLength(uint16(this.minSize()))
+	// Invariants.
+	this.SetType(uint8(13))   // type
+	this.SetVersion(uint8(3)) // version
+}
+
+func (this PacketOut) Size() int {
+	if len(this.Buf) < this.minSize() {
+		return 0
+	}
+
+	size := int(this.Length())
+	return size
+}
+
+func ToPacketOut(p Header12) (PacketOut, error) {
+	if !IsPacketOut(p) {
+		return NewPacketOutWithBuf(nil), errors.New("Cannot convert to of12.PacketOut")
+	}
+
+	return NewPacketOutWithBuf(p.Buf), nil
+}
+
+func IsPacketOut(p Header12) bool {
+	return p.Type() == 13 && true
+}
+
+func (this PacketOut) BufferId() uint32 {
+	offset := this.BufferIdOffset()
+	res := binary.BigEndian.Uint32(this.Buf[offset:])
+	return res
+}
+
+func (this *PacketOut) SetBufferId(b uint32) {
+	offset := this.BufferIdOffset()
+	binary.BigEndian.PutUint32(this.Buf[offset:], b)
+	offset += 4
+}
+
+func (this PacketOut) BufferIdOffset() int {
+	offset := 8
+	return offset
+}
+
+func (this PacketOut) InPort() uint32 {
+	offset := this.InPortOffset()
+	res := binary.BigEndian.Uint32(this.Buf[offset:])
+	return res
+}
+
+func (this *PacketOut) SetInPort(i uint32) {
+	offset := this.InPortOffset()
+	binary.BigEndian.PutUint32(this.Buf[offset:], i)
+	offset += 4
+}
+
+func (this PacketOut) InPortOffset() int {
+	offset := 12
+	return offset
+}
+
+func (this PacketOut) ActionsLen() uint16 {
+	offset := this.ActionsLenOffset()
+	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	return res
+}
+
+func (this *PacketOut) SetActionsLen(a uint16) {
+	offset := this.ActionsLenOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], a)
+	offset += 2
+}
+
+func (this PacketOut) ActionsLenOffset() int {
+	offset := 16
+	return offset
+}
+
+func (this PacketOut) Pad() [6]uint8 {
+	offset := this.PadOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := 6
+	i := 0
+	var res [6]uint8
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := uint8(this.Buf[offset])
+		if size < 1 {
+			break
+		}
+		size -= 1
+		offset += 1
+		count--
+		res[i] = elem
+		i++
+	}
+	return res
+}
+
+func (this *PacketOut) SetPad(p [6]uint8) {
+	offset := this.PadOffset()
+	for _, e := range p {
+		this.Buf[offset] = byte(e)
+		offset++
+	}
+}
+
+func (this PacketOut) PadOffset() int {
+	offset := 18
+	return offset
+}
+
+func (this PacketOut) Actions() []Action {
+	offset := this.ActionsOffset()
+	packet_size := this.Size()
+	size := int(this.ActionsLen())
+	count := this.Size() - offset
+	var res []Action
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := NewActionWithBuf(this.Buf[offset:])
+		if elem.Size() > size {
+			break
+		}
+		size -= elem.Size()
+		offset += elem.Size()
+		count--
+		res = append(res, elem)
+	}
+	return res
+}
+
+func (this *PacketOut) AddActions(a Action) {
 	offset := this.ActionsOffset()
 	offset += this.ActionsSize()
 	size := a.Size()
@@ -4064,91 +4808,3989 @@ func (this *PacketOut) AddActions(a Action) {
 	this.SetActionsLen(this.ActionsLen() + uint16(size))
 }
 
-func (this PacketOut) ActionsOffset() int {
-	offset := 24
-	return offset
+func (this PacketOut) ActionsOffset() int {
+	offset := 24
+	return offset
+}
+
+func (this PacketOut) ActionsSize() int {
+	return int(this.ActionsLen())
+}
+
+func (this PacketOut) Data() []uint8 {
+	offset := this.DataOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	return []uint8(this.Buf[offset : offset+size])
+}
+
+func (this *PacketOut) AddData(d uint8) {
+	offset := this.DataOffset()
+	offset += this.DataSize()
+	size := 1
+	pSize := this.Size()
+	this.OpenGap(offset, size, pSize)
+	this.SetLength(uint16(pSize + size))
+	this.Buf[offset] = byte(d)
+	offset++
+}
+
+func (this PacketOut) DataOffset() int {
+	offset := 24
+	offset += this.ActionsSize()
+	return offset
+}
+
+func (this PacketOut) DataSize() int {
+	offset := this.DataOffset()
+	size := this.Size()
+	return size - offset
+}
+
+func NewOxmFieldWithBuf(b []byte) OxmField {
+	return OxmField{packet.Packet{Buf: b}}
+}
+
+func NewOxmField() OxmField {
+	s := packet.PaddedSize(4, 1, 4)
+	b := make([]byte, s)
+	p := OxmField{packet.Packet{Buf: b}}
+	p.Init()
+	return p
+}
+
+type OxmField struct {
+	packet.Packet
+}
+
+func (this OxmField) minSize() int {
+	return 4
+}
+
+func (this OxmField) Clone() (OxmField, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewOxmField(), err
+	}
+
+	return NewOxmFieldWithBuf(newBuf.Bytes()), nil
+}
+
+type OxmFieldConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewOxmFieldConn(c net.Conn) OxmFieldConn {
+	return OxmFieldConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *OxmFieldConn) WriteOxmField(pkt OxmField) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *OxmFieldConn) WriteOxmFields(pkts []OxmField) error {
+	for _, p := range pkts {
+		if err := c.WriteOxmField(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *OxmFieldConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *OxmFieldConn) ReadOxmField() (OxmField, error) {
+	pkts := make([]OxmField, 1)
+	_, err := c.ReadOxmFields(pkts)
+	if err != nil {
+		return NewOxmField(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *OxmFieldConn) ReadOxmFields(pkts []OxmField) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewOxmFieldWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *OxmField) Init() {
+	this.SetOxmLength(uint8(this.minSize()))
+	// Invariants.
+}
+
+func (this OxmField) Size() int {
+	if len(this.Buf) < this.minSize() {
+		return 0
+	}
+
+	size := int(this.OxmLength())
+	return packet.PaddedSize(size, 1, 4)
+}
+
+func ToOxmField(p packet.Packet) (OxmField, error) {
+	if !IsOxmField(p) {
+		return NewOxmFieldWithBuf(nil), errors.New("Cannot convert to of12.OxmField")
+	}
+
+	return NewOxmFieldWithBuf(p.Buf), nil
+}
+
+func IsOxmField(p packet.Packet) bool {
+	return true
+}
+
+func (this OxmField) OxmClass() uint16 {
+	offset := this.OxmClassOffset()
+	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	return res
+}
+
+func (this *OxmField) SetOxmClass(o uint16) {
+	offset := this.OxmClassOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], o)
+	offset += 2
+}
+
+func (this OxmField) OxmClassOffset() int {
+	offset := 0
+	return offset
+}
+
+func (this OxmField) OxmField() uint8 {
+	offset := this.OxmFieldOffset()
+	res := uint8(this.Buf[offset])
+	return res
+}
+
+func (this *OxmField) SetOxmField(o uint8) {
+	offset := this.OxmFieldOffset()
+	this.Buf[offset] = byte(o)
+	offset++
+}
+
+func (this OxmField) OxmFieldOffset() int {
+	offset := 2
+	return offset
+}
+
+func (this OxmField) OxmLength() uint8 {
+	offset := this.OxmLengthOffset()
+	res := uint8(this.Buf[offset])
+	return res
+}
+
+func (this *OxmField) SetOxmLength(o uint8) {
+	offset := this.OxmLengthOffset()
+	this.Buf[offset] = byte(o)
+	offset++
+}
+
+func (this OxmField) OxmLengthOffset() int {
+	offset := 3
+	return offset
+}
+
+func NewOxmInPortWithBuf(b []byte) OxmInPort {
+	return OxmInPort{OxmField{packet.Packet{Buf: b}}}
+}
+
+func NewOxmInPort() OxmInPort {
+	s := packet.PaddedSize(8, 1, 4)
+	b := make([]byte, s)
+	p := OxmInPort{OxmField{packet.Packet{Buf: b}}}
+	p.Init()
+	return p
+}
+
+type OxmInPort struct {
+	OxmField
+}
+
+func (this OxmInPort) minSize() int {
+	return 8
+}
+
+func (this OxmInPort) Clone() (OxmInPort, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewOxmInPort(), err
+	}
+
+	return NewOxmInPortWithBuf(newBuf.Bytes()), nil
+}
+
+type OxmInPortConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewOxmInPortConn(c net.Conn) OxmInPortConn {
+	return OxmInPortConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *OxmInPortConn) WriteOxmInPort(pkt OxmInPort) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *OxmInPortConn) WriteOxmInPorts(pkts []OxmInPort) error {
+	for _, p := range pkts {
+		if err := c.WriteOxmInPort(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *OxmInPortConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *OxmInPortConn) ReadOxmInPort() (OxmInPort, error) {
+	pkts := make([]OxmInPort, 1)
+	_, err := c.ReadOxmInPorts(pkts)
+	if err != nil {
+		return NewOxmInPort(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *OxmInPortConn) ReadOxmInPorts(pkts []OxmInPort) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewOxmInPortWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *OxmInPort) Init() {
+	this.OxmField.Init()
+	this.SetOxmLength(uint8(this.minSize()))
+	// Invariants.
+	this.SetOxmClass(uint16(32768)) // oxm_class
+	this.SetOxmField(uint8(0))      // oxm_field
+	this.SetOxmLength(uint8(4))     // oxm_length
+}
+
+func (this OxmInPort) Size() int {
+	if len(this.Buf) < this.minSize() {
+		return 0
+	}
+
+	size := int(this.OxmLength())
+	return packet.PaddedSize(size, 1, 4)
+}
+
+func ToOxmInPort(p OxmField) (OxmInPort, error) {
+	if !IsOxmInPort(p) {
+		return NewOxmInPortWithBuf(nil), errors.New("Cannot convert to of12.OxmInPort")
+	}
+
+	return NewOxmInPortWithBuf(p.Buf), nil
+}
+
+func IsOxmInPort(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 0 && p.OxmLength() == 4 && true
+}
+
+func (this OxmInPort) InPort() uint32 {
+	offset := this.InPortOffset()
+	res := binary.BigEndian.Uint32(this.Buf[offset:])
+	return res
+}
+
+func (this *OxmInPort) SetInPort(i uint32) {
+	offset := this.InPortOffset()
+	binary.BigEndian.PutUint32(this.Buf[offset:], i)
+	offset += 4
+}
+
+func (this OxmInPort) InPortOffset() int {
+	offset := 4
+	return offset
+}
+
+func NewOxmEthDstWithBuf(b []byte) OxmEthDst {
+	return OxmEthDst{OxmField{packet.Packet{Buf: b}}}
+}
+
+func NewOxmEthDst() OxmEthDst {
+	s := packet.PaddedSize(10, 1, 4)
+	b := make([]byte, s)
+	p := OxmEthDst{OxmField{packet.Packet{Buf: b}}}
+	p.Init()
+	return p
+}
+
+type OxmEthDst struct {
+	OxmField
+}
+
+func (this OxmEthDst) minSize() int {
+	return 10
+}
+
+func (this OxmEthDst) Clone() (OxmEthDst, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewOxmEthDst(), err
+	}
+
+	return NewOxmEthDstWithBuf(newBuf.Bytes()), nil
+}
+
+type OxmEthDstConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewOxmEthDstConn(c net.Conn) OxmEthDstConn {
+	return OxmEthDstConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *OxmEthDstConn) WriteOxmEthDst(pkt OxmEthDst) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *OxmEthDstConn) WriteOxmEthDsts(pkts []OxmEthDst) error {
+	for _, p := range pkts {
+		if err := c.WriteOxmEthDst(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *OxmEthDstConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *OxmEthDstConn) ReadOxmEthDst() (OxmEthDst, error) {
+	pkts := make([]OxmEthDst, 1)
+	_, err := c.ReadOxmEthDsts(pkts)
+	if err != nil {
+		return NewOxmEthDst(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *OxmEthDstConn) ReadOxmEthDsts(pkts []OxmEthDst) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewOxmEthDstWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *OxmEthDst) Init() {
+	this.OxmField.Init()
+	this.SetOxmLength(uint8(this.minSize()))
+	// Invariants.
+	this.SetOxmClass(uint16(32768)) // oxm_class
+	this.SetOxmField(uint8(6))      // oxm_field
+	this.SetOxmLength(uint8(6))     // oxm_length
+}
+
+func (this OxmEthDst) Size() int {
+	if len(this.Buf) < this.minSize() {
+		return 0
+	}
+
+	size := int(this.OxmLength())
+	return packet.PaddedSize(size, 1, 4)
+}
+
+func ToOxmEthDst(p OxmField) (OxmEthDst, error) {
+	if !IsOxmEthDst(p) {
+		return NewOxmEthDstWithBuf(nil), errors.New("Cannot convert to of12.OxmEthDst")
+	}
+
+	return NewOxmEthDstWithBuf(p.Buf), nil
+}
+
+func IsOxmEthDst(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 6 && p.OxmLength() == 6 && true
+}
+
+func (this OxmEthDst) MacAddr() [6]uint8 {
+	offset := this.MacAddrOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := 6
+	i := 0
+	var res [6]uint8
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := uint8(this.Buf[offset])
+		if size < 1 {
+			break
+		}
+		size -= 1
+		offset += 1
+		count--
+		res[i] = elem
+		i++
+	}
+	return res
+}
+
+func (this *OxmEthDst) SetMacAddr(m [6]uint8) {
+	offset := this.MacAddrOffset()
+	for _, e := range m {
+		this.Buf[offset] = byte(e)
+		offset++
+	}
+}
+
+func (this OxmEthDst) MacAddrOffset() int {
+	offset := 4
+	return offset
+}
+
+func NewOxmEthDstMaskedWithBuf(b []byte) OxmEthDstMasked {
+	return OxmEthDstMasked{OxmField{packet.Packet{Buf: b}}}
+}
+
+func NewOxmEthDstMasked() OxmEthDstMasked {
+	s := packet.PaddedSize(16, 1, 4)
+	b := make([]byte, s)
+	p := OxmEthDstMasked{OxmField{packet.Packet{Buf: b}}}
+	p.Init()
+	return p
+}
+
+type OxmEthDstMasked struct {
+	OxmField
+}
+
+func (this OxmEthDstMasked) minSize() int {
+	return 16
+}
+
+func (this OxmEthDstMasked) Clone() (OxmEthDstMasked, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewOxmEthDstMasked(), err
+	}
+
+	return NewOxmEthDstMaskedWithBuf(newBuf.Bytes()), nil
+}
+
+type OxmEthDstMaskedConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewOxmEthDstMaskedConn(c net.Conn) OxmEthDstMaskedConn {
+	return OxmEthDstMaskedConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *OxmEthDstMaskedConn) WriteOxmEthDstMasked(pkt OxmEthDstMasked) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *OxmEthDstMaskedConn) WriteOxmEthDstMaskeds(pkts []OxmEthDstMasked) error {
+	for _, p := range pkts {
+		if err := c.WriteOxmEthDstMasked(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *OxmEthDstMaskedConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *OxmEthDstMaskedConn) ReadOxmEthDstMasked() (OxmEthDstMasked, error) {
+	pkts := make([]OxmEthDstMasked, 1)
+	_, err := c.ReadOxmEthDstMaskeds(pkts)
+	if err != nil {
+		return NewOxmEthDstMasked(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *OxmEthDstMaskedConn) ReadOxmEthDstMaskeds(pkts []OxmEthDstMasked) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewOxmEthDstMaskedWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *OxmEthDstMasked) Init() {
+	this.OxmField.Init()
+	this.SetOxmLength(uint8(this.minSize()))
+	// Invariants.
+	this.SetOxmClass(uint16(32768)) // oxm_class
+	this.SetOxmField(uint8(7))      // oxm_field
+	this.SetOxmLength(uint8(12))    // oxm_length
+}
+
+func (this OxmEthDstMasked) Size() int {
+	if len(this.Buf) < this.minSize() {
+		return 0
+	}
+
+	size := int(this.OxmLength())
+	return packet.PaddedSize(size, 1, 4)
+}
+
+func ToOxmEthDstMasked(p OxmField) (OxmEthDstMasked, error) {
+	if !IsOxmEthDstMasked(p) {
+		return NewOxmEthDstMaskedWithBuf(nil), errors.New("Cannot convert to of12.OxmEthDstMasked")
+	}
+
+	return NewOxmEthDstMaskedWithBuf(p.Buf), nil
+}
+
+func IsOxmEthDstMasked(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 7 && p.OxmLength() == 12 && true
+}
+
+func (this OxmEthDstMasked) MacAddr() [6]uint8 {
+	offset := this.MacAddrOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := 6
+	i := 0
+	var res [6]uint8
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := uint8(this.Buf[offset])
+		if size < 1 {
+			break
+		}
+		size -= 1
+		offset += 1
+		count--
+		res[i] = elem
+		i++
+	}
+	return res
+}
+
+func (this *OxmEthDstMasked) SetMacAddr(m [6]uint8) {
+	offset := this.MacAddrOffset()
+	for _, e := range m {
+		this.Buf[offset] = byte(e)
+		offset++
+	}
+}
+
+func (this OxmEthDstMasked) MacAddrOffset() int {
+	offset := 4
+	return offset
+}
+
+func (this OxmEthDstMasked) Mask() [6]uint8 {
+	offset := this.MaskOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := 6
+	i := 0
+	var res [6]uint8
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := uint8(this.Buf[offset])
+		if size < 1 {
+			break
+		}
+		size -= 1
+		offset += 1
+		count--
+		res[i] = elem
+		i++
+	}
+	return res
+}
+
+func (this *OxmEthDstMasked) SetMask(m [6]uint8) {
+	offset := this.MaskOffset()
+	for _, e := range m {
+		this.Buf[offset] = byte(e)
+		offset++
+	}
+}
+
+func (this OxmEthDstMasked) MaskOffset() int {
+	offset := 10
+	return offset
+}
+
+func NewOxmEthSrcWithBuf(b []byte) OxmEthSrc {
+	return OxmEthSrc{OxmField{packet.Packet{Buf: b}}}
+}
+
+func NewOxmEthSrc() OxmEthSrc {
+	s := packet.PaddedSize(10, 1, 4)
+	b := make([]byte, s)
+	p := OxmEthSrc{OxmField{packet.Packet{Buf: b}}}
+	p.Init()
+	return p
+}
+
+type OxmEthSrc struct {
+	OxmField
+}
+
+func (this OxmEthSrc) minSize() int {
+	return 10
+}
+
+func (this OxmEthSrc) Clone() (OxmEthSrc, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewOxmEthSrc(), err
+	}
+
+	return NewOxmEthSrcWithBuf(newBuf.Bytes()), nil
+}
+
+type OxmEthSrcConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewOxmEthSrcConn(c net.Conn) OxmEthSrcConn {
+	return OxmEthSrcConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *OxmEthSrcConn) WriteOxmEthSrc(pkt OxmEthSrc) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *OxmEthSrcConn) WriteOxmEthSrcs(pkts []OxmEthSrc) error {
+	for _, p := range pkts {
+		if err := c.WriteOxmEthSrc(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *OxmEthSrcConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *OxmEthSrcConn) ReadOxmEthSrc() (OxmEthSrc, error) {
+	pkts := make([]OxmEthSrc, 1)
+	_, err := c.ReadOxmEthSrcs(pkts)
+	if err != nil {
+		return NewOxmEthSrc(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *OxmEthSrcConn) ReadOxmEthSrcs(pkts []OxmEthSrc) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewOxmEthSrcWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *OxmEthSrc) Init() {
+	this.OxmField.Init()
+	this.SetOxmLength(uint8(this.minSize()))
+	// Invariants.
+	this.SetOxmClass(uint16(32768)) // oxm_class
+	this.SetOxmField(uint8(8))      // oxm_field
+	this.SetOxmLength(uint8(6))     // oxm_length
+}
+
+func (this OxmEthSrc) Size() int {
+	if len(this.Buf) < this.minSize() {
+		return 0
+	}
+
+	size := int(this.OxmLength())
+	return packet.PaddedSize(size, 1, 4)
+}
+
+func ToOxmEthSrc(p OxmField) (OxmEthSrc, error) {
+	if !IsOxmEthSrc(p) {
+		return NewOxmEthSrcWithBuf(nil), errors.New("Cannot convert to of12.OxmEthSrc")
+	}
+
+	return NewOxmEthSrcWithBuf(p.Buf), nil
+}
+
+func IsOxmEthSrc(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 8 && p.OxmLength() == 6 && true
+}
+
+func (this OxmEthSrc) MacAddr() [6]uint8 {
+	offset := this.MacAddrOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := 6
+	i := 0
+	var res [6]uint8
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := uint8(this.Buf[offset])
+		if size < 1 {
+			break
+		}
+		size -= 1
+		offset += 1
+		count--
+		res[i] = elem
+		i++
+	}
+	return res
+}
+
+func (this *OxmEthSrc) SetMacAddr(m [6]uint8) {
+	offset := this.MacAddrOffset()
+	for _, e := range m {
+		this.Buf[offset] = byte(e)
+		offset++
+	}
+}
+
+func (this OxmEthSrc) MacAddrOffset() int {
+	offset := 4
+	return offset
+}
+
+func NewOxmEthSrcMaskedWithBuf(b []byte) OxmEthSrcMasked {
+	return OxmEthSrcMasked{OxmField{packet.Packet{Buf: b}}}
+}
+
+func NewOxmEthSrcMasked() OxmEthSrcMasked {
+	s := packet.PaddedSize(16, 1, 4)
+	b := make([]byte, s)
+	p := OxmEthSrcMasked{OxmField{packet.Packet{Buf: b}}}
+	p.Init()
+	return p
+}
+
+type OxmEthSrcMasked struct {
+	OxmField
+}
+
+func (this OxmEthSrcMasked) minSize() int {
+	return 16
+}
+
+func (this OxmEthSrcMasked) Clone() (OxmEthSrcMasked, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewOxmEthSrcMasked(), err
+	}
+
+	return NewOxmEthSrcMaskedWithBuf(newBuf.Bytes()), nil
+}
+
+type OxmEthSrcMaskedConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewOxmEthSrcMaskedConn(c net.Conn) OxmEthSrcMaskedConn {
+	return OxmEthSrcMaskedConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *OxmEthSrcMaskedConn) WriteOxmEthSrcMasked(pkt OxmEthSrcMasked) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *OxmEthSrcMaskedConn) WriteOxmEthSrcMaskeds(pkts []OxmEthSrcMasked) error {
+	for _, p := range pkts {
+		if err := c.WriteOxmEthSrcMasked(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *OxmEthSrcMaskedConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *OxmEthSrcMaskedConn) ReadOxmEthSrcMasked() (OxmEthSrcMasked, error) {
+	pkts := make([]OxmEthSrcMasked, 1)
+	_, err := c.ReadOxmEthSrcMaskeds(pkts)
+	if err != nil {
+		return NewOxmEthSrcMasked(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *OxmEthSrcMaskedConn) ReadOxmEthSrcMaskeds(pkts []OxmEthSrcMasked) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewOxmEthSrcMaskedWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *OxmEthSrcMasked) Init() {
+	this.OxmField.Init()
+	this.SetOxmLength(uint8(this.minSize()))
+	// Invariants.
+	this.SetOxmClass(uint16(32768)) // oxm_class
+	this.SetOxmField(uint8(9))      // oxm_field
+	this.SetOxmLength(uint8(12))    // oxm_length
+}
+
+func (this OxmEthSrcMasked) Size() int {
+	if len(this.Buf) < this.minSize() {
+		return 0
+	}
+
+	size := int(this.OxmLength())
+	return packet.PaddedSize(size, 1, 4)
+}
+
+func ToOxmEthSrcMasked(p OxmField) (OxmEthSrcMasked, error) {
+	if !IsOxmEthSrcMasked(p) {
+		return NewOxmEthSrcMaskedWithBuf(nil), errors.New("Cannot convert to of12.OxmEthSrcMasked")
+	}
+
+	return NewOxmEthSrcMaskedWithBuf(p.Buf), nil
+}
+
+func IsOxmEthSrcMasked(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 9 && p.OxmLength() == 12 && true
+}
+
+func (this OxmEthSrcMasked) MacAddr() [6]uint8 {
+	offset := this.MacAddrOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := 6
+	i := 0
+	var res [6]uint8
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := uint8(this.Buf[offset])
+		if size < 1 {
+			break
+		}
+		size -= 1
+		offset += 1
+		count--
+		res[i] = elem
+		i++
+	}
+	return res
+}
+
+func (this *OxmEthSrcMasked) SetMacAddr(m [6]uint8) {
+	offset := this.MacAddrOffset()
+	for _, e := range m {
+		this.Buf[offset] = byte(e)
+		offset++
+	}
+}
+
+func (this OxmEthSrcMasked) MacAddrOffset() int {
+	offset := 4
+	return offset
+}
+
+func (this OxmEthSrcMasked) Mask() [6]uint8 {
+	offset := this.MaskOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := 6
+	i := 0
+	var res [6]uint8
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := uint8(this.Buf[offset])
+		if size < 1 {
+			break
+		}
+		size -= 1
+		offset += 1
+		count--
+		res[i] = elem
+		i++
+	}
+	return res
+}
+
+func (this *OxmEthSrcMasked) SetMask(m [6]uint8) {
+	offset := this.MaskOffset()
+	for _, e := range m {
+		this.Buf[offset] = byte(e)
+		offset++
+	}
+}
+
+func (this OxmEthSrcMasked) MaskOffset() int {
+	offset := 10
+	return offset
+}
+
+func NewOxmEthTypeWithBuf(b []byte) OxmEthType {
+	return OxmEthType{OxmField{packet.Packet{Buf: b}}}
+}
+
+func NewOxmEthType() OxmEthType {
+	s := packet.PaddedSize(6, 1, 4)
+	b := make([]byte, s)
+	p := OxmEthType{OxmField{packet.Packet{Buf: b}}}
+	p.Init()
+	return p
+}
+
+type OxmEthType struct {
+	OxmField
+}
+
+func (this OxmEthType) minSize() int {
+	return 6
+}
+
+func (this OxmEthType) Clone() (OxmEthType, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewOxmEthType(), err
+	}
+
+	return NewOxmEthTypeWithBuf(newBuf.Bytes()), nil
+}
+
+type OxmEthTypeConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewOxmEthTypeConn(c net.Conn) OxmEthTypeConn {
+	return OxmEthTypeConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *OxmEthTypeConn) WriteOxmEthType(pkt OxmEthType) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *OxmEthTypeConn) WriteOxmEthTypes(pkts []OxmEthType) error {
+	for _, p := range pkts {
+		if err := c.WriteOxmEthType(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *OxmEthTypeConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *OxmEthTypeConn) ReadOxmEthType() (OxmEthType, error) {
+	pkts := make([]OxmEthType, 1)
+	_, err := c.ReadOxmEthTypes(pkts)
+	if err != nil {
+		return NewOxmEthType(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *OxmEthTypeConn) ReadOxmEthTypes(pkts []OxmEthType) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewOxmEthTypeWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *OxmEthType) Init() {
+	this.OxmField.Init()
+	this.SetOxmLength(uint8(this.minSize()))
+	// Invariants.
+	this.SetOxmClass(uint16(32768)) // oxm_class
+	this.SetOxmField(uint8(10))     // oxm_field
+	this.SetOxmLength(uint8(2))     // oxm_length
+}
+
+func (this OxmEthType) Size() int {
+	if len(this.Buf) < this.minSize() {
+		return 0
+	}
+
+	size := int(this.OxmLength())
+	return packet.PaddedSize(size, 1, 4)
+}
+
+func ToOxmEthType(p OxmField) (OxmEthType, error) {
+	if !IsOxmEthType(p) {
+		return NewOxmEthTypeWithBuf(nil), errors.New("Cannot convert to of12.OxmEthType")
+	}
+
+	return NewOxmEthTypeWithBuf(p.Buf), nil
+}
+
+func IsOxmEthType(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 10 && p.OxmLength() == 2 && true
+}
+
+func (this OxmEthType) Type() uint16 {
+	offset := this.TypeOffset()
+	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	return res
+}
+
+func (this *OxmEthType) SetType(t uint16) {
+	offset := this.TypeOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], t)
+	offset += 2
+}
+
+func (this OxmEthType) TypeOffset() int {
+	offset := 4
+	return offset
+}
+
+func NewOxmVlanVidWithBuf(b []byte) OxmVlanVid {
+	return OxmVlanVid{OxmField{packet.Packet{Buf: b}}}
+}
+
+func NewOxmVlanVid() OxmVlanVid {
+	s := packet.PaddedSize(6, 1, 4)
+	b := make([]byte, s)
+	p := OxmVlanVid{OxmField{packet.Packet{Buf: b}}}
+	p.Init()
+	return p
+}
+
+type OxmVlanVid struct {
+	OxmField
+}
+
+func (this OxmVlanVid) minSize() int {
+	return 6
+}
+
+func (this OxmVlanVid) Clone() (OxmVlanVid, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewOxmVlanVid(), err
+	}
+
+	return NewOxmVlanVidWithBuf(newBuf.Bytes()), nil
+}
+
+type OxmVlanVidConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewOxmVlanVidConn(c net.Conn) OxmVlanVidConn {
+	return OxmVlanVidConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *OxmVlanVidConn) WriteOxmVlanVid(pkt OxmVlanVid) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *OxmVlanVidConn) WriteOxmVlanVids(pkts []OxmVlanVid) error {
+	for _, p := range pkts {
+		if err := c.WriteOxmVlanVid(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *OxmVlanVidConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *OxmVlanVidConn) ReadOxmVlanVid() (OxmVlanVid, error) {
+	pkts := make([]OxmVlanVid, 1)
+	_, err := c.ReadOxmVlanVids(pkts)
+	if err != nil {
+		return NewOxmVlanVid(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *OxmVlanVidConn) ReadOxmVlanVids(pkts []OxmVlanVid) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewOxmVlanVidWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *OxmVlanVid) Init() {
+	this.OxmField.Init()
+	this.SetOxmLength(uint8(this.minSize()))
+	// Invariants.
+	this.SetOxmClass(uint16(32768)) // oxm_class
+	this.SetOxmField(uint8(12))     // oxm_field
+	this.SetOxmLength(uint8(2))     // oxm_length
+}
+
+func (this OxmVlanVid) Size() int {
+	if len(this.Buf) < this.minSize() {
+		return 0
+	}
+
+	size := int(this.OxmLength())
+	return packet.PaddedSize(size, 1, 4)
+}
+
+func ToOxmVlanVid(p OxmField) (OxmVlanVid, error) {
+	if !IsOxmVlanVid(p) {
+		return NewOxmVlanVidWithBuf(nil), errors.New("Cannot convert to of12.OxmVlanVid")
+	}
+
+	return NewOxmVlanVidWithBuf(p.Buf), nil
+}
+
+func IsOxmVlanVid(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 12 && p.OxmLength() == 2 && true
+}
+
+func (this OxmVlanVid) Vid() uint16 {
+	offset := this.VidOffset()
+	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	return res
+}
+
+func (this *OxmVlanVid) SetVid(v uint16) {
+	offset := this.VidOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], v)
+	offset += 2
+}
+
+func (this OxmVlanVid) VidOffset() int {
+	offset := 4
+	return offset
+}
+
+func NewOxmVlanPcpWithBuf(b []byte) OxmVlanPcp {
+	return OxmVlanPcp{OxmField{packet.Packet{Buf: b}}}
+}
+
+func NewOxmVlanPcp() OxmVlanPcp {
+	s := packet.PaddedSize(5, 1, 4)
+	b := make([]byte, s)
+	p := OxmVlanPcp{OxmField{packet.Packet{Buf: b}}}
+	p.Init()
+	return p
+}
+
+type OxmVlanPcp struct {
+	OxmField
+}
+
+func (this OxmVlanPcp) minSize() int {
+	return 5
+}
+
+func (this OxmVlanPcp) Clone() (OxmVlanPcp, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewOxmVlanPcp(), err
+	}
+
+	return NewOxmVlanPcpWithBuf(newBuf.Bytes()), nil
+}
+
+type OxmVlanPcpConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewOxmVlanPcpConn(c net.Conn) OxmVlanPcpConn {
+	return OxmVlanPcpConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *OxmVlanPcpConn) WriteOxmVlanPcp(pkt OxmVlanPcp) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *OxmVlanPcpConn) WriteOxmVlanPcps(pkts []OxmVlanPcp) error {
+	for _, p := range pkts {
+		if err := c.WriteOxmVlanPcp(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *OxmVlanPcpConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *OxmVlanPcpConn) ReadOxmVlanPcp() (OxmVlanPcp, error) {
+	pkts := make([]OxmVlanPcp, 1)
+	_, err := c.ReadOxmVlanPcps(pkts)
+	if err != nil {
+		return NewOxmVlanPcp(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *OxmVlanPcpConn) ReadOxmVlanPcps(pkts []OxmVlanPcp) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewOxmVlanPcpWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *OxmVlanPcp) Init() {
+	this.OxmField.Init()
+	this.SetOxmLength(uint8(this.minSize()))
+	// Invariants.
+	this.SetOxmClass(uint16(32768)) // oxm_class
+	this.SetOxmField(uint8(14))     // oxm_field
+	this.SetOxmLength(uint8(1))     // oxm_length
+}
+
+func (this OxmVlanPcp) Size() int {
+	if len(this.Buf) < this.minSize() {
+		return 0
+	}
+
+	size := int(this.OxmLength())
+	return packet.PaddedSize(size, 1, 4)
+}
+
+func ToOxmVlanPcp(p OxmField) (OxmVlanPcp, error) {
+	if !IsOxmVlanPcp(p) {
+		return NewOxmVlanPcpWithBuf(nil), errors.New("Cannot convert to of12.OxmVlanPcp")
+	}
+
+	return NewOxmVlanPcpWithBuf(p.Buf), nil
+}
+
+func IsOxmVlanPcp(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 14 && p.OxmLength() == 1 && true
+}
+
+func (this OxmVlanPcp) Pcp() uint8 {
+	offset := this.PcpOffset()
+	res := uint8(this.Buf[offset])
+	return res
+}
+
+func (this *OxmVlanPcp) SetPcp(p uint8) {
+	offset := this.PcpOffset()
+	this.Buf[offset] = byte(p)
+	offset++
+}
+
+func (this OxmVlanPcp) PcpOffset() int {
+	offset := 4
+	return offset
+}
+
+func NewOxmIpProtoWithBuf(b []byte) OxmIpProto {
+	return OxmIpProto{OxmField{packet.Packet{Buf: b}}}
+}
+
+func NewOxmIpProto() OxmIpProto {
+	s := packet.PaddedSize(5, 1, 4)
+	b := make([]byte, s)
+	p := OxmIpProto{OxmField{packet.Packet{Buf: b}}}
+	p.Init()
+	return p
+}
+
+type OxmIpProto struct {
+	OxmField
+}
+
+func (this OxmIpProto) minSize() int {
+	return 5
+}
+
+func (this OxmIpProto) Clone() (OxmIpProto, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewOxmIpProto(), err
+	}
+
+	return NewOxmIpProtoWithBuf(newBuf.Bytes()), nil
+}
+
+type OxmIpProtoConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewOxmIpProtoConn(c net.Conn) OxmIpProtoConn {
+	return OxmIpProtoConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *OxmIpProtoConn) WriteOxmIpProto(pkt OxmIpProto) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *OxmIpProtoConn) WriteOxmIpProtos(pkts []OxmIpProto) error {
+	for _, p := range pkts {
+		if err := c.WriteOxmIpProto(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *OxmIpProtoConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *OxmIpProtoConn) ReadOxmIpProto() (OxmIpProto, error) {
+	pkts := make([]OxmIpProto, 1)
+	_, err := c.ReadOxmIpProtos(pkts)
+	if err != nil {
+		return NewOxmIpProto(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *OxmIpProtoConn) ReadOxmIpProtos(pkts []OxmIpProto) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewOxmIpProtoWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *OxmIpProto) Init() {
+	this.OxmField.Init()
+	this.SetOxmLength(uint8(this.minSize()))
+	// Invariants.
+	this.SetOxmClass(uint16(32768)) // oxm_class
+	this.SetOxmField(uint8(20))     // oxm_field
+	this.SetOxmLength(uint8(1))     // oxm_length
+}
+
+func (this OxmIpProto) Size() int {
+	if len(this.Buf) < this.minSize() {
+		return 0
+	}
+
+	size := int(this.OxmLength())
+	return packet.PaddedSize(size, 1, 4)
+}
+
+func ToOxmIpProto(p OxmField) (OxmIpProto, error) {
+	if !IsOxmIpProto(p) {
+		return NewOxmIpProtoWithBuf(nil), errors.New("Cannot convert to of12.OxmIpProto")
+	}
+
+	return NewOxmIpProtoWithBuf(p.Buf), nil
+}
+
+func IsOxmIpProto(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 20 && p.OxmLength() == 1 && true
+}
+
+func (this OxmIpProto) Proto() uint8 {
+	offset := this.ProtoOffset()
+	res := uint8(this.Buf[offset])
+	return res
+}
+
+func (this *OxmIpProto) SetProto(p uint8) {
+	offset := this.ProtoOffset()
+	this.Buf[offset] = byte(p)
+	offset++
+}
+
+func (this OxmIpProto) ProtoOffset() int {
+	offset := 4
+	return offset
+}
+
+func NewOxmIpV4SrcWithBuf(b []byte) OxmIpV4Src {
+	return OxmIpV4Src{OxmField{packet.Packet{Buf: b}}}
+}
+
+func NewOxmIpV4Src() OxmIpV4Src {
+	s := packet.PaddedSize(8, 1, 4)
+	b := make([]byte, s)
+	p := OxmIpV4Src{OxmField{packet.Packet{Buf: b}}}
+	p.Init()
+	return p
+}
+
+type OxmIpV4Src struct {
+	OxmField
+}
+
+func (this OxmIpV4Src) minSize() int {
+	return 8
+}
+
+func (this OxmIpV4Src) Clone() (OxmIpV4Src, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewOxmIpV4Src(), err
+	}
+
+	return NewOxmIpV4SrcWithBuf(newBuf.Bytes()), nil
+}
+
+type OxmIpV4SrcConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewOxmIpV4SrcConn(c net.Conn) OxmIpV4SrcConn {
+	return OxmIpV4SrcConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *OxmIpV4SrcConn) WriteOxmIpV4Src(pkt OxmIpV4Src) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *OxmIpV4SrcConn) WriteOxmIpV4Srcs(pkts []OxmIpV4Src) error {
+	for _, p := range pkts {
+		if err := c.WriteOxmIpV4Src(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *OxmIpV4SrcConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *OxmIpV4SrcConn) ReadOxmIpV4Src() (OxmIpV4Src, error) {
+	pkts := make([]OxmIpV4Src, 1)
+	_, err := c.ReadOxmIpV4Srcs(pkts)
+	if err != nil {
+		return NewOxmIpV4Src(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *OxmIpV4SrcConn) ReadOxmIpV4Srcs(pkts []OxmIpV4Src) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewOxmIpV4SrcWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *OxmIpV4Src) Init() {
+	this.OxmField.Init()
+	this.SetOxmLength(uint8(this.minSize()))
+	// Invariants.
+	this.SetOxmClass(uint16(32768)) // oxm_class
+	this.SetOxmField(uint8(22))     // oxm_field
+	this.SetOxmLength(uint8(4))     // oxm_length
+}
+
+func (this OxmIpV4Src) Size() int {
+	if len(this.Buf) < this.minSize() {
+		return 0
+	}
+
+	size := int(this.OxmLength())
+	return packet.PaddedSize(size, 1, 4)
+}
+
+func ToOxmIpV4Src(p OxmField) (OxmIpV4Src, error) {
+	if !IsOxmIpV4Src(p) {
+		return NewOxmIpV4SrcWithBuf(nil), errors.New("Cannot convert to of12.OxmIpV4Src")
+	}
+
+	return NewOxmIpV4SrcWithBuf(p.Buf), nil
+}
+
+func IsOxmIpV4Src(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 22 && p.OxmLength() == 4 && true
+}
+
+func (this OxmIpV4Src) Addr() [4]uint8 {
+	offset := this.AddrOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := 4
+	i := 0
+	var res [4]uint8
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := uint8(this.Buf[offset])
+		if size < 1 {
+			break
+		}
+		size -= 1
+		offset += 1
+		count--
+		res[i] = elem
+		i++
+	}
+	return res
+}
+
+func (this *OxmIpV4Src) SetAddr(a [4]uint8) {
+	offset := this.AddrOffset()
+	for _, e := range a {
+		this.Buf[offset] = byte(e)
+		offset++
+	}
+}
+
+func (this OxmIpV4Src) AddrOffset() int {
+	offset := 4
+	return offset
+}
+
+func NewOxmIpV4SrcMaskedWithBuf(b []byte) OxmIpV4SrcMasked {
+	return OxmIpV4SrcMasked{OxmField{packet.Packet{Buf: b}}}
+}
+
+func NewOxmIpV4SrcMasked() OxmIpV4SrcMasked {
+	s := packet.PaddedSize(12, 1, 4)
+	b := make([]byte, s)
+	p := OxmIpV4SrcMasked{OxmField{packet.Packet{Buf: b}}}
+	p.Init()
+	return p
+}
+
+type OxmIpV4SrcMasked struct {
+	OxmField
+}
+
+func (this OxmIpV4SrcMasked) minSize() int {
+	return 12
+}
+
+func (this OxmIpV4SrcMasked) Clone() (OxmIpV4SrcMasked, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewOxmIpV4SrcMasked(), err
+	}
+
+	return NewOxmIpV4SrcMaskedWithBuf(newBuf.Bytes()), nil
+}
+
+type OxmIpV4SrcMaskedConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewOxmIpV4SrcMaskedConn(c net.Conn) OxmIpV4SrcMaskedConn {
+	return OxmIpV4SrcMaskedConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *OxmIpV4SrcMaskedConn) WriteOxmIpV4SrcMasked(pkt OxmIpV4SrcMasked) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *OxmIpV4SrcMaskedConn) WriteOxmIpV4SrcMaskeds(pkts []OxmIpV4SrcMasked) error {
+	for _, p := range pkts {
+		if err := c.WriteOxmIpV4SrcMasked(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *OxmIpV4SrcMaskedConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *OxmIpV4SrcMaskedConn) ReadOxmIpV4SrcMasked() (OxmIpV4SrcMasked, error) {
+	pkts := make([]OxmIpV4SrcMasked, 1)
+	_, err := c.ReadOxmIpV4SrcMaskeds(pkts)
+	if err != nil {
+		return NewOxmIpV4SrcMasked(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *OxmIpV4SrcMaskedConn) ReadOxmIpV4SrcMaskeds(pkts []OxmIpV4SrcMasked) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewOxmIpV4SrcMaskedWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *OxmIpV4SrcMasked) Init() {
+	this.OxmField.Init()
+	this.SetOxmLength(uint8(this.minSize()))
+	// Invariants.
+	this.SetOxmClass(uint16(32768)) // oxm_class
+	this.SetOxmField(uint8(23))     // oxm_field
+	this.SetOxmLength(uint8(8))     // oxm_length
+}
+
+func (this OxmIpV4SrcMasked) Size() int {
+	if len(this.Buf) < this.minSize() {
+		return 0
+	}
+
+	size := int(this.OxmLength())
+	return packet.PaddedSize(size, 1, 4)
+}
+
+func ToOxmIpV4SrcMasked(p OxmField) (OxmIpV4SrcMasked, error) {
+	if !IsOxmIpV4SrcMasked(p) {
+		return NewOxmIpV4SrcMaskedWithBuf(nil), errors.New("Cannot convert to of12.OxmIpV4SrcMasked")
+	}
+
+	return NewOxmIpV4SrcMaskedWithBuf(p.Buf), nil
+}
+
+func IsOxmIpV4SrcMasked(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 23 && p.OxmLength() == 8 && true
+}
+
+func (this OxmIpV4SrcMasked) Addr() [4]uint8 {
+	offset := this.AddrOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := 4
+	i := 0
+	var res [4]uint8
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := uint8(this.Buf[offset])
+		if size < 1 {
+			break
+		}
+		size -= 1
+		offset += 1
+		count--
+		res[i] = elem
+		i++
+	}
+	return res
+}
+
+func (this *OxmIpV4SrcMasked) SetAddr(a [4]uint8) {
+	offset := this.AddrOffset()
+	for _, e := range a {
+		this.Buf[offset] = byte(e)
+		offset++
+	}
+}
+
+func (this OxmIpV4SrcMasked) AddrOffset() int {
+	offset := 4
+	return offset
+}
+
+func (this OxmIpV4SrcMasked) Mask() [4]uint8 {
+	offset := this.MaskOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := 4
+	i := 0
+	var res [4]uint8
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := uint8(this.Buf[offset])
+		if size < 1 {
+			break
+		}
+		size -= 1
+		offset += 1
+		count--
+		res[i] = elem
+		i++
+	}
+	return res
+}
+
+func (this *OxmIpV4SrcMasked) SetMask(m [4]uint8) {
+	offset := this.MaskOffset()
+	for _, e := range m {
+		this.Buf[offset] = byte(e)
+		offset++
+	}
+}
+
+func (this OxmIpV4SrcMasked) MaskOffset() int {
+	offset := 8
+	return offset
+}
+
+func NewOxmIpV4DstWithBuf(b []byte) OxmIpV4Dst {
+	return OxmIpV4Dst{OxmField{packet.Packet{Buf: b}}}
+}
+
+func NewOxmIpV4Dst() OxmIpV4Dst {
+	s := packet.PaddedSize(8, 1, 4)
+	b := make([]byte, s)
+	p := OxmIpV4Dst{OxmField{packet.Packet{Buf: b}}}
+	p.Init()
+	return p
+}
+
+type OxmIpV4Dst struct {
+	OxmField
+}
+
+func (this OxmIpV4Dst) minSize() int {
+	return 8
+}
+
+func (this OxmIpV4Dst) Clone() (OxmIpV4Dst, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewOxmIpV4Dst(), err
+	}
+
+	return NewOxmIpV4DstWithBuf(newBuf.Bytes()), nil
+}
+
+type OxmIpV4DstConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewOxmIpV4DstConn(c net.Conn) OxmIpV4DstConn {
+	return OxmIpV4DstConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *OxmIpV4DstConn) WriteOxmIpV4Dst(pkt OxmIpV4Dst) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *OxmIpV4DstConn) WriteOxmIpV4Dsts(pkts []OxmIpV4Dst) error {
+	for _, p := range pkts {
+		if err := c.WriteOxmIpV4Dst(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *OxmIpV4DstConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *OxmIpV4DstConn) ReadOxmIpV4Dst() (OxmIpV4Dst, error) {
+	pkts := make([]OxmIpV4Dst, 1)
+	_, err := c.ReadOxmIpV4Dsts(pkts)
+	if err != nil {
+		return NewOxmIpV4Dst(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *OxmIpV4DstConn) ReadOxmIpV4Dsts(pkts []OxmIpV4Dst) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewOxmIpV4DstWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *OxmIpV4Dst) Init() {
+	this.OxmField.Init()
+	this.SetOxmLength(uint8(this.minSize()))
+	// Invariants.
+	this.SetOxmClass(uint16(32768)) // oxm_class
+	this.SetOxmField(uint8(24))     // oxm_field
+	this.SetOxmLength(uint8(4))     // oxm_length
+}
+
+func (this OxmIpV4Dst) Size() int {
+	if len(this.Buf) < this.minSize() {
+		return 0
+	}
+
+	size := int(this.OxmLength())
+	return packet.PaddedSize(size, 1, 4)
+}
+
+func ToOxmIpV4Dst(p OxmField) (OxmIpV4Dst, error) {
+	if !IsOxmIpV4Dst(p) {
+		return NewOxmIpV4DstWithBuf(nil), errors.New("Cannot convert to of12.OxmIpV4Dst")
+	}
+
+	return NewOxmIpV4DstWithBuf(p.Buf), nil
+}
+
+func IsOxmIpV4Dst(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 24 && p.OxmLength() == 4 && true
+}
+
+func (this OxmIpV4Dst) Addr() [4]uint8 {
+	offset := this.AddrOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := 4
+	i := 0
+	var res [4]uint8
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := uint8(this.Buf[offset])
+		if size < 1 {
+			break
+		}
+		size -= 1
+		offset += 1
+		count--
+		res[i] = elem
+		i++
+	}
+	return res
+}
+
+func (this *OxmIpV4Dst) SetAddr(a [4]uint8) {
+	offset := this.AddrOffset()
+	for _, e := range a {
+		this.Buf[offset] = byte(e)
+		offset++
+	}
+}
+
+func (this OxmIpV4Dst) AddrOffset() int {
+	offset := 4
+	return offset
+}
+
+func NewOxmIpV4DstMaskedWithBuf(b []byte) OxmIpV4DstMasked {
+	return OxmIpV4DstMasked{OxmField{packet.Packet{Buf: b}}}
+}
+
+func NewOxmIpV4DstMasked() OxmIpV4DstMasked {
+	s := packet.PaddedSize(12, 1, 4)
+	b := make([]byte, s)
+	p := OxmIpV4DstMasked{OxmField{packet.Packet{Buf: b}}}
+	p.Init()
+	return p
+}
+
+type OxmIpV4DstMasked struct {
+	OxmField
+}
+
+func (this OxmIpV4DstMasked) minSize() int {
+	return 12
+}
+
+func (this OxmIpV4DstMasked) Clone() (OxmIpV4DstMasked, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewOxmIpV4DstMasked(), err
+	}
+
+	return NewOxmIpV4DstMaskedWithBuf(newBuf.Bytes()), nil
+}
+
+type OxmIpV4DstMaskedConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewOxmIpV4DstMaskedConn(c net.Conn) OxmIpV4DstMaskedConn {
+	return OxmIpV4DstMaskedConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *OxmIpV4DstMaskedConn) WriteOxmIpV4DstMasked(pkt OxmIpV4DstMasked) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *OxmIpV4DstMaskedConn) WriteOxmIpV4DstMaskeds(pkts []OxmIpV4DstMasked) error {
+	for _, p := range pkts {
+		if err := c.WriteOxmIpV4DstMasked(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *OxmIpV4DstMaskedConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *OxmIpV4DstMaskedConn) ReadOxmIpV4DstMasked() (OxmIpV4DstMasked, error) {
+	pkts := make([]OxmIpV4DstMasked, 1)
+	_, err := c.ReadOxmIpV4DstMaskeds(pkts)
+	if err != nil {
+		return NewOxmIpV4DstMasked(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *OxmIpV4DstMaskedConn) ReadOxmIpV4DstMaskeds(pkts []OxmIpV4DstMasked) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewOxmIpV4DstMaskedWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *OxmIpV4DstMasked) Init() {
+	this.OxmField.Init()
+	this.SetOxmLength(uint8(this.minSize()))
+	// Invariants.
+	this.SetOxmClass(uint16(32768)) // oxm_class
+	this.SetOxmField(uint8(25))     // oxm_field
+	this.SetOxmLength(uint8(8))     // oxm_length
+}
+
+func (this OxmIpV4DstMasked) Size() int {
+	if len(this.Buf) < this.minSize() {
+		return 0
+	}
+
+	size := int(this.OxmLength())
+	return packet.PaddedSize(size, 1, 4)
+}
+
+func ToOxmIpV4DstMasked(p OxmField) (OxmIpV4DstMasked, error) {
+	if !IsOxmIpV4DstMasked(p) {
+		return NewOxmIpV4DstMaskedWithBuf(nil), errors.New("Cannot convert to of12.OxmIpV4DstMasked")
+	}
+
+	return NewOxmIpV4DstMaskedWithBuf(p.Buf), nil
+}
+
+func IsOxmIpV4DstMasked(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 25 && p.OxmLength() == 8 && true
+}
+
+func (this OxmIpV4DstMasked) Addr() [4]uint8 {
+	offset := this.AddrOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := 4
+	i := 0
+	var res [4]uint8
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := uint8(this.Buf[offset])
+		if size < 1 {
+			break
+		}
+		size -= 1
+		offset += 1
+		count--
+		res[i] = elem
+		i++
+	}
+	return res
+}
+
+func (this *OxmIpV4DstMasked) SetAddr(a [4]uint8) {
+	offset := this.AddrOffset()
+	for _, e := range a {
+		this.Buf[offset] = byte(e)
+		offset++
+	}
+}
+
+func (this OxmIpV4DstMasked) AddrOffset() int {
+	offset := 4
+	return offset
+}
+
+func (this OxmIpV4DstMasked) Mask() [4]uint8 {
+	offset := this.MaskOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := 4
+	i := 0
+	var res [4]uint8
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := uint8(this.Buf[offset])
+		if size < 1 {
+			break
+		}
+		size -= 1
+		offset += 1
+		count--
+		res[i] = elem
+		i++
+	}
+	return res
+}
+
+func (this *OxmIpV4DstMasked) SetMask(m [4]uint8) {
+	offset := this.MaskOffset()
+	for _, e := range m {
+		this.Buf[offset] = byte(e)
+		offset++
+	}
+}
+
+func (this OxmIpV4DstMasked) MaskOffset() int {
+	offset := 8
+	return offset
+}
+
+func NewOxmIpV6SrcWithBuf(b []byte) OxmIpV6Src {
+	return OxmIpV6Src{OxmField{packet.Packet{Buf: b}}}
+}
+
+func NewOxmIpV6Src() OxmIpV6Src {
+	s := packet.PaddedSize(20, 1, 4)
+	b := make([]byte, s)
+	p := OxmIpV6Src{OxmField{packet.Packet{Buf: b}}}
+	p.Init()
+	return p
+}
+
+type OxmIpV6Src struct {
+	OxmField
+}
+
+func (this OxmIpV6Src) minSize() int {
+	return 20
+}
+
+func (this OxmIpV6Src) Clone() (OxmIpV6Src, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewOxmIpV6Src(), err
+	}
+
+	return NewOxmIpV6SrcWithBuf(newBuf.Bytes()), nil
+}
+
+type OxmIpV6SrcConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewOxmIpV6SrcConn(c net.Conn) OxmIpV6SrcConn {
+	return OxmIpV6SrcConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *OxmIpV6SrcConn) WriteOxmIpV6Src(pkt OxmIpV6Src) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *OxmIpV6SrcConn) WriteOxmIpV6Srcs(pkts []OxmIpV6Src) error {
+	for _, p := range pkts {
+		if err := c.WriteOxmIpV6Src(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *OxmIpV6SrcConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *OxmIpV6SrcConn) ReadOxmIpV6Src() (OxmIpV6Src, error) {
+	pkts := make([]OxmIpV6Src, 1)
+	_, err := c.ReadOxmIpV6Srcs(pkts)
+	if err != nil {
+		return NewOxmIpV6Src(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *OxmIpV6SrcConn) ReadOxmIpV6Srcs(pkts []OxmIpV6Src) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewOxmIpV6SrcWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *OxmIpV6Src) Init() {
+	this.OxmField.Init()
+	this.SetOxmLength(uint8(this.minSize()))
+	// Invariants.
+	this.SetOxmClass(uint16(32768)) // oxm_class
+	this.SetOxmField(uint8(52))     // oxm_field
+	this.SetOxmLength(uint8(16))    // oxm_length
+}
+
+func (this OxmIpV6Src) Size() int {
+	if len(this.Buf) < this.minSize() {
+		return 0
+	}
+
+	size := int(this.OxmLength())
+	return packet.PaddedSize(size, 1, 4)
+}
+
+func ToOxmIpV6Src(p OxmField) (OxmIpV6Src, error) {
+	if !IsOxmIpV6Src(p) {
+		return NewOxmIpV6SrcWithBuf(nil), errors.New("Cannot convert to of12.OxmIpV6Src")
+	}
+
+	return NewOxmIpV6SrcWithBuf(p.Buf), nil
+}
+
+func IsOxmIpV6Src(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 52 && p.OxmLength() == 16 && true
+}
+
+func (this OxmIpV6Src) Addr() [16]uint8 {
+	offset := this.AddrOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := 16
+	i := 0
+	var res [16]uint8
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := uint8(this.Buf[offset])
+		if size < 1 {
+			break
+		}
+		size -= 1
+		offset += 1
+		count--
+		res[i] = elem
+		i++
+	}
+	return res
+}
+
+func (this *OxmIpV6Src) SetAddr(a [16]uint8) {
+	offset := this.AddrOffset()
+	for _, e := range a {
+		this.Buf[offset] = byte(e)
+		offset++
+	}
+}
+
+func (this OxmIpV6Src) AddrOffset() int {
+	offset := 4
+	return offset
+}
+
+func NewOxmIpV6SrcMaskedWithBuf(b []byte) OxmIpV6SrcMasked {
+	return OxmIpV6SrcMasked{OxmField{packet.Packet{Buf: b}}}
+}
+
+func NewOxmIpV6SrcMasked() OxmIpV6SrcMasked {
+	s := packet.PaddedSize(36, 1, 4)
+	b := make([]byte, s)
+	p := OxmIpV6SrcMasked{OxmField{packet.Packet{Buf: b}}}
+	p.Init()
+	return p
+}
+
+type OxmIpV6SrcMasked struct {
+	OxmField
+}
+
+func (this OxmIpV6SrcMasked) minSize() int {
+	return 36
+}
+
+func (this OxmIpV6SrcMasked) Clone() (OxmIpV6SrcMasked, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewOxmIpV6SrcMasked(), err
+	}
+
+	return NewOxmIpV6SrcMaskedWithBuf(newBuf.Bytes()), nil
+}
+
+type OxmIpV6SrcMaskedConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewOxmIpV6SrcMaskedConn(c net.Conn) OxmIpV6SrcMaskedConn {
+	return OxmIpV6SrcMaskedConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *OxmIpV6SrcMaskedConn) WriteOxmIpV6SrcMasked(pkt OxmIpV6SrcMasked) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *OxmIpV6SrcMaskedConn) WriteOxmIpV6SrcMaskeds(pkts []OxmIpV6SrcMasked) error {
+	for _, p := range pkts {
+		if err := c.WriteOxmIpV6SrcMasked(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *OxmIpV6SrcMaskedConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *OxmIpV6SrcMaskedConn) ReadOxmIpV6SrcMasked() (OxmIpV6SrcMasked, error) {
+	pkts := make([]OxmIpV6SrcMasked, 1)
+	_, err := c.ReadOxmIpV6SrcMaskeds(pkts)
+	if err != nil {
+		return NewOxmIpV6SrcMasked(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *OxmIpV6SrcMaskedConn) ReadOxmIpV6SrcMaskeds(pkts []OxmIpV6SrcMasked) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewOxmIpV6SrcMaskedWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *OxmIpV6SrcMasked) Init() {
+	this.OxmField.Init()
+	this.SetOxmLength(uint8(this.minSize()))
+	// Invariants.
+	this.SetOxmClass(uint16(32768)) // oxm_class
+	this.SetOxmField(uint8(53))     // oxm_field
+	this.SetOxmLength(uint8(32))    // oxm_length
+}
+
+func (this OxmIpV6SrcMasked) Size() int {
+	if len(this.Buf) < this.minSize() {
+		return 0
+	}
+
+	size := int(this.OxmLength())
+	return packet.PaddedSize(size, 1, 4)
+}
+
+func ToOxmIpV6SrcMasked(p OxmField) (OxmIpV6SrcMasked, error) {
+	if !IsOxmIpV6SrcMasked(p) {
+		return NewOxmIpV6SrcMaskedWithBuf(nil), errors.New("Cannot convert to of12.OxmIpV6SrcMasked")
+	}
+
+	return NewOxmIpV6SrcMaskedWithBuf(p.Buf), nil
+}
+
+func IsOxmIpV6SrcMasked(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 53 && p.OxmLength() == 32 && true
+}
+
+func (this OxmIpV6SrcMasked) Addr() [16]uint8 {
+	offset := this.AddrOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := 16
+	i := 0
+	var res [16]uint8
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := uint8(this.Buf[offset])
+		if size < 1 {
+			break
+		}
+		size -= 1
+		offset += 1
+		count--
+		res[i] = elem
+		i++
+	}
+	return res
+}
+
+func (this *OxmIpV6SrcMasked) SetAddr(a [16]uint8) {
+	offset := this.AddrOffset()
+	for _, e := range a {
+		this.Buf[offset] = byte(e)
+		offset++
+	}
+}
+
+func (this OxmIpV6SrcMasked) AddrOffset() int {
+	offset := 4
+	return offset
+}
+
+func (this OxmIpV6SrcMasked) Mask() [16]uint8 {
+	offset := this.MaskOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := 16
+	i := 0
+	var res [16]uint8
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := uint8(this.Buf[offset])
+		if size < 1 {
+			break
+		}
+		size -= 1
+		offset += 1
+		count--
+		res[i] = elem
+		i++
+	}
+	return res
+}
+
+func (this *OxmIpV6SrcMasked) SetMask(m [16]uint8) {
+	offset := this.MaskOffset()
+	for _, e := range m {
+		this.Buf[offset] = byte(e)
+		offset++
+	}
+}
+
+func (this OxmIpV6SrcMasked) MaskOffset() int {
+	offset := 20
+	return offset
+}
+
+func NewOxmIpV6DstWithBuf(b []byte) OxmIpV6Dst {
+	return OxmIpV6Dst{OxmField{packet.Packet{Buf: b}}}
+}
+
+func NewOxmIpV6Dst() OxmIpV6Dst {
+	s := packet.PaddedSize(20, 1, 4)
+	b := make([]byte, s)
+	p := OxmIpV6Dst{OxmField{packet.Packet{Buf: b}}}
+	p.Init()
+	return p
+}
+
+type OxmIpV6Dst struct {
+	OxmField
+}
+
+func (this OxmIpV6Dst) minSize() int {
+	return 20
+}
+
+func (this OxmIpV6Dst) Clone() (OxmIpV6Dst, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewOxmIpV6Dst(), err
+	}
+
+	return NewOxmIpV6DstWithBuf(newBuf.Bytes()), nil
+}
+
+type OxmIpV6DstConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewOxmIpV6DstConn(c net.Conn) OxmIpV6DstConn {
+	return OxmIpV6DstConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *OxmIpV6DstConn) WriteOxmIpV6Dst(pkt OxmIpV6Dst) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *OxmIpV6DstConn) WriteOxmIpV6Dsts(pkts []OxmIpV6Dst) error {
+	for _, p := range pkts {
+		if err := c.WriteOxmIpV6Dst(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *OxmIpV6DstConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *OxmIpV6DstConn) ReadOxmIpV6Dst() (OxmIpV6Dst, error) {
+	pkts := make([]OxmIpV6Dst, 1)
+	_, err := c.ReadOxmIpV6Dsts(pkts)
+	if err != nil {
+		return NewOxmIpV6Dst(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *OxmIpV6DstConn) ReadOxmIpV6Dsts(pkts []OxmIpV6Dst) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewOxmIpV6DstWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *OxmIpV6Dst) Init() {
+	this.OxmField.Init()
+	this.SetOxmLength(uint8(this.minSize()))
+	// Invariants.
+	this.SetOxmClass(uint16(32768)) // oxm_class
+	this.SetOxmField(uint8(54))     // oxm_field
+	this.SetOxmLength(uint8(16))    // oxm_length
+}
+
+func (this OxmIpV6Dst) Size() int {
+	if len(this.Buf) < this.minSize() {
+		return 0
+	}
+
+	size := int(this.OxmLength())
+	return packet.PaddedSize(size, 1, 4)
+}
+
+func ToOxmIpV6Dst(p OxmField) (OxmIpV6Dst, error) {
+	if !IsOxmIpV6Dst(p) {
+		return NewOxmIpV6DstWithBuf(nil), errors.New("Cannot convert to of12.OxmIpV6Dst")
+	}
+
+	return NewOxmIpV6DstWithBuf(p.Buf), nil
+}
+
+func IsOxmIpV6Dst(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 54 && p.OxmLength() == 16 && true
+}
+
+func (this OxmIpV6Dst) Addr() [16]uint8 {
+	offset := this.AddrOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := 16
+	i := 0
+	var res [16]uint8
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := uint8(this.Buf[offset])
+		if size < 1 {
+			break
+		}
+		size -= 1
+		offset += 1
+		count--
+		res[i] = elem
+		i++
+	}
+	return res
+}
+
+func (this *OxmIpV6Dst) SetAddr(a [16]uint8) {
+	offset := this.AddrOffset()
+	for _, e := range a {
+		this.Buf[offset] = byte(e)
+		offset++
+	}
+}
+
+func (this OxmIpV6Dst) AddrOffset() int {
+	offset := 4
+	return offset
+}
+
+func NewOxmIpV6DstMaskedWithBuf(b []byte) OxmIpV6DstMasked {
+	return OxmIpV6DstMasked{OxmField{packet.Packet{Buf: b}}}
+}
+
+func NewOxmIpV6DstMasked() OxmIpV6DstMasked {
+	s := packet.PaddedSize(36, 1, 4)
+	b := make([]byte, s)
+	p := OxmIpV6DstMasked{OxmField{packet.Packet{Buf: b}}}
+	p.Init()
+	return p
+}
+
+type OxmIpV6DstMasked struct {
+	OxmField
+}
+
+func (this OxmIpV6DstMasked) minSize() int {
+	return 36
+}
+
+func (this OxmIpV6DstMasked) Clone() (OxmIpV6DstMasked, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewOxmIpV6DstMasked(), err
+	}
+
+	return NewOxmIpV6DstMaskedWithBuf(newBuf.Bytes()), nil
+}
+
+type OxmIpV6DstMaskedConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewOxmIpV6DstMaskedConn(c net.Conn) OxmIpV6DstMaskedConn {
+	return OxmIpV6DstMaskedConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *OxmIpV6DstMaskedConn) WriteOxmIpV6DstMasked(pkt OxmIpV6DstMasked) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *OxmIpV6DstMaskedConn) WriteOxmIpV6DstMaskeds(pkts []OxmIpV6DstMasked) error {
+	for _, p := range pkts {
+		if err := c.WriteOxmIpV6DstMasked(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *OxmIpV6DstMaskedConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *OxmIpV6DstMaskedConn) ReadOxmIpV6DstMasked() (OxmIpV6DstMasked, error) {
+	pkts := make([]OxmIpV6DstMasked, 1)
+	_, err := c.ReadOxmIpV6DstMaskeds(pkts)
+	if err != nil {
+		return NewOxmIpV6DstMasked(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *OxmIpV6DstMaskedConn) ReadOxmIpV6DstMaskeds(pkts []OxmIpV6DstMasked) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewOxmIpV6DstMaskedWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *OxmIpV6DstMasked) Init() {
+	this.OxmField.Init()
+	this.SetOxmLength(uint8(this.minSize()))
+	// Invariants.
+	this.SetOxmClass(uint16(32768)) // oxm_class
+	this.SetOxmField(uint8(55))     // oxm_field
+	this.SetOxmLength(uint8(32))    // oxm_length
+}
+
+func (this OxmIpV6DstMasked) Size() int {
+	if len(this.Buf) < this.minSize() {
+		return 0
+	}
+
+	size := int(this.OxmLength())
+	return packet.PaddedSize(size, 1, 4)
+}
+
+func ToOxmIpV6DstMasked(p OxmField) (OxmIpV6DstMasked, error) {
+	if !IsOxmIpV6DstMasked(p) {
+		return NewOxmIpV6DstMaskedWithBuf(nil), errors.New("Cannot convert to of12.OxmIpV6DstMasked")
+	}
+
+	return NewOxmIpV6DstMaskedWithBuf(p.Buf), nil
+}
+
+func IsOxmIpV6DstMasked(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 55 && p.OxmLength() == 32 && true
+}
+
+func (this OxmIpV6DstMasked) Addr() [16]uint8 {
+	offset := this.AddrOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := 16
+	i := 0
+	var res [16]uint8
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := uint8(this.Buf[offset])
+		if size < 1 {
+			break
+		}
+		size -= 1
+		offset += 1
+		count--
+		res[i] = elem
+		i++
+	}
+	return res
+}
+
+func (this *OxmIpV6DstMasked) SetAddr(a [16]uint8) {
+	offset := this.AddrOffset()
+	for _, e := range a {
+		this.Buf[offset] = byte(e)
+		offset++
+	}
+}
+
+func (this OxmIpV6DstMasked) AddrOffset() int {
+	offset := 4
+	return offset
+}
+
+func (this OxmIpV6DstMasked) Mask() [16]uint8 {
+	offset := this.MaskOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := 16
+	i := 0
+	var res [16]uint8
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := uint8(this.Buf[offset])
+		if size < 1 {
+			break
+		}
+		size -= 1
+		offset += 1
+		count--
+		res[i] = elem
+		i++
+	}
+	return res
+}
+
+func (this *OxmIpV6DstMasked) SetMask(m [16]uint8) {
+	offset := this.MaskOffset()
+	for _, e := range m {
+		this.Buf[offset] = byte(e)
+		offset++
+	}
+}
+
+func (this OxmIpV6DstMasked) MaskOffset() int {
+	offset := 20
+	return offset
+}
+
+func NewOxmTcpSrcWithBuf(b []byte) OxmTcpSrc {
+	return OxmTcpSrc{OxmField{packet.Packet{Buf: b}}}
+}
+
+func NewOxmTcpSrc() OxmTcpSrc {
+	s := packet.PaddedSize(6, 1, 4)
+	b := make([]byte, s)
+	p := OxmTcpSrc{OxmField{packet.Packet{Buf: b}}}
+	p.Init()
+	return p
+}
+
+type OxmTcpSrc struct {
+	OxmField
+}
+
+func (this OxmTcpSrc) minSize() int {
+	return 6
+}
+
+func (this OxmTcpSrc) Clone() (OxmTcpSrc, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewOxmTcpSrc(), err
+	}
+
+	return NewOxmTcpSrcWithBuf(newBuf.Bytes()), nil
+}
+
+type OxmTcpSrcConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewOxmTcpSrcConn(c net.Conn) OxmTcpSrcConn {
+	return OxmTcpSrcConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *OxmTcpSrcConn) WriteOxmTcpSrc(pkt OxmTcpSrc) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *OxmTcpSrcConn) WriteOxmTcpSrcs(pkts []OxmTcpSrc) error {
+	for _, p := range pkts {
+		if err := c.WriteOxmTcpSrc(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *OxmTcpSrcConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *OxmTcpSrcConn) ReadOxmTcpSrc() (OxmTcpSrc, error) {
+	pkts := make([]OxmTcpSrc, 1)
+	_, err := c.ReadOxmTcpSrcs(pkts)
+	if err != nil {
+		return NewOxmTcpSrc(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *OxmTcpSrcConn) ReadOxmTcpSrcs(pkts []OxmTcpSrc) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewOxmTcpSrcWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *OxmTcpSrc) Init() {
+	this.OxmField.Init()
+	this.SetOxmLength(uint8(this.minSize()))
+	// Invariants.
+	this.SetOxmClass(uint16(32768)) // oxm_class
+	this.SetOxmField(uint8(26))     // oxm_field
+	this.SetOxmLength(uint8(2))     // oxm_length
+}
+
+func (this OxmTcpSrc) Size() int {
+	if len(this.Buf) < this.minSize() {
+		return 0
+	}
+
+	size := int(this.OxmLength())
+	return packet.PaddedSize(size, 1, 4)
+}
+
+func ToOxmTcpSrc(p OxmField) (OxmTcpSrc, error) {
+	if !IsOxmTcpSrc(p) {
+		return NewOxmTcpSrcWithBuf(nil), errors.New("Cannot convert to of12.OxmTcpSrc")
+	}
+
+	return NewOxmTcpSrcWithBuf(p.Buf), nil
+}
+
+func IsOxmTcpSrc(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 26 && p.OxmLength() == 2 && true
+}
+
+func (this OxmTcpSrc) Port() uint16 {
+	offset := this.PortOffset()
+	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	return res
+}
+
+func (this *OxmTcpSrc) SetPort(p uint16) {
+	offset := this.PortOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], p)
+	offset += 2
+}
+
+func (this OxmTcpSrc) PortOffset() int {
+	offset := 4
+	return offset
+}
+
+func NewOxmTcpDstWithBuf(b []byte) OxmTcpDst {
+	return OxmTcpDst{OxmField{packet.Packet{Buf: b}}}
+}
+
+func NewOxmTcpDst() OxmTcpDst {
+	s := packet.PaddedSize(6, 1, 4)
+	b := make([]byte, s)
+	p := OxmTcpDst{OxmField{packet.Packet{Buf: b}}}
+	p.Init()
+	return p
+}
+
+type OxmTcpDst struct {
+	OxmField
+}
+
+func (this OxmTcpDst) minSize() int {
+	return 6
+}
+
+func (this OxmTcpDst) Clone() (OxmTcpDst, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewOxmTcpDst(), err
+	}
+
+	return NewOxmTcpDstWithBuf(newBuf.Bytes()), nil
+}
+
+type OxmTcpDstConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewOxmTcpDstConn(c net.Conn) OxmTcpDstConn {
+	return OxmTcpDstConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *OxmTcpDstConn) WriteOxmTcpDst(pkt OxmTcpDst) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *OxmTcpDstConn) WriteOxmTcpDsts(pkts []OxmTcpDst) error {
+	for _, p := range pkts {
+		if err := c.WriteOxmTcpDst(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *OxmTcpDstConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *OxmTcpDstConn) ReadOxmTcpDst() (OxmTcpDst, error) {
+	pkts := make([]OxmTcpDst, 1)
+	_, err := c.ReadOxmTcpDsts(pkts)
+	if err != nil {
+		return NewOxmTcpDst(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *OxmTcpDstConn) ReadOxmTcpDsts(pkts []OxmTcpDst) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewOxmTcpDstWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *OxmTcpDst) Init() {
+	this.OxmField.Init()
+	this.SetOxmLength(uint8(this.minSize()))
+	// Invariants.
+	this.SetOxmClass(uint16(32768)) // oxm_class
+	this.SetOxmField(uint8(28))     // oxm_field
+	this.SetOxmLength(uint8(2))     // oxm_length
+}
+
+func (this OxmTcpDst) Size() int {
+	if len(this.Buf) < this.minSize() {
+		return 0
+	}
+
+	size := int(this.OxmLength())
+	return packet.PaddedSize(size, 1, 4)
 }
 
-func (this PacketOut) ActionsSize() int {
-	return int(this.ActionsLen())
+func ToOxmTcpDst(p OxmField) (OxmTcpDst, error) {
+	if !IsOxmTcpDst(p) {
+		return NewOxmTcpDstWithBuf(nil), errors.New("Cannot convert to of12.OxmTcpDst")
+	}
+
+	return NewOxmTcpDstWithBuf(p.Buf), nil
 }
 
-func (this PacketOut) Data() []uint8 {
-	offset := this.DataOffset()
-	packet_size := this.Size()
-	size := packet_size - offset
-	return []uint8(this.Buf[offset : offset+size])
+func IsOxmTcpDst(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 28 && p.OxmLength() == 2 && true
 }
 
-func (this *PacketOut) AddData(d uint8) {
-	offset := this.DataOffset()
-	offset += this.DataSize()
-	size := 1
-	pSize := this.Size()
-	this.OpenGap(offset, size, pSize)
-	this.SetLength(uint16(pSize + size))
-	this.Buf[offset] = byte(d)
-	offset++
+func (this OxmTcpDst) Port() uint16 {
+	offset := this.PortOffset()
+	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	return res
 }
 
-func (this PacketOut) DataOffset() int {
-	offset := 24
-	offset += this.ActionsSize()
-	return offset
+func (this *OxmTcpDst) SetPort(p uint16) {
+	offset := this.PortOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], p)
+	offset += 2
 }
 
-func (this PacketOut) DataSize() int {
-	offset := this.DataOffset()
-	size := this.Size()
-	return size - offset
+func (this OxmTcpDst) PortOffset() int {
+	offset := 4
+	return offset
 }
 
-func NewOxmFieldWithBuf(b []byte) OxmField {
-	return OxmField{packet.Packet{Buf: b}}
+func NewOxmUdpSrcWithBuf(b []byte) OxmUdpSrc {
+	return OxmUdpSrc{OxmField{packet.Packet{Buf: b}}}
 }
 
-func NewOxmField() OxmField {
-	s := packet.PaddedSize(4, 1, 4)
+func NewOxmUdpSrc() OxmUdpSrc {
+	s := packet.PaddedSize(6, 1, 4)
 	b := make([]byte, s)
-	p := OxmField{packet.Packet{Buf: b}}
+	p := OxmUdpSrc{OxmField{packet.Packet{Buf: b}}}
 	p.Init()
 	return p
 }
 
-type OxmField struct {
-	packet.Packet
+type OxmUdpSrc struct {
+	OxmField
 }
 
-func (this OxmField) minSize() int {
-	return 4
+func (this OxmUdpSrc) minSize() int {
+	return 6
 }
 
-func (this OxmField) Clone() (OxmField, error) {
+func (this OxmUdpSrc) Clone() (OxmUdpSrc, error) {
 	var newBuf bytes.Buffer
 	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
 	if err != nil {
-		return NewOxmField(), err
+		return NewOxmUdpSrc(), err
 	}
 
-	return NewOxmFieldWithBuf(newBuf.Bytes()), nil
+	return NewOxmUdpSrcWithBuf(newBuf.Bytes()), nil
 }
 
-type OxmFieldConn struct {
+type OxmUdpSrcConn struct {
 	net.Conn
 	w      *bufio.Writer
 	buf    []byte
 	offset int
 }
 
-func NewOxmFieldConn(c net.Conn) OxmFieldConn {
-	return OxmFieldConn{
+func NewOxmUdpSrcConn(c net.Conn) OxmUdpSrcConn {
+	return OxmUdpSrcConn{
 		Conn: c,
 		w:    bufio.NewWriter(c),
 		buf:  make([]byte, packet.DefaultBufSize),
 	}
 }
 
-func (c *OxmFieldConn) WriteOxmField(pkt OxmField) error {
+func (c *OxmUdpSrcConn) WriteOxmUdpSrc(pkt OxmUdpSrc) error {
 	s := pkt.Size()
 	b := pkt.Buffer()[:s]
 	n := 0
@@ -4163,30 +8805,30 @@ func (c *OxmFieldConn) WriteOxmField(pkt OxmField) error {
 	return nil
 }
 
-func (c *OxmFieldConn) WriteOxmFields(pkts []OxmField) error {
+func (c *OxmUdpSrcConn) WriteOxmUdpSrcs(pkts []OxmUdpSrc) error {
 	for _, p := range pkts {
-		if err := c.WriteOxmField(p); err != nil {
+		if err := c.WriteOxmUdpSrc(p); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (c *OxmFieldConn) Flush() error {
+func (c *OxmUdpSrcConn) Flush() error {
 	return c.w.Flush()
 }
 
-func (c *OxmFieldConn) ReadOxmField() (OxmField, error) {
-	pkts := make([]OxmField, 1)
-	_, err := c.ReadOxmFields(pkts)
+func (c *OxmUdpSrcConn) ReadOxmUdpSrc() (OxmUdpSrc, error) {
+	pkts := make([]OxmUdpSrc, 1)
+	_, err := c.ReadOxmUdpSrcs(pkts)
 	if err != nil {
-		return NewOxmField(), err
+		return NewOxmUdpSrc(), err
 	}
 
 	return pkts[0], nil
 }
 
-func (c *OxmFieldConn) ReadOxmFields(pkts []OxmField) (int, error) {
+func (c *OxmUdpSrcConn) ReadOxmUdpSrcs(pkts []OxmUdpSrc) (int, error) {
 	if len(c.buf) == c.offset {
 		newSize := packet.DefaultBufSize
 		if newSize < len(c.buf) {
@@ -4208,7 +8850,7 @@ func (c *OxmFieldConn) ReadOxmFields(pkts []OxmField) (int, error) {
 	s := 0
 	n := 0
 	for i := range pkts {
-		p := NewOxmFieldWithBuf(c.buf[s:])
+		p := NewOxmUdpSrcWithBuf(c.buf[s:])
 
 		pSize := p.Size()
 		if pSize == 0 || r < s+pSize {
@@ -4229,12 +8871,16 @@ func (c *OxmFieldConn) ReadOxmFields(pkts []OxmField) (int, error) {
 	return n, nil
 }
 
-func (this *OxmField) Init() {
+func (this *OxmUdpSrc) Init() {
+	this.OxmField.Init()
 	this.SetOxmLength(uint8(this.minSize()))
 	// Invariants.
+	this.SetOxmClass(uint16(32768)) // oxm_class
+	this.SetOxmField(uint8(30))     // oxm_field
+	this.SetOxmLength(uint8(2))     // oxm_length
 }
 
-func (this OxmField) Size() int {
+func (this OxmUdpSrc) Size() int {
 	if len(this.Buf) < this.minSize() {
 		return 0
 	}
@@ -4243,115 +8889,81 @@ func (this OxmField) Size() int {
 	return packet.PaddedSize(size, 1, 4)
 }
 
-func ToOxmField(p packet.Packet) (OxmField, error) {
-	if !IsOxmField(p) {
-		return NewOxmFieldWithBuf(nil), errors.New("Cannot convert to of12.OxmField")
+func ToOxmUdpSrc(p OxmField) (OxmUdpSrc, error) {
+	if !IsOxmUdpSrc(p) {
+		return NewOxmUdpSrcWithBuf(nil), errors.New("Cannot convert to of12.OxmUdpSrc")
 	}
 
-	return NewOxmFieldWithBuf(p.Buf), nil
+	return NewOxmUdpSrcWithBuf(p.Buf), nil
 }
 
-func IsOxmField(p packet.Packet) bool {
-	return true
+func IsOxmUdpSrc(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 30 && p.OxmLength() == 2 && true
 }
 
-func (this OxmField) OxmClass() uint16 {
-	offset := this.OxmClassOffset()
+func (this OxmUdpSrc) Port() uint16 {
+	offset := this.PortOffset()
 	res := binary.BigEndian.Uint16(this.Buf[offset:])
 	return res
 }
 
-func (this *OxmField) SetOxmClass(o uint16) {
-	offset := this.OxmClassOffset()
-	binary.BigEndian.PutUint16(this.Buf[offset:], o)
+func (this *OxmUdpSrc) SetPort(p uint16) {
+	offset := this.PortOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], p)
 	offset += 2
 }
 
-func (this OxmField) OxmClassOffset() int {
-	offset := 0
-	return offset
-}
-
-func (this OxmField) OxmField() uint8 {
-	offset := this.OxmFieldOffset()
-	res := uint8(this.Buf[offset])
-	return res
-}
-
-func (this *OxmField) SetOxmField(o uint8) {
-	offset := this.OxmFieldOffset()
-	this.Buf[offset] = byte(o)
-	offset++
-}
-
-func (this OxmField) OxmFieldOffset() int {
-	offset := 2
-	return offset
-}
-
-func (this OxmField) OxmLength() uint8 {
-	offset := this.OxmLengthOffset()
-	res := uint8(this.Buf[offset])
-	return res
-}
-
-func (this *OxmField) SetOxmLength(o uint8) {
-	offset := this.OxmLengthOffset()
-	this.Buf[offset] = byte(o)
-	offset++
-}
-
-func (this OxmField) OxmLengthOffset() int {
-	offset := 3
+func (this OxmUdpSrc) PortOffset() int {
+	offset := 4
 	return offset
 }
 
-func NewOxmInPortWithBuf(b []byte) OxmInPort {
-	return OxmInPort{OxmField{packet.Packet{Buf: b}}}
+func NewOxmUdpDstWithBuf(b []byte) OxmUdpDst {
+	return OxmUdpDst{OxmField{packet.Packet{Buf: b}}}
 }
 
-func NewOxmInPort() OxmInPort {
-	s := packet.PaddedSize(8, 1, 4)
+func NewOxmUdpDst() OxmUdpDst {
+	s := packet.PaddedSize(6, 1, 4)
 	b := make([]byte, s)
-	p := OxmInPort{OxmField{packet.Packet{Buf: b}}}
+	p := OxmUdpDst{OxmField{packet.Packet{Buf: b}}}
 	p.Init()
 	return p
 }
 
-type OxmInPort struct {
+type OxmUdpDst struct {
 	OxmField
 }
 
-func (this OxmInPort) minSize() int {
-	return 8
+func (this OxmUdpDst) minSize() int {
+	return 6
 }
 
-func (this OxmInPort) Clone() (OxmInPort, error) {
+func (this OxmUdpDst) Clone() (OxmUdpDst, error) {
 	var newBuf bytes.Buffer
 	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
 	if err != nil {
-		return NewOxmInPort(), err
+		return NewOxmUdpDst(), err
 	}
 
-	return NewOxmInPortWithBuf(newBuf.Bytes()), nil
+	return NewOxmUdpDstWithBuf(newBuf.Bytes()), nil
 }
 
-type OxmInPortConn struct {
+type OxmUdpDstConn struct {
 	net.Conn
 	w      *bufio.Writer
 	buf    []byte
 	offset int
 }
 
-func NewOxmInPortConn(c net.Conn) OxmInPortConn {
-	return OxmInPortConn{
+func NewOxmUdpDstConn(c net.Conn) OxmUdpDstConn {
+	return OxmUdpDstConn{
 		Conn: c,
 		w:    bufio.NewWriter(c),
 		buf:  make([]byte, packet.DefaultBufSize),
 	}
 }
 
-func (c *OxmInPortConn) WriteOxmInPort(pkt OxmInPort) error {
+func (c *OxmUdpDstConn) WriteOxmUdpDst(pkt OxmUdpDst) error {
 	s := pkt.Size()
 	b := pkt.Buffer()[:s]
 	n := 0
@@ -4366,30 +8978,30 @@ func (c *OxmInPortConn) WriteOxmInPort(pkt OxmInPort) error {
 	return nil
 }
 
-func (c *OxmInPortConn) WriteOxmInPorts(pkts []OxmInPort) error {
+func (c *OxmUdpDstConn) WriteOxmUdpDsts(pkts []OxmUdpDst) error {
 	for _, p := range pkts {
-		if err := c.WriteOxmInPort(p); err != nil {
+		if err := c.WriteOxmUdpDst(p); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (c *OxmInPortConn) Flush() error {
+func (c *OxmUdpDstConn) Flush() error {
 	return c.w.Flush()
 }
 
-func (c *OxmInPortConn) ReadOxmInPort() (OxmInPort, error) {
-	pkts := make([]OxmInPort, 1)
-	_, err := c.ReadOxmInPorts(pkts)
+func (c *OxmUdpDstConn) ReadOxmUdpDst() (OxmUdpDst, error) {
+	pkts := make([]OxmUdpDst, 1)
+	_, err := c.ReadOxmUdpDsts(pkts)
 	if err != nil {
-		return NewOxmInPort(), err
+		return NewOxmUdpDst(), err
 	}
 
 	return pkts[0], nil
 }
 
-func (c *OxmInPortConn) ReadOxmInPorts(pkts []OxmInPort) (int, error) {
+func (c *OxmUdpDstConn) ReadOxmUdpDsts(pkts []OxmUdpDst) (int, error) {
 	if len(c.buf) == c.offset {
 		newSize := packet.DefaultBufSize
 		if newSize < len(c.buf) {
@@ -4411,7 +9023,7 @@ func (c *OxmInPortConn) ReadOxmInPorts(pkts []OxmInPort) (int, error) {
 	s := 0
 	n := 0
 	for i := range pkts {
-		p := NewOxmInPortWithBuf(c.buf[s:])
+		p := NewOxmUdpDstWithBuf(c.buf[s:])
 
 		pSize := p.Size()
 		if pSize == 0 || r < s+pSize {
@@ -4432,16 +9044,16 @@ func (c *OxmInPortConn) ReadOxmInPorts(pkts []OxmInPort) (int, error) {
 	return n, nil
 }
 
-func (this *OxmInPort) Init() {
+func (this *OxmUdpDst) Init() {
 	this.OxmField.Init()
 	this.SetOxmLength(uint8(this.minSize()))
 	// Invariants.
 	this.SetOxmClass(uint16(32768)) // oxm_class
-	this.SetOxmField(uint8(0))      // oxm_field
-	this.SetOxmLength(uint8(4))     // oxm_length
+	this.SetOxmField(uint8(32))     // oxm_field
+	this.SetOxmLength(uint8(2))     // oxm_length
 }
 
-func (this OxmInPort) Size() int {
+func (this OxmUdpDst) Size() int {
 	if len(this.Buf) < this.minSize() {
 		return 0
 	}
@@ -4450,81 +9062,81 @@ func (this OxmInPort) Size() int {
 	return packet.PaddedSize(size, 1, 4)
 }
 
-func ToOxmInPort(p OxmField) (OxmInPort, error) {
-	if !IsOxmInPort(p) {
-		return NewOxmInPortWithBuf(nil), errors.New("Cannot convert to of12.OxmInPort")
+func ToOxmUdpDst(p OxmField) (OxmUdpDst, error) {
+	if !IsOxmUdpDst(p) {
+		return NewOxmUdpDstWithBuf(nil), errors.New("Cannot convert to of12.OxmUdpDst")
 	}
 
-	return NewOxmInPortWithBuf(p.Buf), nil
+	return NewOxmUdpDstWithBuf(p.Buf), nil
 }
 
-func IsOxmInPort(p OxmField) bool {
-	return p.OxmClass() == 32768 && p.OxmField() == 0 && p.OxmLength() == 4 && true
+func IsOxmUdpDst(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 32 && p.OxmLength() == 2 && true
 }
 
-func (this OxmInPort) InPort() uint32 {
-	offset := this.InPortOffset()
-	res := binary.BigEndian.Uint32(this.Buf[offset:])
+func (this OxmUdpDst) Port() uint16 {
+	offset := this.PortOffset()
+	res := binary.BigEndian.Uint16(this.Buf[offset:])
 	return res
 }
 
-func (this *OxmInPort) SetInPort(i uint32) {
-	offset := this.InPortOffset()
-	binary.BigEndian.PutUint32(this.Buf[offset:], i)
-	offset += 4
+func (this *OxmUdpDst) SetPort(p uint16) {
+	offset := this.PortOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], p)
+	offset += 2
 }
 
-func (this OxmInPort) InPortOffset() int {
+func (this OxmUdpDst) PortOffset() int {
 	offset := 4
 	return offset
 }
 
-func NewOxmEthDstWithBuf(b []byte) OxmEthDst {
-	return OxmEthDst{OxmField{packet.Packet{Buf: b}}}
+func NewOxmMetadataWithBuf(b []byte) OxmMetadata {
+	return OxmMetadata{OxmField{packet.Packet{Buf: b}}}
 }
 
-func NewOxmEthDst() OxmEthDst {
-	s := packet.PaddedSize(10, 1, 4)
+func NewOxmMetadata() OxmMetadata {
+	s := packet.PaddedSize(12, 1, 4)
 	b := make([]byte, s)
-	p := OxmEthDst{OxmField{packet.Packet{Buf: b}}}
+	p := OxmMetadata{OxmField{packet.Packet{Buf: b}}}
 	p.Init()
 	return p
 }
 
-type OxmEthDst struct {
+type OxmMetadata struct {
 	OxmField
 }
 
-func (this OxmEthDst) minSize() int {
-	return 10
+func (this OxmMetadata) minSize() int {
+	return 12
 }
 
-func (this OxmEthDst) Clone() (OxmEthDst, error) {
+func (this OxmMetadata) Clone() (OxmMetadata, error) {
 	var newBuf bytes.Buffer
 	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
 	if err != nil {
-		return NewOxmEthDst(), err
+		return NewOxmMetadata(), err
 	}
 
-	return NewOxmEthDstWithBuf(newBuf.Bytes()), nil
+	return NewOxmMetadataWithBuf(newBuf.Bytes()), nil
 }
 
-type OxmEthDstConn struct {
+type OxmMetadataConn struct {
 	net.Conn
 	w      *bufio.Writer
 	buf    []byte
 	offset int
 }
 
-func NewOxmEthDstConn(c net.Conn) OxmEthDstConn {
-	return OxmEthDstConn{
+func NewOxmMetadataConn(c net.Conn) OxmMetadataConn {
+	return OxmMetadataConn{
 		Conn: c,
 		w:    bufio.NewWriter(c),
 		buf:  make([]byte, packet.DefaultBufSize),
 	}
 }
 
-func (c *OxmEthDstConn) WriteOxmEthDst(pkt OxmEthDst) error {
+func (c *OxmMetadataConn) WriteOxmMetadata(pkt OxmMetadata) error {
 	s := pkt.Size()
 	b := pkt.Buffer()[:s]
 	n := 0
@@ -4539,30 +9151,30 @@ func (c *OxmEthDstConn) WriteOxmEthDst(pkt OxmEthDst) error {
 	return nil
 }
 
-func (c *OxmEthDstConn) WriteOxmEthDsts(pkts []OxmEthDst) error {
+func (c *OxmMetadataConn) WriteOxmMetadatas(pkts []OxmMetadata) error {
 	for _, p := range pkts {
-		if err := c.WriteOxmEthDst(p); err != nil {
+		if err := c.WriteOxmMetadata(p); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (c *OxmEthDstConn) Flush() error {
+func (c *OxmMetadataConn) Flush() error {
 	return c.w.Flush()
 }
 
-func (c *OxmEthDstConn) ReadOxmEthDst() (OxmEthDst, error) {
-	pkts := make([]OxmEthDst, 1)
-	_, err := c.ReadOxmEthDsts(pkts)
+func (c *OxmMetadataConn) ReadOxmMetadata() (OxmMetadata, error) {
+	pkts := make([]OxmMetadata, 1)
+	_, err := c.ReadOxmMetadatas(pkts)
 	if err != nil {
-		return NewOxmEthDst(), err
+		return NewOxmMetadata(), err
 	}
 
 	return pkts[0], nil
 }
 
-func (c *OxmEthDstConn) ReadOxmEthDsts(pkts []OxmEthDst) (int, error) {
+func (c *OxmMetadataConn) ReadOxmMetadatas(pkts []OxmMetadata) (int, error) {
 	if len(c.buf) == c.offset {
 		newSize := packet.DefaultBufSize
 		if newSize < len(c.buf) {
@@ -4584,7 +9196,7 @@ func (c *OxmEthDstConn) ReadOxmEthDsts(pkts []OxmEthDst) (int, error) {
 	s := 0
 	n := 0
 	for i := range pkts {
-		p := NewOxmEthDstWithBuf(c.buf[s:])
+		p := NewOxmMetadataWithBuf(c.buf[s:])
 
 		pSize := p.Size()
 		if pSize == 0 || r < s+pSize {
@@ -4605,116 +9217,99 @@ func (c *OxmEthDstConn) ReadOxmEthDsts(pkts []OxmEthDst) (int, error) {
 	return n, nil
 }
 
-func (this *OxmEthDst) Init() {
+func (this *OxmMetadata) Init() {
 	this.OxmField.Init()
 	this.SetOxmLength(uint8(this.minSize()))
 	// Invariants.
 	this.SetOxmClass(uint16(32768)) // oxm_class
-	this.SetOxmField(uint8(6))      // oxm_field
-	this.SetOxmLength(uint8(6))     // oxm_length
-}
-
-func (this OxmEthDst) Size() int {
-	if len(this.Buf) < this.minSize() {
-		return 0
-	}
-
-	size := int(this.OxmLength())
-	return packet.PaddedSize(size, 1, 4)
-}
-
-func ToOxmEthDst(p OxmField) (OxmEthDst, error) {
-	if !IsOxmEthDst(p) {
-		return NewOxmEthDstWithBuf(nil), errors.New("Cannot convert to of12.OxmEthDst")
-	}
-
-	return NewOxmEthDstWithBuf(p.Buf), nil
-}
-
-func IsOxmEthDst(p OxmField) bool {
-	return p.OxmClass() == 32768 && p.OxmField() == 6 && p.OxmLength() == 6 && true
+	this.SetOxmField(uint8(4))      // oxm_field
+	this.SetOxmLength(uint8(8))     // oxm_length
 }
 
-func (this OxmEthDst) MacAddr() [6]uint8 {
-	offset := this.MacAddrOffset()
-	packet_size := this.Size()
-	size := packet_size - offset
-	count := 6
-	i := 0
-	var res [6]uint8
-	for size > 0 && count > 0 && packet_size > offset {
-		elem := uint8(this.Buf[offset])
-		if size < 1 {
-			break
-		}
-		size -= 1
-		offset += 1
-		count--
-		res[i] = elem
-		i++
+func (this OxmMetadata) Size() int {
+	if len(this.Buf) < this.minSize() {
+		return 0
 	}
-	return res
+
+	size := int(this.OxmLength())
+	return packet.PaddedSize(size, 1, 4)
 }
 
-func (this *OxmEthDst) SetMacAddr(m [6]uint8) {
-	offset := this.MacAddrOffset()
-	for _, e := range m {
-		this.Buf[offset] = byte(e)
-		offset++
+func ToOxmMetadata(p OxmField) (OxmMetadata, error) {
+	if !IsOxmMetadata(p) {
+		return NewOxmMetadataWithBuf(nil), errors.New("Cannot convert to of12.OxmMetadata")
 	}
+
+	return NewOxmMetadataWithBuf(p.Buf), nil
 }
 
-func (this OxmEthDst) MacAddrOffset() int {
+func IsOxmMetadata(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 4 && p.OxmLength() == 8 && true
+}
+
+func (this OxmMetadata) Metadata() uint64 {
+	offset := this.MetadataOffset()
+	res := binary.BigEndian.Uint64(this.Buf[offset:])
+	return res
+}
+
+func (this *OxmMetadata) SetMetadata(m uint64) {
+	offset := this.MetadataOffset()
+	binary.BigEndian.PutUint64(this.Buf[offset:], m)
+	offset += 8
+}
+
+func (this OxmMetadata) MetadataOffset() int {
 	offset := 4
 	return offset
 }
 
-func NewOxmEthDstMaskedWithBuf(b []byte) OxmEthDstMasked {
-	return OxmEthDstMasked{OxmField{packet.Packet{Buf: b}}}
+func NewOxmMetadataMaskedWithBuf(b []byte) OxmMetadataMasked {
+	return OxmMetadataMasked{OxmField{packet.Packet{Buf: b}}}
 }
 
-func NewOxmEthDstMasked() OxmEthDstMasked {
-	s := packet.PaddedSize(16, 1, 4)
+func NewOxmMetadataMasked() OxmMetadataMasked {
+	s := packet.PaddedSize(20, 1, 4)
 	b := make([]byte, s)
-	p := OxmEthDstMasked{OxmField{packet.Packet{Buf: b}}}
+	p := OxmMetadataMasked{OxmField{packet.Packet{Buf: b}}}
 	p.Init()
 	return p
 }
 
-type OxmEthDstMasked struct {
+type OxmMetadataMasked struct {
 	OxmField
 }
 
-func (this OxmEthDstMasked) minSize() int {
-	return 16
+func (this OxmMetadataMasked) minSize() int {
+	return 20
 }
 
-func (this OxmEthDstMasked) Clone() (OxmEthDstMasked, error) {
+func (this OxmMetadataMasked) Clone() (OxmMetadataMasked, error) {
 	var newBuf bytes.Buffer
 	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
 	if err != nil {
-		return NewOxmEthDstMasked(), err
+		return NewOxmMetadataMasked(), err
 	}
 
-	return NewOxmEthDstMaskedWithBuf(newBuf.Bytes()), nil
+	return NewOxmMetadataMaskedWithBuf(newBuf.Bytes()), nil
 }
 
-type OxmEthDstMaskedConn struct {
+type OxmMetadataMaskedConn struct {
 	net.Conn
 	w      *bufio.Writer
 	buf    []byte
 	offset int
 }
 
-func NewOxmEthDstMaskedConn(c net.Conn) OxmEthDstMaskedConn {
-	return OxmEthDstMaskedConn{
+func NewOxmMetadataMaskedConn(c net.Conn) OxmMetadataMaskedConn {
+	return OxmMetadataMaskedConn{
 		Conn: c,
 		w:    bufio.NewWriter(c),
 		buf:  make([]byte, packet.DefaultBufSize),
 	}
 }
 
-func (c *OxmEthDstMaskedConn) WriteOxmEthDstMasked(pkt OxmEthDstMasked) error {
+func (c *OxmMetadataMaskedConn) WriteOxmMetadataMasked(pkt OxmMetadataMasked) error {
 	s := pkt.Size()
 	b := pkt.Buffer()[:s]
 	n := 0
@@ -4729,30 +9324,30 @@ func (c *OxmEthDstMaskedConn) WriteOxmEthDstMasked(pkt OxmEthDstMasked) error {
 	return nil
 }
 
-func (c *OxmEthDstMaskedConn) WriteOxmEthDstMaskeds(pkts []OxmEthDstMasked) error {
+func (c *OxmMetadataMaskedConn) WriteOxmMetadataMaskeds(pkts []OxmMetadataMasked) error {
 	for _, p := range pkts {
-		if err := c.WriteOxmEthDstMasked(p); err != nil {
+		if err := c.WriteOxmMetadataMasked(p); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (c *OxmEthDstMaskedConn) Flush() error {
+func (c *OxmMetadataMaskedConn) Flush() error {
 	return c.w.Flush()
 }
 
-func (c *OxmEthDstMaskedConn) ReadOxmEthDstMasked() (OxmEthDstMasked, error) {
-	pkts := make([]OxmEthDstMasked, 1)
-	_, err := c.ReadOxmEthDstMaskeds(pkts)
+func (c *OxmMetadataMaskedConn) ReadOxmMetadataMasked() (OxmMetadataMasked, error) {
+	pkts := make([]OxmMetadataMasked, 1)
+	_, err := c.ReadOxmMetadataMaskeds(pkts)
 	if err != nil {
-		return NewOxmEthDstMasked(), err
+		return NewOxmMetadataMasked(), err
 	}
 
 	return pkts[0], nil
 }
 
-func (c *OxmEthDstMaskedConn) ReadOxmEthDstMaskeds(pkts []OxmEthDstMasked) (int, error) {
+func (c *OxmMetadataMaskedConn) ReadOxmMetadataMaskeds(pkts []OxmMetadataMasked) (int, error) {
 	if len(c.buf) == c.offset {
 		newSize := packet.DefaultBufSize
 		if newSize < len(c.buf) {
@@ -4774,7 +9369,7 @@ func (c *OxmEthDstMaskedConn) ReadOxmEthDstMaskeds(pkts []OxmEthDstMasked) (int,
 	s := 0
 	n := 0
 	for i := range pkts {
-		p := NewOxmEthDstMaskedWithBuf(c.buf[s:])
+		p := NewOxmMetadataMaskedWithBuf(c.buf[s:])
 
 		pSize := p.Size()
 		if pSize == 0 || r < s+pSize {
@@ -4795,16 +9390,16 @@ func (c *OxmEthDstMaskedConn) ReadOxmEthDstMaskeds(pkts []OxmEthDstMasked) (int,
 	return n, nil
 }
 
-func (this *OxmEthDstMasked) Init() {
+func (this *OxmMetadataMasked) Init() {
 	this.OxmField.Init()
 	this.SetOxmLength(uint8(this.minSize()))
 	// Invariants.
 	this.SetOxmClass(uint16(32768)) // oxm_class
-	this.SetOxmField(uint8(7))      // oxm_field
-	this.SetOxmLength(uint8(12))    // oxm_length
+	this.SetOxmField(uint8(5))      // oxm_field
+	this.SetOxmLength(uint8(16))    // oxm_length
 }
 
-func (this OxmEthDstMasked) Size() int {
+func (this OxmMetadataMasked) Size() int {
 	if len(this.Buf) < this.minSize() {
 		return 0
 	}
@@ -4813,132 +9408,98 @@ func (this OxmEthDstMasked) Size() int {
 	return packet.PaddedSize(size, 1, 4)
 }
 
-func ToOxmEthDstMasked(p OxmField) (OxmEthDstMasked, error) {
-	if !IsOxmEthDstMasked(p) {
-		return NewOxmEthDstMaskedWithBuf(nil), errors.New("Cannot convert to of12.OxmEthDstMasked")
+func ToOxmMetadataMasked(p OxmField) (OxmMetadataMasked, error) {
+	if !IsOxmMetadataMasked(p) {
+		return NewOxmMetadataMaskedWithBuf(nil), errors.New("Cannot convert to of12.OxmMetadataMasked")
 	}
 
-	return NewOxmEthDstMaskedWithBuf(p.Buf), nil
+	return NewOxmMetadataMaskedWithBuf(p.Buf), nil
 }
 
-func IsOxmEthDstMasked(p OxmField) bool {
-	return p.OxmClass() == 32768 && p.OxmField() == 7 && p.OxmLength() == 12 && true
+func IsOxmMetadataMasked(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 5 && p.OxmLength() == 16 && true
 }
 
-func (this OxmEthDstMasked) MacAddr() [6]uint8 {
-	offset := this.MacAddrOffset()
-	packet_size := this.Size()
-	size := packet_size - offset
-	count := 6
-	i := 0
-	var res [6]uint8
-	for size > 0 && count > 0 && packet_size > offset {
-		elem := uint8(this.Buf[offset])
-		if size < 1 {
-			break
-		}
-		size -= 1
-		offset += 1
-		count--
-		res[i] = elem
-		i++
-	}
+func (this OxmMetadataMasked) Metadata() uint64 {
+	offset := this.MetadataOffset()
+	res := binary.BigEndian.Uint64(this.Buf[offset:])
 	return res
 }
 
-func (this *OxmEthDstMasked) SetMacAddr(m [6]uint8) {
-	offset := this.MacAddrOffset()
-	for _, e := range m {
-		this.Buf[offset] = byte(e)
-		offset++
-	}
+func (this *OxmMetadataMasked) SetMetadata(m uint64) {
+	offset := this.MetadataOffset()
+	binary.BigEndian.PutUint64(this.Buf[offset:], m)
+	offset += 8
 }
 
-func (this OxmEthDstMasked) MacAddrOffset() int {
+func (this OxmMetadataMasked) MetadataOffset() int {
 	offset := 4
 	return offset
 }
 
-func (this OxmEthDstMasked) Mask() [6]uint8 {
+func (this OxmMetadataMasked) Mask() uint64 {
 	offset := this.MaskOffset()
-	packet_size := this.Size()
-	size := packet_size - offset
-	count := 6
-	i := 0
-	var res [6]uint8
-	for size > 0 && count > 0 && packet_size > offset {
-		elem := uint8(this.Buf[offset])
-		if size < 1 {
-			break
-		}
-		size -= 1
-		offset += 1
-		count--
-		res[i] = elem
-		i++
-	}
+	res := binary.BigEndian.Uint64(this.Buf[offset:])
 	return res
 }
 
-func (this *OxmEthDstMasked) SetMask(m [6]uint8) {
+func (this *OxmMetadataMasked) SetMask(m uint64) {
 	offset := this.MaskOffset()
-	for _, e := range m {
-		this.Buf[offset] = byte(e)
-		offset++
-	}
+	binary.BigEndian.PutUint64(this.Buf[offset:], m)
+	offset += 8
 }
 
-func (this OxmEthDstMasked) MaskOffset() int {
-	offset := 10
+func (this OxmMetadataMasked) MaskOffset() int {
+	offset := 12
 	return offset
 }
 
-func NewOxmEthSrcWithBuf(b []byte) OxmEthSrc {
-	return OxmEthSrc{OxmField{packet.Packet{Buf: b}}}
+func NewOxmIpDscpWithBuf(b []byte) OxmIpDscp {
+	return OxmIpDscp{OxmField{packet.Packet{Buf: b}}}
 }
 
-func NewOxmEthSrc() OxmEthSrc {
-	s := packet.PaddedSize(10, 1, 4)
+func NewOxmIpDscp() OxmIpDscp {
+	s := packet.PaddedSize(5, 1, 4)
 	b := make([]byte, s)
-	p := OxmEthSrc{OxmField{packet.Packet{Buf: b}}}
+	p := OxmIpDscp{OxmField{packet.Packet{Buf: b}}}
 	p.Init()
 	return p
 }
 
-type OxmEthSrc struct {
+type OxmIpDscp struct {
 	OxmField
 }
 
-func (this OxmEthSrc) minSize() int {
-	return 10
+func (this OxmIpDscp) minSize() int {
+	return 5
 }
 
-func (this OxmEthSrc) Clone() (OxmEthSrc, error) {
+func (this OxmIpDscp) Clone() (OxmIpDscp, error) {
 	var newBuf bytes.Buffer
 	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
 	if err != nil {
-		return NewOxmEthSrc(), err
+		return NewOxmIpDscp(), err
 	}
 
-	return NewOxmEthSrcWithBuf(newBuf.Bytes()), nil
+	return NewOxmIpDscpWithBuf(newBuf.Bytes()), nil
 }
 
-type OxmEthSrcConn struct {
+type OxmIpDscpConn struct {
 	net.Conn
 	w      *bufio.Writer
 	buf    []byte
 	offset int
 }
 
-func NewOxmEthSrcConn(c net.Conn) OxmEthSrcConn {
-	return OxmEthSrcConn{
+func NewOxmIpDscpConn(c net.Conn) OxmIpDscpConn {
+	return OxmIpDscpConn{
 		Conn: c,
 		w:    bufio.NewWriter(c),
 		buf:  make([]byte, packet.DefaultBufSize),
 	}
 }
 
-func (c *OxmEthSrcConn) WriteOxmEthSrc(pkt OxmEthSrc) error {
+func (c *OxmIpDscpConn) WriteOxmIpDscp(pkt OxmIpDscp) error {
 	s := pkt.Size()
 	b := pkt.Buffer()[:s]
 	n := 0
@@ -4953,30 +9514,30 @@ func (c *OxmEthSrcConn) WriteOxmEthSrc(pkt OxmEthSrc) error {
 	return nil
 }
 
-func (c *OxmEthSrcConn) WriteOxmEthSrcs(pkts []OxmEthSrc) error {
+func (c *OxmIpDscpConn) WriteOxmIpDscps(pkts []OxmIpDscp) error {
 	for _, p := range pkts {
-		if err := c.WriteOxmEthSrc(p); err != nil {
+		if err := c.WriteOxmIpDscp(p); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (c *OxmEthSrcConn) Flush() error {
+func (c *OxmIpDscpConn) Flush() error {
 	return c.w.Flush()
 }
 
-func (c *OxmEthSrcConn) ReadOxmEthSrc() (OxmEthSrc, error) {
-	pkts := make([]OxmEthSrc, 1)
-	_, err := c.ReadOxmEthSrcs(pkts)
+func (c *OxmIpDscpConn) ReadOxmIpDscp() (OxmIpDscp, error) {
+	pkts := make([]OxmIpDscp, 1)
+	_, err := c.ReadOxmIpDscps(pkts)
 	if err != nil {
-		return NewOxmEthSrc(), err
+		return NewOxmIpDscp(), err
 	}
 
 	return pkts[0], nil
 }
 
-func (c *OxmEthSrcConn) ReadOxmEthSrcs(pkts []OxmEthSrc) (int, error) {
+func (c *OxmIpDscpConn) ReadOxmIpDscps(pkts []OxmIpDscp) (int, error) {
 	if len(c.buf) == c.offset {
 		newSize := packet.DefaultBufSize
 		if newSize < len(c.buf) {
@@ -4998,7 +9559,7 @@ func (c *OxmEthSrcConn) ReadOxmEthSrcs(pkts []OxmEthSrc) (int, error) {
 	s := 0
 	n := 0
 	for i := range pkts {
-		p := NewOxmEthSrcWithBuf(c.buf[s:])
+		p := NewOxmIpDscpWithBuf(c.buf[s:])
 
 		pSize := p.Size()
 		if pSize == 0 || r < s+pSize {
@@ -5019,16 +9580,16 @@ func (c *OxmEthSrcConn) ReadOxmEthSrcs(pkts []OxmEthSrc) (int, error) {
 	return n, nil
 }
 
-func (this *OxmEthSrc) Init() {
+func (this *OxmIpDscp) Init() {
 	this.OxmField.Init()
 	this.SetOxmLength(uint8(this.minSize()))
 	// Invariants.
 	this.SetOxmClass(uint16(32768)) // oxm_class
-	this.SetOxmField(uint8(8))      // oxm_field
-	this.SetOxmLength(uint8(6))     // oxm_length
+	this.SetOxmField(uint8(16))     // oxm_field
+	this.SetOxmLength(uint8(1))     // oxm_length
 }
 
-func (this OxmEthSrc) Size() int {
+func (this OxmIpDscp) Size() int {
 	if len(this.Buf) < this.minSize() {
 		return 0
 	}
@@ -5037,98 +9598,81 @@ func (this OxmEthSrc) Size() int {
 	return packet.PaddedSize(size, 1, 4)
 }
 
-func ToOxmEthSrc(p OxmField) (OxmEthSrc, error) {
-	if !IsOxmEthSrc(p) {
-		return NewOxmEthSrcWithBuf(nil), errors.New("Cannot convert to of12.OxmEthSrc")
+func ToOxmIpDscp(p OxmField) (OxmIpDscp, error) {
+	if !IsOxmIpDscp(p) {
+		return NewOxmIpDscpWithBuf(nil), errors.New("Cannot convert to of12.OxmIpDscp")
 	}
 
-	return NewOxmEthSrcWithBuf(p.Buf), nil
+	return NewOxmIpDscpWithBuf(p.Buf), nil
 }
 
-func IsOxmEthSrc(p OxmField) bool {
-	return p.OxmClass() == 32768 && p.OxmField() == 8 && p.OxmLength() == 6 && true
+func IsOxmIpDscp(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 16 && p.OxmLength() == 1 && true
 }
 
-func (this OxmEthSrc) MacAddr() [6]uint8 {
-	offset := this.MacAddrOffset()
-	packet_size := this.Size()
-	size := packet_size - offset
-	count := 6
-	i := 0
-	var res [6]uint8
-	for size > 0 && count > 0 && packet_size > offset {
-		elem := uint8(this.Buf[offset])
-		if size < 1 {
-			break
-		}
-		size -= 1
-		offset += 1
-		count--
-		res[i] = elem
-		i++
-	}
+func (this OxmIpDscp) Dscp() uint8 {
+	offset := this.DscpOffset()
+	res := uint8(this.Buf[offset])
 	return res
 }
 
-func (this *OxmEthSrc) SetMacAddr(m [6]uint8) {
-	offset := this.MacAddrOffset()
-	for _, e := range m {
-		this.Buf[offset] = byte(e)
-		offset++
-	}
+func (this *OxmIpDscp) SetDscp(d uint8) {
+	offset := this.DscpOffset()
+	this.Buf[offset] = byte(d)
+	offset++
 }
 
-func (this OxmEthSrc) MacAddrOffset() int {
+func (this OxmIpDscp) DscpOffset() int {
 	offset := 4
 	return offset
 }
 
-func NewOxmEthSrcMaskedWithBuf(b []byte) OxmEthSrcMasked {
-	return OxmEthSrcMasked{OxmField{packet.Packet{Buf: b}}}
+func NewOxmIpEcnWithBuf(b []byte) OxmIpEcn {
+	return OxmIpEcn{OxmField{packet.Packet{Buf: b}}}
 }
 
-func NewOxmEthSrcMasked() OxmEthSrcMasked {
-	s := packet.PaddedSize(16, 1, 4)
+func NewOxmIpEcn() OxmIpEcn {
+	s := packet.PaddedSize(5, 1, 4)
 	b := make([]byte, s)
-	p := OxmEthSrcMasked{OxmField{packet.Packet{Buf: b}}}
+	p := OxmIpEcn{OxmField{packet.Packet{Buf: b}}}
 	p.Init()
 	return p
 }
 
-type OxmEthSrcMasked struct {
+type OxmIpEcn struct {
 	OxmField
 }
 
-func (this OxmEthSrcMasked) minSize() int {
-	return 16
+func (this OxmIpEcn) minSize() int {
+	return 5
 }
 
-func (this OxmEthSrcMasked) Clone() (OxmEthSrcMasked, error) {
+func (this OxmIpEcn) Clone() (OxmIpEcn, error) {
 	var newBuf bytes.Buffer
 	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
 	if err != nil {
-		return NewOxmEthSrcMasked(), err
+		return NewOxmIpEcn(), err
 	}
 
-	return NewOxmEthSrcMaskedWithBuf(newBuf.Bytes()), nil
+	return NewOxmIpEcnWithBuf(newBuf.Bytes()), nil
 }
 
-type OxmEthSrcMaskedConn struct {
+type OxmIpEcnConn struct {
 	net.Conn
 	w      *bufio.Writer
 	buf    []byte
 	offset int
 }
 
-func NewOxmEthSrcMaskedConn(c net.Conn) OxmEthSrcMaskedConn {
-	return OxmEthSrcMaskedConn{
+func NewOxmIpEcnConn(c net.Conn) OxmIpEcnConn {
+	return OxmIpEcnConn{
 		Conn: c,
 		w:    bufio.NewWriter(c),
 		buf:  make([]byte, packet.DefaultBufSize),
 	}
 }
 
-func (c *OxmEthSrcMaskedConn) WriteOxmEthSrcMasked(pkt OxmEthSrcMasked) error {
+func (c *OxmIpEcnConn) WriteOxmIpEcn(pkt OxmIpEcn) error {
 	s := pkt.Size()
 	b := pkt.Buffer()[:s]
 	n := 0
@@ -5143,30 +9687,30 @@ func (c *OxmEthSrcMaskedConn) WriteOxmEthSrcMasked(pkt OxmEthSrcMasked) error {
 	return nil
 }
 
-func (c *OxmEthSrcMaskedConn) WriteOxmEthSrcMaskeds(pkts []OxmEthSrcMasked) error {
+func (c *OxmIpEcnConn) WriteOxmIpEcns(pkts []OxmIpEcn) error {
 	for _, p := range pkts {
-		if err := c.WriteOxmEthSrcMasked(p); err != nil {
+		if err := c.WriteOxmIpEcn(p); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (c *OxmEthSrcMaskedConn) Flush() error {
+func (c *OxmIpEcnConn) Flush() error {
 	return c.w.Flush()
 }
 
-func (c *OxmEthSrcMaskedConn) ReadOxmEthSrcMasked() (OxmEthSrcMasked, error) {
-	pkts := make([]OxmEthSrcMasked, 1)
-	_, err := c.ReadOxmEthSrcMaskeds(pkts)
+func (c *OxmIpEcnConn) ReadOxmIpEcn() (OxmIpEcn, error) {
+	pkts := make([]OxmIpEcn, 1)
+	_, err := c.ReadOxmIpEcns(pkts)
 	if err != nil {
-		return NewOxmEthSrcMasked(), err
+		return NewOxmIpEcn(), err
 	}
 
 	return pkts[0], nil
 }
 
-func (c *OxmEthSrcMaskedConn) ReadOxmEthSrcMaskeds(pkts []OxmEthSrcMasked) (int, error) {
+func (c *OxmIpEcnConn) ReadOxmIpEcns(pkts []OxmIpEcn) (int, error) {
 	if len(c.buf) == c.offset {
 		newSize := packet.DefaultBufSize
 		if newSize < len(c.buf) {
@@ -5188,7 +9732,7 @@ func (c *OxmEthSrcMaskedConn) ReadOxmEthSrcMaskeds(pkts []OxmEthSrcMasked) (int,
 	s := 0
 	n := 0
 	for i := range pkts {
-		p := NewOxmEthSrcMaskedWithBuf(c.buf[s:])
+		p := NewOxmIpEcnWithBuf(c.buf[s:])
 
 		pSize := p.Size()
 		if pSize == 0 || r < s+pSize {
@@ -5209,150 +9753,99 @@ func (c *OxmEthSrcMaskedConn) ReadOxmEthSrcMaskeds(pkts []OxmEthSrcMasked) (int,
 	return n, nil
 }
 
-func (this *OxmEthSrcMasked) Init() {
+func (this *OxmIpEcn) Init() {
 	this.OxmField.Init()
 	this.SetOxmLength(uint8(this.minSize()))
-	// Invariants.
-	this.SetOxmClass(uint16(32768)) // oxm_class
-	this.SetOxmField(uint8(9))      // oxm_field
-	this.SetOxmLength(uint8(12))    // oxm_length
-}
-
-func (this OxmEthSrcMasked) Size() int {
-	if len(this.Buf) < this.minSize() {
-		return 0
-	}
-
-	size := int(this.OxmLength())
-	return packet.PaddedSize(size, 1, 4)
-}
-
-func ToOxmEthSrcMasked(p OxmField) (OxmEthSrcMasked, error) {
-	if !IsOxmEthSrcMasked(p) {
-		return NewOxmEthSrcMaskedWithBuf(nil), errors.New("Cannot convert to of12.OxmEthSrcMasked")
-	}
-
-	return NewOxmEthSrcMaskedWithBuf(p.Buf), nil
-}
-
-func IsOxmEthSrcMasked(p OxmField) bool {
-	return p.OxmClass() == 32768 && p.OxmField() == 9 && p.OxmLength() == 12 && true
-}
-
-func (this OxmEthSrcMasked) MacAddr() [6]uint8 {
-	offset := this.MacAddrOffset()
-	packet_size := this.Size()
-	size := packet_size - offset
-	count := 6
-	i := 0
-	var res [6]uint8
-	for size > 0 && count > 0 && packet_size > offset {
-		elem := uint8(this.Buf[offset])
-		if size < 1 {
-			break
-		}
-		size -= 1
-		offset += 1
-		count--
-		res[i] = elem
-		i++
+	// Invariants.
+	this.SetOxmClass(uint16(32768)) // oxm_class
+	this.SetOxmField(uint8(18))     // oxm_field
+	this.SetOxmLength(uint8(1))     // oxm_length
+}
+
+func (this OxmIpEcn) Size() int {
+	if len(this.Buf) < this.minSize() {
+		return 0
 	}
-	return res
+
+	size := int(this.OxmLength())
+	return packet.PaddedSize(size, 1, 4)
 }
 
-func (this *OxmEthSrcMasked) SetMacAddr(m [6]uint8) {
-	offset := this.MacAddrOffset()
-	for _, e := range m {
-		this.Buf[offset] = byte(e)
-		offset++
+func ToOxmIpEcn(p OxmField) (OxmIpEcn, error) {
+	if !IsOxmIpEcn(p) {
+		return NewOxmIpEcnWithBuf(nil), errors.New("Cannot convert to of12.OxmIpEcn")
 	}
+
+	return NewOxmIpEcnWithBuf(p.Buf), nil
 }
 
-func (this OxmEthSrcMasked) MacAddrOffset() int {
-	offset := 4
-	return offset
+func IsOxmIpEcn(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 18 && p.OxmLength() == 1 && true
 }
 
-func (this OxmEthSrcMasked) Mask() [6]uint8 {
-	offset := this.MaskOffset()
-	packet_size := this.Size()
-	size := packet_size - offset
-	count := 6
-	i := 0
-	var res [6]uint8
-	for size > 0 && count > 0 && packet_size > offset {
-		elem := uint8(this.Buf[offset])
-		if size < 1 {
-			break
-		}
-		size -= 1
-		offset += 1
-		count--
-		res[i] = elem
-		i++
-	}
+func (this OxmIpEcn) Ecn() uint8 {
+	offset := this.EcnOffset()
+	res := uint8(this.Buf[offset])
 	return res
 }
 
-func (this *OxmEthSrcMasked) SetMask(m [6]uint8) {
-	offset := this.MaskOffset()
-	for _, e := range m {
-		this.Buf[offset] = byte(e)
-		offset++
-	}
+func (this *OxmIpEcn) SetEcn(e uint8) {
+	offset := this.EcnOffset()
+	this.Buf[offset] = byte(e)
+	offset++
 }
 
-func (this OxmEthSrcMasked) MaskOffset() int {
-	offset := 10
+func (this OxmIpEcn) EcnOffset() int {
+	offset := 4
 	return offset
 }
 
-func NewOxmEthTypeWithBuf(b []byte) OxmEthType {
-	return OxmEthType{OxmField{packet.Packet{Buf: b}}}
+func NewOxmIcmpV4TypeWithBuf(b []byte) OxmIcmpV4Type {
+	return OxmIcmpV4Type{OxmField{packet.Packet{Buf: b}}}
 }
 
-func NewOxmEthType() OxmEthType {
-	s := packet.PaddedSize(6, 1, 4)
+func NewOxmIcmpV4Type() OxmIcmpV4Type {
+	s := packet.PaddedSize(5, 1, 4)
 	b := make([]byte, s)
-	p := OxmEthType{OxmField{packet.Packet{Buf: b}}}
+	p := OxmIcmpV4Type{OxmField{packet.Packet{Buf: b}}}
 	p.Init()
 	return p
 }
 
-type OxmEthType struct {
+type OxmIcmpV4Type struct {
 	OxmField
 }
 
-func (this OxmEthType) minSize() int {
-	return 6
+func (this OxmIcmpV4Type) minSize() int {
+	return 5
 }
 
-func (this OxmEthType) Clone() (OxmEthType, error) {
+func (this OxmIcmpV4Type) Clone() (OxmIcmpV4Type, error) {
 	var newBuf bytes.Buffer
 	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
 	if err != nil {
-		return NewOxmEthType(), err
+		return NewOxmIcmpV4Type(), err
 	}
 
-	return NewOxmEthTypeWithBuf(newBuf.Bytes()), nil
+	return NewOxmIcmpV4TypeWithBuf(newBuf.Bytes()), nil
 }
 
-type OxmEthTypeConn struct {
+type OxmIcmpV4TypeConn struct {
 	net.Conn
 	w      *bufio.Writer
 	buf    []byte
 	offset int
 }
 
-func NewOxmEthTypeConn(c net.Conn) OxmEthTypeConn {
-	return OxmEthTypeConn{
+func NewOxmIcmpV4TypeConn(c net.Conn) OxmIcmpV4TypeConn {
+	return OxmIcmpV4TypeConn{
 		Conn: c,
 		w:    bufio.NewWriter(c),
 		buf:  make([]byte, packet.DefaultBufSize),
 	}
 }
 
-func (c *OxmEthTypeConn) WriteOxmEthType(pkt OxmEthType) error {
+func (c *OxmIcmpV4TypeConn) WriteOxmIcmpV4Type(pkt OxmIcmpV4Type) error {
 	s := pkt.Size()
 	b := pkt.Buffer()[:s]
 	n := 0
@@ -5367,30 +9860,30 @@ func (c *OxmEthTypeConn) WriteOxmEthType(pkt OxmEthType) error {
 	return nil
 }
 
-func (c *OxmEthTypeConn) WriteOxmEthTypes(pkts []OxmEthType) error {
+func (c *OxmIcmpV4TypeConn) WriteOxmIcmpV4Types(pkts []OxmIcmpV4Type) error {
 	for _, p := range pkts {
-		if err := c.WriteOxmEthType(p); err != nil {
+		if err := c.WriteOxmIcmpV4Type(p); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (c *OxmEthTypeConn) Flush() error {
+func (c *OxmIcmpV4TypeConn) Flush() error {
 	return c.w.Flush()
 }
 
-func (c *OxmEthTypeConn) ReadOxmEthType() (OxmEthType, error) {
-	pkts := make([]OxmEthType, 1)
-	_, err := c.ReadOxmEthTypes(pkts)
+func (c *OxmIcmpV4TypeConn) ReadOxmIcmpV4Type() (OxmIcmpV4Type, error) {
+	pkts := make([]OxmIcmpV4Type, 1)
+	_, err := c.ReadOxmIcmpV4Types(pkts)
 	if err != nil {
-		return NewOxmEthType(), err
+		return NewOxmIcmpV4Type(), err
 	}
 
 	return pkts[0], nil
 }
 
-func (c *OxmEthTypeConn) ReadOxmEthTypes(pkts []OxmEthType) (int, error) {
+func (c *OxmIcmpV4TypeConn) ReadOxmIcmpV4Types(pkts []OxmIcmpV4Type) (int, error) {
 	if len(c.buf) == c.offset {
 		newSize := packet.DefaultBufSize
 		if newSize < len(c.buf) {
@@ -5412,7 +9905,7 @@ func (c *OxmEthTypeConn) ReadOxmEthTypes(pkts []OxmEthType) (int, error) {
 	s := 0
 	n := 0
 	for i := range pkts {
-		p := NewOxmEthTypeWithBuf(c.buf[s:])
+		p := NewOxmIcmpV4TypeWithBuf(c.buf[s:])
 
 		pSize := p.Size()
 		if pSize == 0 || r < s+pSize {
@@ -5433,16 +9926,16 @@ func (c *OxmEthTypeConn) ReadOxmEthTypes(pkts []OxmEthType) (int, error) {
 	return n, nil
 }
 
-func (this *OxmEthType) Init() {
+func (this *OxmIcmpV4Type) Init() {
 	this.OxmField.Init()
 	this.SetOxmLength(uint8(this.minSize()))
 	// Invariants.
 	this.SetOxmClass(uint16(32768)) // oxm_class
-	this.SetOxmField(uint8(10))     // oxm_field
-	this.SetOxmLength(uint8(2))     // oxm_length
+	this.SetOxmField(uint8(38))     // oxm_field
+	this.SetOxmLength(uint8(1))     // oxm_length
 }
 
-func (this OxmEthType) Size() int {
+func (this OxmIcmpV4Type) Size() int {
 	if len(this.Buf) < this.minSize() {
 		return 0
 	}
@@ -5451,81 +9944,81 @@ func (this OxmEthType) Size() int {
 	return packet.PaddedSize(size, 1, 4)
 }
 
-func ToOxmEthType(p OxmField) (OxmEthType, error) {
-	if !IsOxmEthType(p) {
-		return NewOxmEthTypeWithBuf(nil), errors.New("Cannot convert to of12.OxmEthType")
+func ToOxmIcmpV4Type(p OxmField) (OxmIcmpV4Type, error) {
+	if !IsOxmIcmpV4Type(p) {
+		return NewOxmIcmpV4TypeWithBuf(nil), errors.New("Cannot convert to of12.OxmIcmpV4Type")
 	}
 
-	return NewOxmEthTypeWithBuf(p.Buf), nil
+	return NewOxmIcmpV4TypeWithBuf(p.Buf), nil
 }
 
-func IsOxmEthType(p OxmField) bool {
-	return p.OxmClass() == 32768 && p.OxmField() == 10 && p.OxmLength() == 2 && true
+func IsOxmIcmpV4Type(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 38 && p.OxmLength() == 1 && true
 }
 
-func (this OxmEthType) Type() uint16 {
+func (this OxmIcmpV4Type) Type() uint8 {
 	offset := this.TypeOffset()
-	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	res := uint8(this.Buf[offset])
 	return res
 }
 
-func (this *OxmEthType) SetType(t uint16) {
+func (this *OxmIcmpV4Type) SetType(t uint8) {
 	offset := this.TypeOffset()
-	binary.BigEndian.PutUint16(this.Buf[offset:], t)
-	offset += 2
+	this.Buf[offset] = byte(t)
+	offset++
 }
 
-func (this OxmEthType) TypeOffset() int {
+func (this OxmIcmpV4Type) TypeOffset() int {
 	offset := 4
 	return offset
 }
 
-func NewOxmIpProtoWithBuf(b []byte) OxmIpProto {
-	return OxmIpProto{OxmField{packet.Packet{Buf: b}}}
+func NewOxmIcmpV4CodeWithBuf(b []byte) OxmIcmpV4Code {
+	return OxmIcmpV4Code{OxmField{packet.Packet{Buf: b}}}
 }
 
-func NewOxmIpProto() OxmIpProto {
+func NewOxmIcmpV4Code() OxmIcmpV4Code {
 	s := packet.PaddedSize(5, 1, 4)
 	b := make([]byte, s)
-	p := OxmIpProto{OxmField{packet.Packet{Buf: b}}}
+	p := OxmIcmpV4Code{OxmField{packet.Packet{Buf: b}}}
 	p.Init()
 	return p
 }
 
-type OxmIpProto struct {
+type OxmIcmpV4Code struct {
 	OxmField
 }
 
-func (this OxmIpProto) minSize() int {
+func (this OxmIcmpV4Code) minSize() int {
 	return 5
 }
 
-func (this OxmIpProto) Clone() (OxmIpProto, error) {
+func (this OxmIcmpV4Code) Clone() (OxmIcmpV4Code, error) {
 	var newBuf bytes.Buffer
 	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
 	if err != nil {
-		return NewOxmIpProto(), err
+		return NewOxmIcmpV4Code(), err
 	}
 
-	return NewOxmIpProtoWithBuf(newBuf.Bytes()), nil
+	return NewOxmIcmpV4CodeWithBuf(newBuf.Bytes()), nil
 }
 
-type OxmIpProtoConn struct {
+type OxmIcmpV4CodeConn struct {
 	net.Conn
 	w      *bufio.Writer
 	buf    []byte
 	offset int
 }
 
-func NewOxmIpProtoConn(c net.Conn) OxmIpProtoConn {
-	return OxmIpProtoConn{
+func NewOxmIcmpV4CodeConn(c net.Conn) OxmIcmpV4CodeConn {
+	return OxmIcmpV4CodeConn{
 		Conn: c,
 		w:    bufio.NewWriter(c),
 		buf:  make([]byte, packet.DefaultBufSize),
 	}
 }
 
-func (c *OxmIpProtoConn) WriteOxmIpProto(pkt OxmIpProto) error {
+func (c *OxmIcmpV4CodeConn) WriteOxmIcmpV4Code(pkt OxmIcmpV4Code) error {
 	s := pkt.Size()
 	b := pkt.Buffer()[:s]
 	n := 0
@@ -5540,30 +10033,30 @@ func (c *OxmIpProtoConn) WriteOxmIpProto(pkt OxmIpProto) error {
 	return nil
 }
 
-func (c *OxmIpProtoConn) WriteOxmIpProtos(pkts []OxmIpProto) error {
+func (c *OxmIcmpV4CodeConn) WriteOxmIcmpV4Codes(pkts []OxmIcmpV4Code) error {
 	for _, p := range pkts {
-		if err := c.WriteOxmIpProto(p); err != nil {
+		if err := c.WriteOxmIcmpV4Code(p); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (c *OxmIpProtoConn) Flush() error {
+func (c *OxmIcmpV4CodeConn) Flush() error {
 	return c.w.Flush()
 }
 
-func (c *OxmIpProtoConn) ReadOxmIpProto() (OxmIpProto, error) {
-	pkts := make([]OxmIpProto, 1)
-	_, err := c.ReadOxmIpProtos(pkts)
+func (c *OxmIcmpV4CodeConn) ReadOxmIcmpV4Code() (OxmIcmpV4Code, error) {
+	pkts := make([]OxmIcmpV4Code, 1)
+	_, err := c.ReadOxmIcmpV4Codes(pkts)
 	if err != nil {
-		return NewOxmIpProto(), err
+		return NewOxmIcmpV4Code(), err
 	}
 
 	return pkts[0], nil
 }
 
-func (c *OxmIpProtoConn) ReadOxmIpProtos(pkts []OxmIpProto) (int, error) {
+func (c *OxmIcmpV4CodeConn) ReadOxmIcmpV4Codes(pkts []OxmIcmpV4Code) (int, error) {
 	if len(c.buf) == c.offset {
 		newSize := packet.DefaultBufSize
 		if newSize < len(c.buf) {
@@ -5585,7 +10078,7 @@ func (c *OxmIpProtoConn) ReadOxmIpProtos(pkts []OxmIpProto) (int, error) {
 	s := 0
 	n := 0
 	for i := range pkts {
-		p := NewOxmIpProtoWithBuf(c.buf[s:])
+		p := NewOxmIcmpV4CodeWithBuf(c.buf[s:])
 
 		pSize := p.Size()
 		if pSize == 0 || r < s+pSize {
@@ -5606,16 +10099,16 @@ func (c *OxmIpProtoConn) ReadOxmIpProtos(pkts []OxmIpProto) (int, error) {
 	return n, nil
 }
 
-func (this *OxmIpProto) Init() {
+func (this *OxmIcmpV4Code) Init() {
 	this.OxmField.Init()
 	this.SetOxmLength(uint8(this.minSize()))
 	// Invariants.
 	this.SetOxmClass(uint16(32768)) // oxm_class
-	this.SetOxmField(uint8(20))     // oxm_field
+	this.SetOxmField(uint8(40))     // oxm_field
 	this.SetOxmLength(uint8(1))     // oxm_length
 }
 
-func (this OxmIpProto) Size() int {
+func (this OxmIcmpV4Code) Size() int {
 	if len(this.Buf) < this.minSize() {
 		return 0
 	}
@@ -5624,81 +10117,81 @@ func (this OxmIpProto) Size() int {
 	return packet.PaddedSize(size, 1, 4)
 }
 
-func ToOxmIpProto(p OxmField) (OxmIpProto, error) {
-	if !IsOxmIpProto(p) {
-		return NewOxmIpProtoWithBuf(nil), errors.New("Cannot convert to of12.OxmIpProto")
+func ToOxmIcmpV4Code(p OxmField) (OxmIcmpV4Code, error) {
+	if !IsOxmIcmpV4Code(p) {
+		return NewOxmIcmpV4CodeWithBuf(nil), errors.New("Cannot convert to of12.OxmIcmpV4Code")
 	}
 
-	return NewOxmIpProtoWithBuf(p.Buf), nil
+	return NewOxmIcmpV4CodeWithBuf(p.Buf), nil
 }
 
-func IsOxmIpProto(p OxmField) bool {
-	return p.OxmClass() == 32768 && p.OxmField() == 20 && p.OxmLength() == 1 && true
+func IsOxmIcmpV4Code(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 40 && p.OxmLength() == 1 && true
 }
 
-func (this OxmIpProto) Proto() uint8 {
-	offset := this.ProtoOffset()
+func (this OxmIcmpV4Code) Code() uint8 {
+	offset := this.CodeOffset()
 	res := uint8(this.Buf[offset])
 	return res
 }
 
-func (this *OxmIpProto) SetProto(p uint8) {
-	offset := this.ProtoOffset()
-	this.Buf[offset] = byte(p)
+func (this *OxmIcmpV4Code) SetCode(c uint8) {
+	offset := this.CodeOffset()
+	this.Buf[offset] = byte(c)
 	offset++
 }
 
-func (this OxmIpProto) ProtoOffset() int {
+func (this OxmIcmpV4Code) CodeOffset() int {
 	offset := 4
 	return offset
 }
 
-func NewOxmIpV4SrcWithBuf(b []byte) OxmIpV4Src {
-	return OxmIpV4Src{OxmField{packet.Packet{Buf: b}}}
+func NewOxmArpOpWithBuf(b []byte) OxmArpOp {
+	return OxmArpOp{OxmField{packet.Packet{Buf: b}}}
 }
 
-func NewOxmIpV4Src() OxmIpV4Src {
-	s := packet.PaddedSize(12, 1, 4)
+func NewOxmArpOp() OxmArpOp {
+	s := packet.PaddedSize(6, 1, 4)
 	b := make([]byte, s)
-	p := OxmIpV4Src{OxmField{packet.Packet{Buf: b}}}
+	p := OxmArpOp{OxmField{packet.Packet{Buf: b}}}
 	p.Init()
 	return p
 }
 
-type OxmIpV4Src struct {
+type OxmArpOp struct {
 	OxmField
 }
 
-func (this OxmIpV4Src) minSize() int {
-	return 12
+func (this OxmArpOp) minSize() int {
+	return 6
 }
 
-func (this OxmIpV4Src) Clone() (OxmIpV4Src, error) {
+func (this OxmArpOp) Clone() (OxmArpOp, error) {
 	var newBuf bytes.Buffer
 	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
 	if err != nil {
-		return NewOxmIpV4Src(), err
+		return NewOxmArpOp(), err
 	}
 
-	return NewOxmIpV4SrcWithBuf(newBuf.Bytes()), nil
+	return NewOxmArpOpWithBuf(newBuf.Bytes()), nil
 }
 
-type OxmIpV4SrcConn struct {
+type OxmArpOpConn struct {
 	net.Conn
 	w      *bufio.Writer
 	buf    []byte
 	offset int
 }
 
-func NewOxmIpV4SrcConn(c net.Conn) OxmIpV4SrcConn {
-	return OxmIpV4SrcConn{
+func NewOxmArpOpConn(c net.Conn) OxmArpOpConn {
+	return OxmArpOpConn{
 		Conn: c,
 		w:    bufio.NewWriter(c),
 		buf:  make([]byte, packet.DefaultBufSize),
 	}
 }
 
-func (c *OxmIpV4SrcConn) WriteOxmIpV4Src(pkt OxmIpV4Src) error {
+func (c *OxmArpOpConn) WriteOxmArpOp(pkt OxmArpOp) error {
 	s := pkt.Size()
 	b := pkt.Buffer()[:s]
 	n := 0
@@ -5713,30 +10206,30 @@ func (c *OxmIpV4SrcConn) WriteOxmIpV4Src(pkt OxmIpV4Src) error {
 	return nil
 }
 
-func (c *OxmIpV4SrcConn) WriteOxmIpV4Srcs(pkts []OxmIpV4Src) error {
+func (c *OxmArpOpConn) WriteOxmArpOps(pkts []OxmArpOp) error {
 	for _, p := range pkts {
-		if err := c.WriteOxmIpV4Src(p); err != nil {
+		if err := c.WriteOxmArpOp(p); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (c *OxmIpV4SrcConn) Flush() error {
+func (c *OxmArpOpConn) Flush() error {
 	return c.w.Flush()
 }
 
-func (c *OxmIpV4SrcConn) ReadOxmIpV4Src() (OxmIpV4Src, error) {
-	pkts := make([]OxmIpV4Src, 1)
-	_, err := c.ReadOxmIpV4Srcs(pkts)
+func (c *OxmArpOpConn) ReadOxmArpOp() (OxmArpOp, error) {
+	pkts := make([]OxmArpOp, 1)
+	_, err := c.ReadOxmArpOps(pkts)
 	if err != nil {
-		return NewOxmIpV4Src(), err
+		return NewOxmArpOp(), err
 	}
 
 	return pkts[0], nil
 }
 
-func (c *OxmIpV4SrcConn) ReadOxmIpV4Srcs(pkts []OxmIpV4Src) (int, error) {
+func (c *OxmArpOpConn) ReadOxmArpOps(pkts []OxmArpOp) (int, error) {
 	if len(c.buf) == c.offset {
 		newSize := packet.DefaultBufSize
 		if newSize < len(c.buf) {
@@ -5758,7 +10251,7 @@ func (c *OxmIpV4SrcConn) ReadOxmIpV4Srcs(pkts []OxmIpV4Src) (int, error) {
 	s := 0
 	n := 0
 	for i := range pkts {
-		p := NewOxmIpV4SrcWithBuf(c.buf[s:])
+		p := NewOxmArpOpWithBuf(c.buf[s:])
 
 		pSize := p.Size()
 		if pSize == 0 || r < s+pSize {
@@ -5779,16 +10272,16 @@ func (c *OxmIpV4SrcConn) ReadOxmIpV4Srcs(pkts []OxmIpV4Src) (int, error) {
 	return n, nil
 }
 
-func (this *OxmIpV4Src) Init() {
+func (this *OxmArpOp) Init() {
 	this.OxmField.Init()
 	this.SetOxmLength(uint8(this.minSize()))
 	// Invariants.
 	this.SetOxmClass(uint16(32768)) // oxm_class
-	this.SetOxmField(uint8(22))     // oxm_field
-	this.SetOxmLength(uint8(4))     // oxm_length
+	this.SetOxmField(uint8(42))     // oxm_field
+	this.SetOxmLength(uint8(2))     // oxm_length
 }
 
-func (this OxmIpV4Src) Size() int {
+func (this OxmArpOp) Size() int {
 	if len(this.Buf) < this.minSize() {
 		return 0
 	}
@@ -5797,132 +10290,81 @@ func (this OxmIpV4Src) Size() int {
 	return packet.PaddedSize(size, 1, 4)
 }
 
-func ToOxmIpV4Src(p OxmField) (OxmIpV4Src, error) {
-	if !IsOxmIpV4Src(p) {
-		return NewOxmIpV4SrcWithBuf(nil), errors.New("Cannot convert to of12.OxmIpV4Src")
+func ToOxmArpOp(p OxmField) (OxmArpOp, error) {
+	if !IsOxmArpOp(p) {
+		return NewOxmArpOpWithBuf(nil), errors.New("Cannot convert to of12.OxmArpOp")
 	}
 
-	return NewOxmIpV4SrcWithBuf(p.Buf), nil
+	return NewOxmArpOpWithBuf(p.Buf), nil
 }
 
-func IsOxmIpV4Src(p OxmField) bool {
-	return p.OxmClass() == 32768 && p.OxmField() == 22 && p.OxmLength() == 4 && true
+func IsOxmArpOp(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 42 && p.OxmLength() == 2 && true
 }
 
-func (this OxmIpV4Src) Addr() [4]uint8 {
-	offset := this.AddrOffset()
-	packet_size := this.Size()
-	size := packet_size - offset
-	count := 4
-	i := 0
-	var res [4]uint8
-	for size > 0 && count > 0 && packet_size > offset {
-		elem := uint8(this.Buf[offset])
-		if size < 1 {
-			break
-		}
-		size -= 1
-		offset += 1
-		count--
-		res[i] = elem
-		i++
-	}
+func (this OxmArpOp) Op() uint16 {
+	offset := this.OpOffset()
+	res := binary.BigEndian.Uint16(this.Buf[offset:])
 	return res
 }
 
-func (this *OxmIpV4Src) SetAddr(a [4]uint8) {
-	offset := this.AddrOffset()
-	for _, e := range a {
-		this.Buf[offset] = byte(e)
-		offset++
-	}
+func (this *OxmArpOp) SetOp(o uint16) {
+	offset := this.OpOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], o)
+	offset += 2
 }
 
-func (this OxmIpV4Src) AddrOffset() int {
+func (this OxmArpOp) OpOffset() int {
 	offset := 4
 	return offset
 }
 
-func (this OxmIpV4Src) Mask() [4]uint8 {
-	offset := this.MaskOffset()
-	packet_size := this.Size()
-	size := packet_size - offset
-	count := 4
-	i := 0
-	var res [4]uint8
-	for size > 0 && count > 0 && packet_size > offset {
-		elem := uint8(this.Buf[offset])
-		if size < 1 {
-			break
-		}
-		size -= 1
-		offset += 1
-		count--
-		res[i] = elem
-		i++
-	}
-	return res
-}
-
-func (this *OxmIpV4Src) SetMask(m [4]uint8) {
-	offset := this.MaskOffset()
-	for _, e := range m {
-		this.Buf[offset] = byte(e)
-		offset++
-	}
-}
-
-func (this OxmIpV4Src) MaskOffset() int {
-	offset := 8
-	return offset
-}
-
-func NewOxmIpV4SrcMaskedWithBuf(b []byte) OxmIpV4SrcMasked {
-	return OxmIpV4SrcMasked{OxmField{packet.Packet{Buf: b}}}
+func NewOxmArpSpaWithBuf(b []byte) OxmArpSpa {
+	return OxmArpSpa{OxmField{packet.Packet{Buf: b}}}
 }
 
-func NewOxmIpV4SrcMasked() OxmIpV4SrcMasked {
-	s := packet.PaddedSize(12, 1, 4)
+func NewOxmArpSpa() OxmArpSpa {
+	s := packet.PaddedSize(8, 1, 4)
 	b := make([]byte, s)
-	p := OxmIpV4SrcMasked{OxmField{packet.Packet{Buf: b}}}
+	p := OxmArpSpa{OxmField{packet.Packet{Buf: b}}}
 	p.Init()
 	return p
 }
 
-type OxmIpV4SrcMasked struct {
+type OxmArpSpa struct {
 	OxmField
 }
 
-func (this OxmIpV4SrcMasked) minSize() int {
-	return 12
+func (this OxmArpSpa) minSize() int {
+	return 8
 }
 
-func (this OxmIpV4SrcMasked) Clone() (OxmIpV4SrcMasked, error) {
+func (this OxmArpSpa) Clone() (OxmArpSpa, error) {
 	var newBuf bytes.Buffer
 	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
 	if err != nil {
-		return NewOxmIpV4SrcMasked(), err
+		return NewOxmArpSpa(), err
 	}
 
-	return NewOxmIpV4SrcMaskedWithBuf(newBuf.Bytes()), nil
+	return NewOxmArpSpaWithBuf(newBuf.Bytes()), nil
 }
 
-type OxmIpV4SrcMaskedConn struct {
+type OxmArpSpaConn struct {
 	net.Conn
 	w      *bufio.Writer
 	buf    []byte
 	offset int
 }
 
-func NewOxmIpV4SrcMaskedConn(c net.Conn) OxmIpV4SrcMaskedConn {
-	return OxmIpV4SrcMaskedConn{
+func NewOxmArpSpaConn(c net.Conn) OxmArpSpaConn {
+	return OxmArpSpaConn{
 		Conn: c,
 		w:    bufio.NewWriter(c),
 		buf:  make([]byte, packet.DefaultBufSize),
 	}
 }
 
-func (c *OxmIpV4SrcMaskedConn) WriteOxmIpV4SrcMasked(pkt OxmIpV4SrcMasked) error {
+func (c *OxmArpSpaConn) WriteOxmArpSpa(pkt OxmArpSpa) error {
 	s := pkt.Size()
 	b := pkt.Buffer()[:s]
 	n := 0
@@ -5937,30 +10379,30 @@ func (c *OxmIpV4SrcMaskedConn) WriteOxmIpV4SrcMasked(pkt OxmIpV4SrcMasked) error
 	return nil
 }
 
-func (c *OxmIpV4SrcMaskedConn) WriteOxmIpV4SrcMaskeds(pkts []OxmIpV4SrcMasked) error {
+func (c *OxmArpSpaConn) WriteOxmArpSpas(pkts []OxmArpSpa) error {
 	for _, p := range pkts {
-		if err := c.WriteOxmIpV4SrcMasked(p); err != nil {
+		if err := c.WriteOxmArpSpa(p); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (c *OxmIpV4SrcMaskedConn) Flush() error {
+func (c *OxmArpSpaConn) Flush() error {
 	return c.w.Flush()
-}
-
-func (c *OxmIpV4SrcMaskedConn) ReadOxmIpV4SrcMasked() (OxmIpV4SrcMasked, error) {
-	pkts := make([]OxmIpV4SrcMasked, 1)
-	_, err := c.ReadOxmIpV4SrcMaskeds(pkts)
+}
+
+func (c *OxmArpSpaConn) ReadOxmArpSpa() (OxmArpSpa, error) {
+	pkts := make([]OxmArpSpa, 1)
+	_, err := c.ReadOxmArpSpas(pkts)
 	if err != nil {
-		return NewOxmIpV4SrcMasked(), err
+		return NewOxmArpSpa(), err
 	}
 
 	return pkts[0], nil
 }
 
-func (c *OxmIpV4SrcMaskedConn) ReadOxmIpV4SrcMaskeds(pkts []OxmIpV4SrcMasked) (int, error) {
+func (c *OxmArpSpaConn) ReadOxmArpSpas(pkts []OxmArpSpa) (int, error) {
 	if len(c.buf) == c.offset {
 		newSize := packet.DefaultBufSize
 		if newSize < len(c.buf) {
@@ -5982,7 +10424,7 @@ func (c *OxmIpV4SrcMaskedConn) ReadOxmIpV4SrcMaskeds(pkts []OxmIpV4SrcMasked) (i
 	s := 0
 	n := 0
 	for i := range pkts {
-		p := NewOxmIpV4SrcMaskedWithBuf(c.buf[s:])
+		p := NewOxmArpSpaWithBuf(c.buf[s:])
 
 		pSize := p.Size()
 		if pSize == 0 || r < s+pSize {
@@ -6003,16 +10445,16 @@ func (c *OxmIpV4SrcMaskedConn) ReadOxmIpV4SrcMaskeds(pkts []OxmIpV4SrcMasked) (i
 	return n, nil
 }
 
-func (this *OxmIpV4SrcMasked) Init() {
+func (this *OxmArpSpa) Init() {
 	this.OxmField.Init()
 	this.SetOxmLength(uint8(this.minSize()))
 	// Invariants.
 	this.SetOxmClass(uint16(32768)) // oxm_class
-	this.SetOxmField(uint8(23))     // oxm_field
-	this.SetOxmLength(uint8(8))     // oxm_length
+	this.SetOxmField(uint8(44))     // oxm_field
+	this.SetOxmLength(uint8(4))     // oxm_length
 }
 
-func (this OxmIpV4SrcMasked) Size() int {
+func (this OxmArpSpa) Size() int {
 	if len(this.Buf) < this.minSize() {
 		return 0
 	}
@@ -6021,19 +10463,19 @@ func (this OxmIpV4SrcMasked) Size() int {
 	return packet.PaddedSize(size, 1, 4)
 }
 
-func ToOxmIpV4SrcMasked(p OxmField) (OxmIpV4SrcMasked, error) {
-	if !IsOxmIpV4SrcMasked(p) {
-		return NewOxmIpV4SrcMaskedWithBuf(nil), errors.New("Cannot convert to of12.OxmIpV4SrcMasked")
+func ToOxmArpSpa(p OxmField) (OxmArpSpa, error) {
+	if !IsOxmArpSpa(p) {
+		return NewOxmArpSpaWithBuf(nil), errors.New("Cannot convert to of12.OxmArpSpa")
 	}
 
-	return NewOxmIpV4SrcMaskedWithBuf(p.Buf), nil
+	return NewOxmArpSpaWithBuf(p.Buf), nil
 }
 
-func IsOxmIpV4SrcMasked(p OxmField) bool {
-	return p.OxmClass() == 32768 && p.OxmField() == 23 && p.OxmLength() == 8 && true
+func IsOxmArpSpa(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 44 && p.OxmLength() == 4 && true
 }
 
-func (this OxmIpV4SrcMasked) Addr() [4]uint8 {
+func (this OxmArpSpa) Addr() [4]uint8 {
 	offset := this.AddrOffset()
 	packet_size := this.Size()
 	size := packet_size - offset
@@ -6054,7 +10496,7 @@ func (this OxmIpV4SrcMasked) Addr() [4]uint8 {
 	return res
 }
 
-func (this *OxmIpV4SrcMasked) SetAddr(a [4]uint8) {
+func (this *OxmArpSpa) SetAddr(a [4]uint8) {
 	offset := this.AddrOffset()
 	for _, e := range a {
 		this.Buf[offset] = byte(e)
@@ -6062,91 +10504,57 @@ func (this *OxmIpV4SrcMasked) SetAddr(a [4]uint8) {
 	}
 }
 
-func (this OxmIpV4SrcMasked) AddrOffset() int {
+func (this OxmArpSpa) AddrOffset() int {
 	offset := 4
 	return offset
 }
 
-func (this OxmIpV4SrcMasked) Mask() [4]uint8 {
-	offset := this.MaskOffset()
-	packet_size := this.Size()
-	size := packet_size - offset
-	count := 4
-	i := 0
-	var res [4]uint8
-	for size > 0 && count > 0 && packet_size > offset {
-		elem := uint8(this.Buf[offset])
-		if size < 1 {
-			break
-		}
-		size -= 1
-		offset += 1
-		count--
-		res[i] = elem
-		i++
-	}
-	return res
-}
-
-func (this *OxmIpV4SrcMasked) SetMask(m [4]uint8) {
-	offset := this.MaskOffset()
-	for _, e := range m {
-		this.Buf[offset] = byte(e)
-		offset++
-	}
-}
-
-func (this OxmIpV4SrcMasked) MaskOffset() int {
-	offset := 8
-	return offset
-}
-
-func NewOxmIpV4DstWithBuf(b []byte) OxmIpV4Dst {
-	return OxmIpV4Dst{OxmField{packet.Packet{Buf: b}}}
+func NewOxmArpSpaMaskedWithBuf(b []byte) OxmArpSpaMasked {
+	return OxmArpSpaMasked{OxmField{packet.Packet{Buf: b}}}
 }
 
-func NewOxmIpV4Dst() OxmIpV4Dst {
-	s := packet.PaddedSize(8, 1, 4)
+func NewOxmArpSpaMasked() OxmArpSpaMasked {
+	s := packet.PaddedSize(12, 1, 4)
 	b := make([]byte, s)
-	p := OxmIpV4Dst{OxmField{packet.Packet{Buf: b}}}
+	p := OxmArpSpaMasked{OxmField{packet.Packet{Buf: b}}}
 	p.Init()
 	return p
 }
 
-type OxmIpV4Dst struct {
+type OxmArpSpaMasked struct {
 	OxmField
 }
 
-func (this OxmIpV4Dst) minSize() int {
-	return 8
+func (this OxmArpSpaMasked) minSize() int {
+	return 12
 }
 
-func (this OxmIpV4Dst) Clone() (OxmIpV4Dst, error) {
+func (this OxmArpSpaMasked) Clone() (OxmArpSpaMasked, error) {
 	var newBuf bytes.Buffer
 	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
 	if err != nil {
-		return NewOxmIpV4Dst(), err
+		return NewOxmArpSpaMasked(), err
 	}
 
-	return NewOxmIpV4DstWithBuf(newBuf.Bytes()), nil
+	return NewOxmArpSpaMaskedWithBuf(newBuf.Bytes()), nil
 }
 
-type OxmIpV4DstConn struct {
+type OxmArpSpaMaskedConn struct {
 	net.Conn
 	w      *bufio.Writer
 	buf    []byte
 	offset int
 }
 
-func NewOxmIpV4DstConn(c net.Conn) OxmIpV4DstConn {
-	return OxmIpV4DstConn{
+func NewOxmArpSpaMaskedConn(c net.Conn) OxmArpSpaMaskedConn {
+	return OxmArpSpaMaskedConn{
 		Conn: c,
 		w:    bufio.NewWriter(c),
 		buf:  make([]byte, packet.DefaultBufSize),
 	}
 }
 
-func (c *OxmIpV4DstConn) WriteOxmIpV4Dst(pkt OxmIpV4Dst) error {
+func (c *OxmArpSpaMaskedConn) WriteOxmArpSpaMasked(pkt OxmArpSpaMasked) error {
 	s := pkt.Size()
 	b := pkt.Buffer()[:s]
 	n := 0
@@ -6161,30 +10569,30 @@ func (c *OxmIpV4DstConn) WriteOxmIpV4Dst(pkt OxmIpV4Dst) error {
 	return nil
 }
 
-func (c *OxmIpV4DstConn) WriteOxmIpV4Dsts(pkts []OxmIpV4Dst) error {
+func (c *OxmArpSpaMaskedConn) WriteOxmArpSpaMaskeds(pkts []OxmArpSpaMasked) error {
 	for _, p := range pkts {
-		if err := c.WriteOxmIpV4Dst(p); err != nil {
+		if err := c.WriteOxmArpSpaMasked(p); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (c *OxmIpV4DstConn) Flush() error {
+func (c *OxmArpSpaMaskedConn) Flush() error {
 	return c.w.Flush()
 }
 
-func (c *OxmIpV4DstConn) ReadOxmIpV4Dst() (OxmIpV4Dst, error) {
-	pkts := make([]OxmIpV4Dst, 1)
-	_, err := c.ReadOxmIpV4Dsts(pkts)
+func (c *OxmArpSpaMaskedConn) ReadOxmArpSpaMasked() (OxmArpSpaMasked, error) {
+	pkts := make([]OxmArpSpaMasked, 1)
+	_, err := c.ReadOxmArpSpaMaskeds(pkts)
 	if err != nil {
-		return NewOxmIpV4Dst(), err
+		return NewOxmArpSpaMasked(), err
 	}
 
 	return pkts[0], nil
 }
 
-func (c *OxmIpV4DstConn) ReadOxmIpV4Dsts(pkts []OxmIpV4Dst) (int, error) {
+func (c *OxmArpSpaMaskedConn) ReadOxmArpSpaMaskeds(pkts []OxmArpSpaMasked) (int, error) {
 	if len(c.buf) == c.offset {
 		newSize := packet.DefaultBufSize
 		if newSize < len(c.buf) {
@@ -6206,7 +10614,7 @@ func (c *OxmIpV4DstConn) ReadOxmIpV4Dsts(pkts []OxmIpV4Dst) (int, error) {
 	s := 0
 	n := 0
 	for i := range pkts {
-		p := NewOxmIpV4DstWithBuf(c.buf[s:])
+		p := NewOxmArpSpaMaskedWithBuf(c.buf[s:])
 
 		pSize := p.Size()
 		if pSize == 0 || r < s+pSize {
@@ -6227,16 +10635,16 @@ func (c *OxmIpV4DstConn) ReadOxmIpV4Dsts(pkts []OxmIpV4Dst) (int, error) {
 	return n, nil
 }
 
-func (this *OxmIpV4Dst) Init() {
+func (this *OxmArpSpaMasked) Init() {
 	this.OxmField.Init()
 	this.SetOxmLength(uint8(this.minSize()))
 	// Invariants.
 	this.SetOxmClass(uint16(32768)) // oxm_class
-	this.SetOxmField(uint8(24))     // oxm_field
-	this.SetOxmLength(uint8(4))     // oxm_length
+	this.SetOxmField(uint8(45))     // oxm_field
+	this.SetOxmLength(uint8(8))     // oxm_length
 }
 
-func (this OxmIpV4Dst) Size() int {
+func (this OxmArpSpaMasked) Size() int {
 	if len(this.Buf) < this.minSize() {
 		return 0
 	}
@@ -6245,19 +10653,19 @@ func (this OxmIpV4Dst) Size() int {
 	return packet.PaddedSize(size, 1, 4)
 }
 
-func ToOxmIpV4Dst(p OxmField) (OxmIpV4Dst, error) {
-	if !IsOxmIpV4Dst(p) {
-		return NewOxmIpV4DstWithBuf(nil), errors.New("Cannot convert to of12.OxmIpV4Dst")
+func ToOxmArpSpaMasked(p OxmField) (OxmArpSpaMasked, error) {
+	if !IsOxmArpSpaMasked(p) {
+		return NewOxmArpSpaMaskedWithBuf(nil), errors.New("Cannot convert to of12.OxmArpSpaMasked")
 	}
 
-	return NewOxmIpV4DstWithBuf(p.Buf), nil
+	return NewOxmArpSpaMaskedWithBuf(p.Buf), nil
 }
 
-func IsOxmIpV4Dst(p OxmField) bool {
-	return p.OxmClass() == 32768 && p.OxmField() == 24 && p.OxmLength() == 4 && true
+func IsOxmArpSpaMasked(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 45 && p.OxmLength() == 8 && true
 }
 
-func (this OxmIpV4Dst) Addr() [4]uint8 {
+func (this OxmArpSpaMasked) Addr() [4]uint8 {
 	offset := this.AddrOffset()
 	packet_size := this.Size()
 	size := packet_size - offset
@@ -6278,7 +10686,7 @@ func (this OxmIpV4Dst) Addr() [4]uint8 {
 	return res
 }
 
-func (this *OxmIpV4Dst) SetAddr(a [4]uint8) {
+func (this *OxmArpSpaMasked) SetAddr(a [4]uint8) {
 	offset := this.AddrOffset()
 	for _, e := range a {
 		this.Buf[offset] = byte(e)
@@ -6286,57 +10694,91 @@ func (this *OxmIpV4Dst) SetAddr(a [4]uint8) {
 	}
 }
 
-func (this OxmIpV4Dst) AddrOffset() int {
+func (this OxmArpSpaMasked) AddrOffset() int {
 	offset := 4
 	return offset
 }
 
-func NewOxmIpV4DstMaskedWithBuf(b []byte) OxmIpV4DstMasked {
-	return OxmIpV4DstMasked{OxmField{packet.Packet{Buf: b}}}
+func (this OxmArpSpaMasked) Mask() [4]uint8 {
+	offset := this.MaskOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := 4
+	i := 0
+	var res [4]uint8
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := uint8(this.Buf[offset])
+		if size < 1 {
+			break
+		}
+		size -= 1
+		offset += 1
+		count--
+		res[i] = elem
+		i++
+	}
+	return res
 }
 
-func NewOxmIpV4DstMasked() OxmIpV4DstMasked {
-	s := packet.PaddedSize(12, 1, 4)
+func (this *OxmArpSpaMasked) SetMask(m [4]uint8) {
+	offset := this.MaskOffset()
+	for _, e := range m {
+		this.Buf[offset] = byte(e)
+		offset++
+	}
+}
+
+func (this OxmArpSpaMasked) MaskOffset() int {
+	offset := 8
+	return offset
+}
+
+func NewOxmArpTpaWithBuf(b []byte) OxmArpTpa {
+	return OxmArpTpa{OxmField{packet.Packet{Buf: b}}}
+}
+
+func NewOxmArpTpa() OxmArpTpa {
+	s := packet.PaddedSize(8, 1, 4)
 	b := make([]byte, s)
-	p := OxmIpV4DstMasked{OxmField{packet.Packet{Buf: b}}}
+	p := OxmArpTpa{OxmField{packet.Packet{Buf: b}}}
 	p.Init()
 	return p
 }
 
-type OxmIpV4DstMasked struct {
+type OxmArpTpa struct {
 	OxmField
 }
 
-func (this OxmIpV4DstMasked) minSize() int {
-	return 12
+func (this OxmArpTpa) minSize() int {
+	return 8
 }
 
-func (this OxmIpV4DstMasked) Clone() (OxmIpV4DstMasked, error) {
+func (this OxmArpTpa) Clone() (OxmArpTpa, error) {
 	var newBuf bytes.Buffer
 	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
 	if err != nil {
-		return NewOxmIpV4DstMasked(), err
+		return NewOxmArpTpa(), err
 	}
 
-	return NewOxmIpV4DstMaskedWithBuf(newBuf.Bytes()), nil
+	return NewOxmArpTpaWithBuf(newBuf.Bytes()), nil
 }
 
-type OxmIpV4DstMaskedConn struct {
+type OxmArpTpaConn struct {
 	net.Conn
 	w      *bufio.Writer
 	buf    []byte
 	offset int
 }
 
-func NewOxmIpV4DstMaskedConn(c net.Conn) OxmIpV4DstMaskedConn {
-	return OxmIpV4DstMaskedConn{
+func NewOxmArpTpaConn(c net.Conn) OxmArpTpaConn {
+	return OxmArpTpaConn{
 		Conn: c,
 		w:    bufio.NewWriter(c),
 		buf:  make([]byte, packet.DefaultBufSize),
 	}
 }
 
-func (c *OxmIpV4DstMaskedConn) WriteOxmIpV4DstMasked(pkt OxmIpV4DstMasked) error {
+func (c *OxmArpTpaConn) WriteOxmArpTpa(pkt OxmArpTpa) error {
 	s := pkt.Size()
 	b := pkt.Buffer()[:s]
 	n := 0
@@ -6351,30 +10793,30 @@ func (c *OxmIpV4DstMaskedConn) WriteOxmIpV4DstMasked(pkt OxmIpV4DstMasked) error
 	return nil
 }
 
-func (c *OxmIpV4DstMaskedConn) WriteOxmIpV4DstMaskeds(pkts []OxmIpV4DstMasked) error {
+func (c *OxmArpTpaConn) WriteOxmArpTpas(pkts []OxmArpTpa) error {
 	for _, p := range pkts {
-		if err := c.WriteOxmIpV4DstMasked(p); err != nil {
+		if err := c.WriteOxmArpTpa(p); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (c *OxmIpV4DstMaskedConn) Flush() error {
+func (c *OxmArpTpaConn) Flush() error {
 	return c.w.Flush()
 }
 
-func (c *OxmIpV4DstMaskedConn) ReadOxmIpV4DstMasked() (OxmIpV4DstMasked, error) {
-	pkts := make([]OxmIpV4DstMasked, 1)
-	_, err := c.ReadOxmIpV4DstMaskeds(pkts)
+func (c *OxmArpTpaConn) ReadOxmArpTpa() (OxmArpTpa, error) {
+	pkts := make([]OxmArpTpa, 1)
+	_, err := c.ReadOxmArpTpas(pkts)
 	if err != nil {
-		return NewOxmIpV4DstMasked(), err
+		return NewOxmArpTpa(), err
 	}
 
 	return pkts[0], nil
 }
 
-func (c *OxmIpV4DstMaskedConn) ReadOxmIpV4DstMaskeds(pkts []OxmIpV4DstMasked) (int, error) {
+func (c *OxmArpTpaConn) ReadOxmArpTpas(pkts []OxmArpTpa) (int, error) {
 	if len(c.buf) == c.offset {
 		newSize := packet.DefaultBufSize
 		if newSize < len(c.buf) {
@@ -6396,7 +10838,7 @@ func (c *OxmIpV4DstMaskedConn) ReadOxmIpV4DstMaskeds(pkts []OxmIpV4DstMasked) (i
 	s := 0
 	n := 0
 	for i := range pkts {
-		p := NewOxmIpV4DstMaskedWithBuf(c.buf[s:])
+		p := NewOxmArpTpaWithBuf(c.buf[s:])
 
 		pSize := p.Size()
 		if pSize == 0 || r < s+pSize {
@@ -6417,16 +10859,16 @@ func (c *OxmIpV4DstMaskedConn) ReadOxmIpV4DstMaskeds(pkts []OxmIpV4DstMasked) (i
 	return n, nil
 }
 
-func (this *OxmIpV4DstMasked) Init() {
+func (this *OxmArpTpa) Init() {
 	this.OxmField.Init()
 	this.SetOxmLength(uint8(this.minSize()))
 	// Invariants.
 	this.SetOxmClass(uint16(32768)) // oxm_class
-	this.SetOxmField(uint8(25))     // oxm_field
-	this.SetOxmLength(uint8(8))     // oxm_length
+	this.SetOxmField(uint8(46))     // oxm_field
+	this.SetOxmLength(uint8(4))     // oxm_length
 }
 
-func (this OxmIpV4DstMasked) Size() int {
+func (this OxmArpTpa) Size() int {
 	if len(this.Buf) < this.minSize() {
 		return 0
 	}
@@ -6435,19 +10877,19 @@ func (this OxmIpV4DstMasked) Size() int {
 	return packet.PaddedSize(size, 1, 4)
 }
 
-func ToOxmIpV4DstMasked(p OxmField) (OxmIpV4DstMasked, error) {
-	if !IsOxmIpV4DstMasked(p) {
-		return NewOxmIpV4DstMaskedWithBuf(nil), errors.New("Cannot convert to of12.OxmIpV4DstMasked")
+func ToOxmArpTpa(p OxmField) (OxmArpTpa, error) {
+	if !IsOxmArpTpa(p) {
+		return NewOxmArpTpaWithBuf(nil), errors.New("Cannot convert to of12.OxmArpTpa")
 	}
 
-	return NewOxmIpV4DstMaskedWithBuf(p.Buf), nil
+	return NewOxmArpTpaWithBuf(p.Buf), nil
 }
 
-func IsOxmIpV4DstMasked(p OxmField) bool {
-	return p.OxmClass() == 32768 && p.OxmField() == 25 && p.OxmLength() == 8 && true
+func IsOxmArpTpa(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 46 && p.OxmLength() == 4 && true
 }
 
-func (this OxmIpV4DstMasked) Addr() [4]uint8 {
+func (this OxmArpTpa) Addr() [4]uint8 {
 	offset := this.AddrOffset()
 	packet_size := this.Size()
 	size := packet_size - offset
@@ -6468,7 +10910,7 @@ func (this OxmIpV4DstMasked) Addr() [4]uint8 {
 	return res
 }
 
-func (this *OxmIpV4DstMasked) SetAddr(a [4]uint8) {
+func (this *OxmArpTpa) SetAddr(a [4]uint8) {
 	offset := this.AddrOffset()
 	for _, e := range a {
 		this.Buf[offset] = byte(e)
@@ -6476,91 +10918,57 @@ func (this *OxmIpV4DstMasked) SetAddr(a [4]uint8) {
 	}
 }
 
-func (this OxmIpV4DstMasked) AddrOffset() int {
+func (this OxmArpTpa) AddrOffset() int {
 	offset := 4
 	return offset
 }
 
-func (this OxmIpV4DstMasked) Mask() [4]uint8 {
-	offset := this.MaskOffset()
-	packet_size := this.Size()
-	size := packet_size - offset
-	count := 4
-	i := 0
-	var res [4]uint8
-	for size > 0 && count > 0 && packet_size > offset {
-		elem := uint8(this.Buf[offset])
-		if size < 1 {
-			break
-		}
-		size -= 1
-		offset += 1
-		count--
-		res[i] = elem
-		i++
-	}
-	return res
-}
-
-func (this *OxmIpV4DstMasked) SetMask(m [4]uint8) {
-	offset := this.MaskOffset()
-	for _, e := range m {
-		this.Buf[offset] = byte(e)
-		offset++
-	}
-}
-
-func (this OxmIpV4DstMasked) MaskOffset() int {
-	offset := 8
-	return offset
-}
-
-func NewOxmIpV6SrcWithBuf(b []byte) OxmIpV6Src {
-	return OxmIpV6Src{OxmField{packet.Packet{Buf: b}}}
+func NewOxmArpTpaMaskedWithBuf(b []byte) OxmArpTpaMasked {
+	return OxmArpTpaMasked{OxmField{packet.Packet{Buf: b}}}
 }
 
-func NewOxmIpV6Src() OxmIpV6Src {
-	s := packet.PaddedSize(20, 1, 4)
+func NewOxmArpTpaMasked() OxmArpTpaMasked {
+	s := packet.PaddedSize(12, 1, 4)
 	b := make([]byte, s)
-	p := OxmIpV6Src{OxmField{packet.Packet{Buf: b}}}
+	p := OxmArpTpaMasked{OxmField{packet.Packet{Buf: b}}}
 	p.Init()
 	return p
 }
 
-type OxmIpV6Src struct {
+type OxmArpTpaMasked struct {
 	OxmField
 }
 
-func (this OxmIpV6Src) minSize() int {
-	return 20
+func (this OxmArpTpaMasked) minSize() int {
+	return 12
 }
 
-func (this OxmIpV6Src) Clone() (OxmIpV6Src, error) {
+func (this OxmArpTpaMasked) Clone() (OxmArpTpaMasked, error) {
 	var newBuf bytes.Buffer
 	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
 	if err != nil {
-		return NewOxmIpV6Src(), err
+		return NewOxmArpTpaMasked(), err
 	}
 
-	return NewOxmIpV6SrcWithBuf(newBuf.Bytes()), nil
+	return NewOxmArpTpaMaskedWithBuf(newBuf.Bytes()), nil
 }
 
-type OxmIpV6SrcConn struct {
+type OxmArpTpaMaskedConn struct {
 	net.Conn
 	w      *bufio.Writer
 	buf    []byte
 	offset int
 }
 
-func NewOxmIpV6SrcConn(c net.Conn) OxmIpV6SrcConn {
-	return OxmIpV6SrcConn{
+func NewOxmArpTpaMaskedConn(c net.Conn) OxmArpTpaMaskedConn {
+	return OxmArpTpaMaskedConn{
 		Conn: c,
 		w:    bufio.NewWriter(c),
 		buf:  make([]byte, packet.DefaultBufSize),
 	}
 }
 
-func (c *OxmIpV6SrcConn) WriteOxmIpV6Src(pkt OxmIpV6Src) error {
+func (c *OxmArpTpaMaskedConn) WriteOxmArpTpaMasked(pkt OxmArpTpaMasked) error {
 	s := pkt.Size()
 	b := pkt.Buffer()[:s]
 	n := 0
@@ -6575,30 +10983,30 @@ func (c *OxmIpV6SrcConn) WriteOxmIpV6Src(pkt OxmIpV6Src) error {
 	return nil
 }
 
-func (c *OxmIpV6SrcConn) WriteOxmIpV6Srcs(pkts []OxmIpV6Src) error {
+func (c *OxmArpTpaMaskedConn) WriteOxmArpTpaMaskeds(pkts []OxmArpTpaMasked) error {
 	for _, p := range pkts {
-		if err := c.WriteOxmIpV6Src(p); err != nil {
+		if err := c.WriteOxmArpTpaMasked(p); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (c *OxmIpV6SrcConn) Flush() error {
+func (c *OxmArpTpaMaskedConn) Flush() error {
 	return c.w.Flush()
 }
 
-func (c *OxmIpV6SrcConn) ReadOxmIpV6Src() (OxmIpV6Src, error) {
-	pkts := make([]OxmIpV6Src, 1)
-	_, err := c.ReadOxmIpV6Srcs(pkts)
+func (c *OxmArpTpaMaskedConn) ReadOxmArpTpaMasked() (OxmArpTpaMasked, error) {
+	pkts := make([]OxmArpTpaMasked, 1)
+	_, err := c.ReadOxmArpTpaMaskeds(pkts)
 	if err != nil {
-		return NewOxmIpV6Src(), err
+		return NewOxmArpTpaMasked(), err
 	}
 
 	return pkts[0], nil
 }
 
-func (c *OxmIpV6SrcConn) ReadOxmIpV6Srcs(pkts []OxmIpV6Src) (int, error) {
+func (c *OxmArpTpaMaskedConn) ReadOxmArpTpaMaskeds(pkts []OxmArpTpaMasked) (int, error) {
 	if len(c.buf) == c.offset {
 		newSize := packet.DefaultBufSize
 		if newSize < len(c.buf) {
@@ -6620,7 +11028,7 @@ func (c *OxmIpV6SrcConn) ReadOxmIpV6Srcs(pkts []OxmIpV6Src) (int, error) {
 	s := 0
 	n := 0
 	for i := range pkts {
-		p := NewOxmIpV6SrcWithBuf(c.buf[s:])
+		p := NewOxmArpTpaMaskedWithBuf(c.buf[s:])
 
 		pSize := p.Size()
 		if pSize == 0 || r < s+pSize {
@@ -6641,16 +11049,16 @@ func (c *OxmIpV6SrcConn) ReadOxmIpV6Srcs(pkts []OxmIpV6Src) (int, error) {
 	return n, nil
 }
 
-func (this *OxmIpV6Src) Init() {
+func (this *OxmArpTpaMasked) Init() {
 	this.OxmField.Init()
 	this.SetOxmLength(uint8(this.minSize()))
 	// Invariants.
 	this.SetOxmClass(uint16(32768)) // oxm_class
-	this.SetOxmField(uint8(52))     // oxm_field
-	this.SetOxmLength(uint8(16))    // oxm_length
+	this.SetOxmField(uint8(47))     // oxm_field
+	this.SetOxmLength(uint8(8))     // oxm_length
 }
 
-func (this OxmIpV6Src) Size() int {
+func (this OxmArpTpaMasked) Size() int {
 	if len(this.Buf) < this.minSize() {
 		return 0
 	}
@@ -6659,25 +11067,59 @@ func (this OxmIpV6Src) Size() int {
 	return packet.PaddedSize(size, 1, 4)
 }
 
-func ToOxmIpV6Src(p OxmField) (OxmIpV6Src, error) {
-	if !IsOxmIpV6Src(p) {
-		return NewOxmIpV6SrcWithBuf(nil), errors.New("Cannot convert to of12.OxmIpV6Src")
+func ToOxmArpTpaMasked(p OxmField) (OxmArpTpaMasked, error) {
+	if !IsOxmArpTpaMasked(p) {
+		return NewOxmArpTpaMaskedWithBuf(nil), errors.New("Cannot convert to of12.OxmArpTpaMasked")
+	}
+
+	return NewOxmArpTpaMaskedWithBuf(p.Buf), nil
+}
+
+func IsOxmArpTpaMasked(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 47 && p.OxmLength() == 8 && true
+}
+
+func (this OxmArpTpaMasked) Addr() [4]uint8 {
+	offset := this.AddrOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := 4
+	i := 0
+	var res [4]uint8
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := uint8(this.Buf[offset])
+		if size < 1 {
+			break
+		}
+		size -= 1
+		offset += 1
+		count--
+		res[i] = elem
+		i++
 	}
+	return res
+}
 
-	return NewOxmIpV6SrcWithBuf(p.Buf), nil
+func (this *OxmArpTpaMasked) SetAddr(a [4]uint8) {
+	offset := this.AddrOffset()
+	for _, e := range a {
+		this.Buf[offset] = byte(e)
+		offset++
+	}
 }
 
-func IsOxmIpV6Src(p OxmField) bool {
-	return p.OxmClass() == 32768 && p.OxmField() == 52 && p.OxmLength() == 16 && true
+func (this OxmArpTpaMasked) AddrOffset() int {
+	offset := 4
+	return offset
 }
 
-func (this OxmIpV6Src) Addr() [16]uint8 {
-	offset := this.AddrOffset()
+func (this OxmArpTpaMasked) Mask() [4]uint8 {
+	offset := this.MaskOffset()
 	packet_size := this.Size()
 	size := packet_size - offset
-	count := 16
+	count := 4
 	i := 0
-	var res [16]uint8
+	var res [4]uint8
 	for size > 0 && count > 0 && packet_size > offset {
 		elem := uint8(this.Buf[offset])
 		if size < 1 {
@@ -6692,65 +11134,65 @@ func (this OxmIpV6Src) Addr() [16]uint8 {
 	return res
 }
 
-func (this *OxmIpV6Src) SetAddr(a [16]uint8) {
-	offset := this.AddrOffset()
-	for _, e := range a {
+func (this *OxmArpTpaMasked) SetMask(m [4]uint8) {
+	offset := this.MaskOffset()
+	for _, e := range m {
 		this.Buf[offset] = byte(e)
 		offset++
 	}
 }
 
-func (this OxmIpV6Src) AddrOffset() int {
-	offset := 4
+func (this OxmArpTpaMasked) MaskOffset() int {
+	offset := 8
 	return offset
 }
 
-func NewOxmIpV6SrcMaskedWithBuf(b []byte) OxmIpV6SrcMasked {
-	return OxmIpV6SrcMasked{OxmField{packet.Packet{Buf: b}}}
+func NewOxmIpV6FlabelWithBuf(b []byte) OxmIpV6Flabel {
+	return OxmIpV6Flabel{OxmField{packet.Packet{Buf: b}}}
 }
 
-func NewOxmIpV6SrcMasked() OxmIpV6SrcMasked {
-	s := packet.PaddedSize(36, 1, 4)
+func NewOxmIpV6Flabel() OxmIpV6Flabel {
+	s := packet.PaddedSize(8, 1, 4)
 	b := make([]byte, s)
-	p := OxmIpV6SrcMasked{OxmField{packet.Packet{Buf: b}}}
+	p := OxmIpV6Flabel{OxmField{packet.Packet{Buf: b}}}
 	p.Init()
 	return p
 }
 
-type OxmIpV6SrcMasked struct {
+type OxmIpV6Flabel struct {
 	OxmField
 }
 
-func (this OxmIpV6SrcMasked) minSize() int {
-	return 36
+func (this OxmIpV6Flabel) minSize() int {
+	return 8
 }
 
-func (this OxmIpV6SrcMasked) Clone() (OxmIpV6SrcMasked, error) {
+func (this OxmIpV6Flabel) Clone() (OxmIpV6Flabel, error) {
 	var newBuf bytes.Buffer
 	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
 	if err != nil {
-		return NewOxmIpV6SrcMasked(), err
+		return NewOxmIpV6Flabel(), err
 	}
 
-	return NewOxmIpV6SrcMaskedWithBuf(newBuf.Bytes()), nil
+	return NewOxmIpV6FlabelWithBuf(newBuf.Bytes()), nil
 }
 
-type OxmIpV6SrcMaskedConn struct {
+type OxmIpV6FlabelConn struct {
 	net.Conn
 	w      *bufio.Writer
 	buf    []byte
 	offset int
 }
 
-func NewOxmIpV6SrcMaskedConn(c net.Conn) OxmIpV6SrcMaskedConn {
-	return OxmIpV6SrcMaskedConn{
+func NewOxmIpV6FlabelConn(c net.Conn) OxmIpV6FlabelConn {
+	return OxmIpV6FlabelConn{
 		Conn: c,
 		w:    bufio.NewWriter(c),
 		buf:  make([]byte, packet.DefaultBufSize),
 	}
 }
 
-func (c *OxmIpV6SrcMaskedConn) WriteOxmIpV6SrcMasked(pkt OxmIpV6SrcMasked) error {
+func (c *OxmIpV6FlabelConn) WriteOxmIpV6Flabel(pkt OxmIpV6Flabel) error {
 	s := pkt.Size()
 	b := pkt.Buffer()[:s]
 	n := 0
@@ -6765,30 +11207,30 @@ func (c *OxmIpV6SrcMaskedConn) WriteOxmIpV6SrcMasked(pkt OxmIpV6SrcMasked) error
 	return nil
 }
 
-func (c *OxmIpV6SrcMaskedConn) WriteOxmIpV6SrcMaskeds(pkts []OxmIpV6SrcMasked) error {
+func (c *OxmIpV6FlabelConn) WriteOxmIpV6Flabels(pkts []OxmIpV6Flabel) error {
 	for _, p := range pkts {
-		if err := c.WriteOxmIpV6SrcMasked(p); err != nil {
+		if err := c.WriteOxmIpV6Flabel(p); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (c *OxmIpV6SrcMaskedConn) Flush() error {
+func (c *OxmIpV6FlabelConn) Flush() error {
 	return c.w.Flush()
 }
 
-func (c *OxmIpV6SrcMaskedConn) ReadOxmIpV6SrcMasked() (OxmIpV6SrcMasked, error) {
-	pkts := make([]OxmIpV6SrcMasked, 1)
-	_, err := c.ReadOxmIpV6SrcMaskeds(pkts)
+func (c *OxmIpV6FlabelConn) ReadOxmIpV6Flabel() (OxmIpV6Flabel, error) {
+	pkts := make([]OxmIpV6Flabel, 1)
+	_, err := c.ReadOxmIpV6Flabels(pkts)
 	if err != nil {
-		return NewOxmIpV6SrcMasked(), err
+		return NewOxmIpV6Flabel(), err
 	}
 
 	return pkts[0], nil
 }
 
-func (c *OxmIpV6SrcMaskedConn) ReadOxmIpV6SrcMaskeds(pkts []OxmIpV6SrcMasked) (int, error) {
+func (c *OxmIpV6FlabelConn) ReadOxmIpV6Flabels(pkts []OxmIpV6Flabel) (int, error) {
 	if len(c.buf) == c.offset {
 		newSize := packet.DefaultBufSize
 		if newSize < len(c.buf) {
@@ -6810,7 +11252,7 @@ func (c *OxmIpV6SrcMaskedConn) ReadOxmIpV6SrcMaskeds(pkts []OxmIpV6SrcMasked) (i
 	s := 0
 	n := 0
 	for i := range pkts {
-		p := NewOxmIpV6SrcMaskedWithBuf(c.buf[s:])
+		p := NewOxmIpV6FlabelWithBuf(c.buf[s:])
 
 		pSize := p.Size()
 		if pSize == 0 || r < s+pSize {
@@ -6831,16 +11273,16 @@ func (c *OxmIpV6SrcMaskedConn) ReadOxmIpV6SrcMaskeds(pkts []OxmIpV6SrcMasked) (i
 	return n, nil
 }
 
-func (this *OxmIpV6SrcMasked) Init() {
+func (this *OxmIpV6Flabel) Init() {
 	this.OxmField.Init()
 	this.SetOxmLength(uint8(this.minSize()))
 	// Invariants.
 	this.SetOxmClass(uint16(32768)) // oxm_class
-	this.SetOxmField(uint8(53))     // oxm_field
-	this.SetOxmLength(uint8(32))    // oxm_length
+	this.SetOxmField(uint8(56))     // oxm_field
+	this.SetOxmLength(uint8(4))     // oxm_length
 }
 
-func (this OxmIpV6SrcMasked) Size() int {
+func (this OxmIpV6Flabel) Size() int {
 	if len(this.Buf) < this.minSize() {
 		return 0
 	}
@@ -6849,132 +11291,81 @@ func (this OxmIpV6SrcMasked) Size() int {
 	return packet.PaddedSize(size, 1, 4)
 }
 
-func ToOxmIpV6SrcMasked(p OxmField) (OxmIpV6SrcMasked, error) {
-	if !IsOxmIpV6SrcMasked(p) {
-		return NewOxmIpV6SrcMaskedWithBuf(nil), errors.New("Cannot convert to of12.OxmIpV6SrcMasked")
+func ToOxmIpV6Flabel(p OxmField) (OxmIpV6Flabel, error) {
+	if !IsOxmIpV6Flabel(p) {
+		return NewOxmIpV6FlabelWithBuf(nil), errors.New("Cannot convert to of12.OxmIpV6Flabel")
 	}
 
-	return NewOxmIpV6SrcMaskedWithBuf(p.Buf), nil
+	return NewOxmIpV6FlabelWithBuf(p.Buf), nil
 }
 
-func IsOxmIpV6SrcMasked(p OxmField) bool {
-	return p.OxmClass() == 32768 && p.OxmField() == 53 && p.OxmLength() == 32 && true
+func IsOxmIpV6Flabel(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 56 && p.OxmLength() == 4 && true
 }
 
-func (this OxmIpV6SrcMasked) Addr() [16]uint8 {
-	offset := this.AddrOffset()
-	packet_size := this.Size()
-	size := packet_size - offset
-	count := 16
-	i := 0
-	var res [16]uint8
-	for size > 0 && count > 0 && packet_size > offset {
-		elem := uint8(this.Buf[offset])
-		if size < 1 {
-			break
-		}
-		size -= 1
-		offset += 1
-		count--
-		res[i] = elem
-		i++
-	}
+func (this OxmIpV6Flabel) Flabel() uint32 {
+	offset := this.FlabelOffset()
+	res := binary.BigEndian.Uint32(this.Buf[offset:])
 	return res
 }
 
-func (this *OxmIpV6SrcMasked) SetAddr(a [16]uint8) {
-	offset := this.AddrOffset()
-	for _, e := range a {
-		this.Buf[offset] = byte(e)
-		offset++
-	}
+func (this *OxmIpV6Flabel) SetFlabel(f uint32) {
+	offset := this.FlabelOffset()
+	binary.BigEndian.PutUint32(this.Buf[offset:], f)
+	offset += 4
 }
 
-func (this OxmIpV6SrcMasked) AddrOffset() int {
+func (this OxmIpV6Flabel) FlabelOffset() int {
 	offset := 4
 	return offset
 }
 
-func (this OxmIpV6SrcMasked) Mask() [16]uint8 {
-	offset := this.MaskOffset()
-	packet_size := this.Size()
-	size := packet_size - offset
-	count := 16
-	i := 0
-	var res [16]uint8
-	for size > 0 && count > 0 && packet_size > offset {
-		elem := uint8(this.Buf[offset])
-		if size < 1 {
-			break
-		}
-		size -= 1
-		offset += 1
-		count--
-		res[i] = elem
-		i++
-	}
-	return res
-}
-
-func (this *OxmIpV6SrcMasked) SetMask(m [16]uint8) {
-	offset := this.MaskOffset()
-	for _, e := range m {
-		this.Buf[offset] = byte(e)
-		offset++
-	}
-}
-
-func (this OxmIpV6SrcMasked) MaskOffset() int {
-	offset := 20
-	return offset
-}
-
-func NewOxmIpV6DstWithBuf(b []byte) OxmIpV6Dst {
-	return OxmIpV6Dst{OxmField{packet.Packet{Buf: b}}}
+func NewOxmIpV6FlabelMaskedWithBuf(b []byte) OxmIpV6FlabelMasked {
+	return OxmIpV6FlabelMasked{OxmField{packet.Packet{Buf: b}}}
 }
 
-func NewOxmIpV6Dst() OxmIpV6Dst {
-	s := packet.PaddedSize(20, 1, 4)
+func NewOxmIpV6FlabelMasked() OxmIpV6FlabelMasked {
+	s := packet.PaddedSize(12, 1, 4)
 	b := make([]byte, s)
-	p := OxmIpV6Dst{OxmField{packet.Packet{Buf: b}}}
+	p := OxmIpV6FlabelMasked{OxmField{packet.Packet{Buf: b}}}
 	p.Init()
 	return p
 }
 
-type OxmIpV6Dst struct {
+type OxmIpV6FlabelMasked struct {
 	OxmField
 }
 
-func (this OxmIpV6Dst) minSize() int {
-	return 20
+func (this OxmIpV6FlabelMasked) minSize() int {
+	return 12
 }
 
-func (this OxmIpV6Dst) Clone() (OxmIpV6Dst, error) {
+func (this OxmIpV6FlabelMasked) Clone() (OxmIpV6FlabelMasked, error) {
 	var newBuf bytes.Buffer
 	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
 	if err != nil {
-		return NewOxmIpV6Dst(), err
+		return NewOxmIpV6FlabelMasked(), err
 	}
 
-	return NewOxmIpV6DstWithBuf(newBuf.Bytes()), nil
+	return NewOxmIpV6FlabelMaskedWithBuf(newBuf.Bytes()), nil
 }
 
-type OxmIpV6DstConn struct {
+type OxmIpV6FlabelMaskedConn struct {
 	net.Conn
 	w      *bufio.Writer
 	buf    []byte
 	offset int
 }
 
-func NewOxmIpV6DstConn(c net.Conn) OxmIpV6DstConn {
-	return OxmIpV6DstConn{
+func NewOxmIpV6FlabelMaskedConn(c net.Conn) OxmIpV6FlabelMaskedConn {
+	return OxmIpV6FlabelMaskedConn{
 		Conn: c,
 		w:    bufio.NewWriter(c),
 		buf:  make([]byte, packet.DefaultBufSize),
 	}
 }
 
-func (c *OxmIpV6DstConn) WriteOxmIpV6Dst(pkt OxmIpV6Dst) error {
+func (c *OxmIpV6FlabelMaskedConn) WriteOxmIpV6FlabelMasked(pkt OxmIpV6FlabelMasked) error {
 	s := pkt.Size()
 	b := pkt.Buffer()[:s]
 	n := 0
@@ -6989,30 +11380,30 @@ func (c *OxmIpV6DstConn) WriteOxmIpV6Dst(pkt OxmIpV6Dst) error {
 	return nil
 }
 
-func (c *OxmIpV6DstConn) WriteOxmIpV6Dsts(pkts []OxmIpV6Dst) error {
+func (c *OxmIpV6FlabelMaskedConn) WriteOxmIpV6FlabelMaskeds(pkts []OxmIpV6FlabelMasked) error {
 	for _, p := range pkts {
-		if err := c.WriteOxmIpV6Dst(p); err != nil {
+		if err := c.WriteOxmIpV6FlabelMasked(p); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (c *OxmIpV6DstConn) Flush() error {
+func (c *OxmIpV6FlabelMaskedConn) Flush() error {
 	return c.w.Flush()
 }
 
-func (c *OxmIpV6DstConn) ReadOxmIpV6Dst() (OxmIpV6Dst, error) {
-	pkts := make([]OxmIpV6Dst, 1)
-	_, err := c.ReadOxmIpV6Dsts(pkts)
+func (c *OxmIpV6FlabelMaskedConn) ReadOxmIpV6FlabelMasked() (OxmIpV6FlabelMasked, error) {
+	pkts := make([]OxmIpV6FlabelMasked, 1)
+	_, err := c.ReadOxmIpV6FlabelMaskeds(pkts)
 	if err != nil {
-		return NewOxmIpV6Dst(), err
+		return NewOxmIpV6FlabelMasked(), err
 	}
 
 	return pkts[0], nil
 }
 
-func (c *OxmIpV6DstConn) ReadOxmIpV6Dsts(pkts []OxmIpV6Dst) (int, error) {
+func (c *OxmIpV6FlabelMaskedConn) ReadOxmIpV6FlabelMaskeds(pkts []OxmIpV6FlabelMasked) (int, error) {
 	if len(c.buf) == c.offset {
 		newSize := packet.DefaultBufSize
 		if newSize < len(c.buf) {
@@ -7034,7 +11425,7 @@ func (c *OxmIpV6DstConn) ReadOxmIpV6Dsts(pkts []OxmIpV6Dst) (int, error) {
 	s := 0
 	n := 0
 	for i := range pkts {
-		p := NewOxmIpV6DstWithBuf(c.buf[s:])
+		p := NewOxmIpV6FlabelMaskedWithBuf(c.buf[s:])
 
 		pSize := p.Size()
 		if pSize == 0 || r < s+pSize {
@@ -7055,16 +11446,16 @@ func (c *OxmIpV6DstConn) ReadOxmIpV6Dsts(pkts []OxmIpV6Dst) (int, error) {
 	return n, nil
 }
 
-func (this *OxmIpV6Dst) Init() {
+func (this *OxmIpV6FlabelMasked) Init() {
 	this.OxmField.Init()
 	this.SetOxmLength(uint8(this.minSize()))
 	// Invariants.
 	this.SetOxmClass(uint16(32768)) // oxm_class
-	this.SetOxmField(uint8(54))     // oxm_field
-	this.SetOxmLength(uint8(16))    // oxm_length
+	this.SetOxmField(uint8(57))     // oxm_field
+	this.SetOxmLength(uint8(8))     // oxm_length
 }
 
-func (this OxmIpV6Dst) Size() int {
+func (this OxmIpV6FlabelMasked) Size() int {
 	if len(this.Buf) < this.minSize() {
 		return 0
 	}
@@ -7073,98 +11464,98 @@ func (this OxmIpV6Dst) Size() int {
 	return packet.PaddedSize(size, 1, 4)
 }
 
-func ToOxmIpV6Dst(p OxmField) (OxmIpV6Dst, error) {
-	if !IsOxmIpV6Dst(p) {
-		return NewOxmIpV6DstWithBuf(nil), errors.New("Cannot convert to of12.OxmIpV6Dst")
+func ToOxmIpV6FlabelMasked(p OxmField) (OxmIpV6FlabelMasked, error) {
+	if !IsOxmIpV6FlabelMasked(p) {
+		return NewOxmIpV6FlabelMaskedWithBuf(nil), errors.New("Cannot convert to of12.OxmIpV6FlabelMasked")
 	}
 
-	return NewOxmIpV6DstWithBuf(p.Buf), nil
+	return NewOxmIpV6FlabelMaskedWithBuf(p.Buf), nil
 }
 
-func IsOxmIpV6Dst(p OxmField) bool {
-	return p.OxmClass() == 32768 && p.OxmField() == 54 && p.OxmLength() == 16 && true
+func IsOxmIpV6FlabelMasked(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 57 && p.OxmLength() == 8 && true
 }
 
-func (this OxmIpV6Dst) Addr() [16]uint8 {
-	offset := this.AddrOffset()
-	packet_size := this.Size()
-	size := packet_size - offset
-	count := 16
-	i := 0
-	var res [16]uint8
-	for size > 0 && count > 0 && packet_size > offset {
-		elem := uint8(this.Buf[offset])
-		if size < 1 {
-			break
-		}
-		size -= 1
-		offset += 1
-		count--
-		res[i] = elem
-		i++
-	}
+func (this OxmIpV6FlabelMasked) Flabel() uint32 {
+	offset := this.FlabelOffset()
+	res := binary.BigEndian.Uint32(this.Buf[offset:])
 	return res
 }
 
-func (this *OxmIpV6Dst) SetAddr(a [16]uint8) {
-	offset := this.AddrOffset()
-	for _, e := range a {
-		this.Buf[offset] = byte(e)
-		offset++
-	}
+func (this *OxmIpV6FlabelMasked) SetFlabel(f uint32) {
+	offset := this.FlabelOffset()
+	binary.BigEndian.PutUint32(this.Buf[offset:], f)
+	offset += 4
 }
 
-func (this OxmIpV6Dst) AddrOffset() int {
+func (this OxmIpV6FlabelMasked) FlabelOffset() int {
 	offset := 4
 	return offset
 }
 
-func NewOxmIpV6DstMaskedWithBuf(b []byte) OxmIpV6DstMasked {
-	return OxmIpV6DstMasked{OxmField{packet.Packet{Buf: b}}}
+func (this OxmIpV6FlabelMasked) Mask() uint32 {
+	offset := this.MaskOffset()
+	res := binary.BigEndian.Uint32(this.Buf[offset:])
+	return res
 }
 
-func NewOxmIpV6DstMasked() OxmIpV6DstMasked {
-	s := packet.PaddedSize(36, 1, 4)
+func (this *OxmIpV6FlabelMasked) SetMask(m uint32) {
+	offset := this.MaskOffset()
+	binary.BigEndian.PutUint32(this.Buf[offset:], m)
+	offset += 4
+}
+
+func (this OxmIpV6FlabelMasked) MaskOffset() int {
+	offset := 8
+	return offset
+}
+
+func NewOxmIcmpV6TypeWithBuf(b []byte) OxmIcmpV6Type {
+	return OxmIcmpV6Type{OxmField{packet.Packet{Buf: b}}}
+}
+
+func NewOxmIcmpV6Type() OxmIcmpV6Type {
+	s := packet.PaddedSize(5, 1, 4)
 	b := make([]byte, s)
-	p := OxmIpV6DstMasked{OxmField{packet.Packet{Buf: b}}}
+	p := OxmIcmpV6Type{OxmField{packet.Packet{Buf: b}}}
 	p.Init()
 	return p
 }
 
-type OxmIpV6DstMasked struct {
+type OxmIcmpV6Type struct {
 	OxmField
 }
 
-func (this OxmIpV6DstMasked) minSize() int {
-	return 36
+func (this OxmIcmpV6Type) minSize() int {
+	return 5
 }
 
-func (this OxmIpV6DstMasked) Clone() (OxmIpV6DstMasked, error) {
+func (this OxmIcmpV6Type) Clone() (OxmIcmpV6Type, error) {
 	var newBuf bytes.Buffer
 	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
 	if err != nil {
-		return NewOxmIpV6DstMasked(), err
+		return NewOxmIcmpV6Type(), err
 	}
 
-	return NewOxmIpV6DstMaskedWithBuf(newBuf.Bytes()), nil
+	return NewOxmIcmpV6TypeWithBuf(newBuf.Bytes()), nil
 }
 
-type OxmIpV6DstMaskedConn struct {
+type OxmIcmpV6TypeConn struct {
 	net.Conn
 	w      *bufio.Writer
 	buf    []byte
 	offset int
 }
 
-func NewOxmIpV6DstMaskedConn(c net.Conn) OxmIpV6DstMaskedConn {
-	return OxmIpV6DstMaskedConn{
+func NewOxmIcmpV6TypeConn(c net.Conn) OxmIcmpV6TypeConn {
+	return OxmIcmpV6TypeConn{
 		Conn: c,
 		w:    bufio.NewWriter(c),
 		buf:  make([]byte, packet.DefaultBufSize),
 	}
 }
 
-func (c *OxmIpV6DstMaskedConn) WriteOxmIpV6DstMasked(pkt OxmIpV6DstMasked) error {
+func (c *OxmIcmpV6TypeConn) WriteOxmIcmpV6Type(pkt OxmIcmpV6Type) error {
 	s := pkt.Size()
 	b := pkt.Buffer()[:s]
 	n := 0
@@ -7179,30 +11570,30 @@ func (c *OxmIpV6DstMaskedConn) WriteOxmIpV6DstMasked(pkt OxmIpV6DstMasked) error
 	return nil
 }
 
-func (c *OxmIpV6DstMaskedConn) WriteOxmIpV6DstMaskeds(pkts []OxmIpV6DstMasked) error {
+func (c *OxmIcmpV6TypeConn) WriteOxmIcmpV6Types(pkts []OxmIcmpV6Type) error {
 	for _, p := range pkts {
-		if err := c.WriteOxmIpV6DstMasked(p); err != nil {
+		if err := c.WriteOxmIcmpV6Type(p); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (c *OxmIpV6DstMaskedConn) Flush() error {
+func (c *OxmIcmpV6TypeConn) Flush() error {
 	return c.w.Flush()
 }
 
-func (c *OxmIpV6DstMaskedConn) ReadOxmIpV6DstMasked() (OxmIpV6DstMasked, error) {
-	pkts := make([]OxmIpV6DstMasked, 1)
-	_, err := c.ReadOxmIpV6DstMaskeds(pkts)
+func (c *OxmIcmpV6TypeConn) ReadOxmIcmpV6Type() (OxmIcmpV6Type, error) {
+	pkts := make([]OxmIcmpV6Type, 1)
+	_, err := c.ReadOxmIcmpV6Types(pkts)
 	if err != nil {
-		return NewOxmIpV6DstMasked(), err
+		return NewOxmIcmpV6Type(), err
 	}
 
 	return pkts[0], nil
 }
 
-func (c *OxmIpV6DstMaskedConn) ReadOxmIpV6DstMaskeds(pkts []OxmIpV6DstMasked) (int, error) {
+func (c *OxmIcmpV6TypeConn) ReadOxmIcmpV6Types(pkts []OxmIcmpV6Type) (int, error) {
 	if len(c.buf) == c.offset {
 		newSize := packet.DefaultBufSize
 		if newSize < len(c.buf) {
@@ -7224,7 +11615,7 @@ func (c *OxmIpV6DstMaskedConn) ReadOxmIpV6DstMaskeds(pkts []OxmIpV6DstMasked) (i
 	s := 0
 	n := 0
 	for i := range pkts {
-		p := NewOxmIpV6DstMaskedWithBuf(c.buf[s:])
+		p := NewOxmIcmpV6TypeWithBuf(c.buf[s:])
 
 		pSize := p.Size()
 		if pSize == 0 || r < s+pSize {
@@ -7245,150 +11636,99 @@ func (c *OxmIpV6DstMaskedConn) ReadOxmIpV6DstMaskeds(pkts []OxmIpV6DstMasked) (i
 	return n, nil
 }
 
-func (this *OxmIpV6DstMasked) Init() {
+func (this *OxmIcmpV6Type) Init() {
 	this.OxmField.Init()
-	this.SetOxmLength(uint8(this.minSize()))
-	// Invariants.
-	this.SetOxmClass(uint16(32768)) // oxm_class
-	this.SetOxmField(uint8(55))     // oxm_field
-	this.SetOxmLength(uint8(32))    // oxm_length
-}
-
-func (this OxmIpV6DstMasked) Size() int {
-	if len(this.Buf) < this.minSize() {
-		return 0
-	}
-
-	size := int(this.OxmLength())
-	return packet.PaddedSize(size, 1, 4)
-}
-
-func ToOxmIpV6DstMasked(p OxmField) (OxmIpV6DstMasked, error) {
-	if !IsOxmIpV6DstMasked(p) {
-		return NewOxmIpV6DstMaskedWithBuf(nil), errors.New("Cannot convert to of12.OxmIpV6DstMasked")
-	}
-
-	return NewOxmIpV6DstMaskedWithBuf(p.Buf), nil
-}
-
-func IsOxmIpV6DstMasked(p OxmField) bool {
-	return p.OxmClass() == 32768 && p.OxmField() == 55 && p.OxmLength() == 32 && true
+	this.SetOxmLength(uint8(this.minSize()))
+	// Invariants.
+	this.SetOxmClass(uint16(32768)) // oxm_class
+	this.SetOxmField(uint8(58))     // oxm_field
+	this.SetOxmLength(uint8(1))     // oxm_length
 }
 
-func (this OxmIpV6DstMasked) Addr() [16]uint8 {
-	offset := this.AddrOffset()
-	packet_size := this.Size()
-	size := packet_size - offset
-	count := 16
-	i := 0
-	var res [16]uint8
-	for size > 0 && count > 0 && packet_size > offset {
-		elem := uint8(this.Buf[offset])
-		if size < 1 {
-			break
-		}
-		size -= 1
-		offset += 1
-		count--
-		res[i] = elem
-		i++
+func (this OxmIcmpV6Type) Size() int {
+	if len(this.Buf) < this.minSize() {
+		return 0
 	}
-	return res
+
+	size := int(this.OxmLength())
+	return packet.PaddedSize(size, 1, 4)
 }
 
-func (this *OxmIpV6DstMasked) SetAddr(a [16]uint8) {
-	offset := this.AddrOffset()
-	for _, e := range a {
-		this.Buf[offset] = byte(e)
-		offset++
+func ToOxmIcmpV6Type(p OxmField) (OxmIcmpV6Type, error) {
+	if !IsOxmIcmpV6Type(p) {
+		return NewOxmIcmpV6TypeWithBuf(nil), errors.New("Cannot convert to of12.OxmIcmpV6Type")
 	}
+
+	return NewOxmIcmpV6TypeWithBuf(p.Buf), nil
 }
 
-func (this OxmIpV6DstMasked) AddrOffset() int {
-	offset := 4
-	return offset
+func IsOxmIcmpV6Type(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 58 && p.OxmLength() == 1 && true
 }
 
-func (this OxmIpV6DstMasked) Mask() [16]uint8 {
-	offset := this.MaskOffset()
-	packet_size := this.Size()
-	size := packet_size - offset
-	count := 16
-	i := 0
-	var res [16]uint8
-	for size > 0 && count > 0 && packet_size > offset {
-		elem := uint8(this.Buf[offset])
-		if size < 1 {
-			break
-		}
-		size -= 1
-		offset += 1
-		count--
-		res[i] = elem
-		i++
-	}
+func (this OxmIcmpV6Type) Type() uint8 {
+	offset := this.TypeOffset()
+	res := uint8(this.Buf[offset])
 	return res
 }
 
-func (this *OxmIpV6DstMasked) SetMask(m [16]uint8) {
-	offset := this.MaskOffset()
-	for _, e := range m {
-		this.Buf[offset] = byte(e)
-		offset++
-	}
+func (this *OxmIcmpV6Type) SetType(t uint8) {
+	offset := this.TypeOffset()
+	this.Buf[offset] = byte(t)
+	offset++
 }
 
-func (this OxmIpV6DstMasked) MaskOffset() int {
-	offset := 20
+func (this OxmIcmpV6Type) TypeOffset() int {
+	offset := 4
 	return offset
 }
 
-func NewOxmTcpSrcWithBuf(b []byte) OxmTcpSrc {
-	return OxmTcpSrc{OxmField{packet.Packet{Buf: b}}}
+func NewOxmIcmpV6CodeWithBuf(b []byte) OxmIcmpV6Code {
+	return OxmIcmpV6Code{OxmField{packet.Packet{Buf: b}}}
 }
 
-func NewOxmTcpSrc() OxmTcpSrc {
-	s := packet.PaddedSize(6, 1, 4)
+func NewOxmIcmpV6Code() OxmIcmpV6Code {
+	s := packet.PaddedSize(5, 1, 4)
 	b := make([]byte, s)
-	p := OxmTcpSrc{OxmField{packet.Packet{Buf: b}}}
+	p := OxmIcmpV6Code{OxmField{packet.Packet{Buf: b}}}
 	p.Init()
 	return p
 }
 
-type OxmTcpSrc struct {
+type OxmIcmpV6Code struct {
 	OxmField
 }
 
-func (this OxmTcpSrc) minSize() int {
-	return 6
+func (this OxmIcmpV6Code) minSize() int {
+	return 5
 }
 
-func (this OxmTcpSrc) Clone() (OxmTcpSrc, error) {
+func (this OxmIcmpV6Code) Clone() (OxmIcmpV6Code, error) {
 	var newBuf bytes.Buffer
 	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
 	if err != nil {
-		return NewOxmTcpSrc(), err
+		return NewOxmIcmpV6Code(), err
 	}
 
-	return NewOxmTcpSrcWithBuf(newBuf.Bytes()), nil
+	return NewOxmIcmpV6CodeWithBuf(newBuf.Bytes()), nil
 }
 
-type OxmTcpSrcConn struct {
+type OxmIcmpV6CodeConn struct {
 	net.Conn
 	w      *bufio.Writer
 	buf    []byte
 	offset int
 }
 
-func NewOxmTcpSrcConn(c net.Conn) OxmTcpSrcConn {
-	return OxmTcpSrcConn{
+func NewOxmIcmpV6CodeConn(c net.Conn) OxmIcmpV6CodeConn {
+	return OxmIcmpV6CodeConn{
 		Conn: c,
 		w:    bufio.NewWriter(c),
 		buf:  make([]byte, packet.DefaultBufSize),
 	}
 }
 
-func (c *OxmTcpSrcConn) WriteOxmTcpSrc(pkt OxmTcpSrc) error {
+func (c *OxmIcmpV6CodeConn) WriteOxmIcmpV6Code(pkt OxmIcmpV6Code) error {
 	s := pkt.Size()
 	b := pkt.Buffer()[:s]
 	n := 0
@@ -7403,30 +11743,30 @@ func (c *OxmTcpSrcConn) WriteOxmTcpSrc(pkt OxmTcpSrc) error {
 	return nil
 }
 
-func (c *OxmTcpSrcConn) WriteOxmTcpSrcs(pkts []OxmTcpSrc) error {
+func (c *OxmIcmpV6CodeConn) WriteOxmIcmpV6Codes(pkts []OxmIcmpV6Code) error {
 	for _, p := range pkts {
-		if err := c.WriteOxmTcpSrc(p); err != nil {
+		if err := c.WriteOxmIcmpV6Code(p); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (c *OxmTcpSrcConn) Flush() error {
+func (c *OxmIcmpV6CodeConn) Flush() error {
 	return c.w.Flush()
 }
 
-func (c *OxmTcpSrcConn) ReadOxmTcpSrc() (OxmTcpSrc, error) {
-	pkts := make([]OxmTcpSrc, 1)
-	_, err := c.ReadOxmTcpSrcs(pkts)
+func (c *OxmIcmpV6CodeConn) ReadOxmIcmpV6Code() (OxmIcmpV6Code, error) {
+	pkts := make([]OxmIcmpV6Code, 1)
+	_, err := c.ReadOxmIcmpV6Codes(pkts)
 	if err != nil {
-		return NewOxmTcpSrc(), err
+		return NewOxmIcmpV6Code(), err
 	}
 
 	return pkts[0], nil
 }
 
-func (c *OxmTcpSrcConn) ReadOxmTcpSrcs(pkts []OxmTcpSrc) (int, error) {
+func (c *OxmIcmpV6CodeConn) ReadOxmIcmpV6Codes(pkts []OxmIcmpV6Code) (int, error) {
 	if len(c.buf) == c.offset {
 		newSize := packet.DefaultBufSize
 		if newSize < len(c.buf) {
@@ -7448,7 +11788,7 @@ func (c *OxmTcpSrcConn) ReadOxmTcpSrcs(pkts []OxmTcpSrc) (int, error) {
 	s := 0
 	n := 0
 	for i := range pkts {
-		p := NewOxmTcpSrcWithBuf(c.buf[s:])
+		p := NewOxmIcmpV6CodeWithBuf(c.buf[s:])
 
 		pSize := p.Size()
 		if pSize == 0 || r < s+pSize {
@@ -7469,16 +11809,16 @@ func (c *OxmTcpSrcConn) ReadOxmTcpSrcs(pkts []OxmTcpSrc) (int, error) {
 	return n, nil
 }
 
-func (this *OxmTcpSrc) Init() {
+func (this *OxmIcmpV6Code) Init() {
 	this.OxmField.Init()
 	this.SetOxmLength(uint8(this.minSize()))
 	// Invariants.
 	this.SetOxmClass(uint16(32768)) // oxm_class
-	this.SetOxmField(uint8(26))     // oxm_field
-	this.SetOxmLength(uint8(2))     // oxm_length
+	this.SetOxmField(uint8(60))     // oxm_field
+	this.SetOxmLength(uint8(1))     // oxm_length
 }
 
-func (this OxmTcpSrc) Size() int {
+func (this OxmIcmpV6Code) Size() int {
 	if len(this.Buf) < this.minSize() {
 		return 0
 	}
@@ -7487,81 +11827,81 @@ func (this OxmTcpSrc) Size() int {
 	return packet.PaddedSize(size, 1, 4)
 }
 
-func ToOxmTcpSrc(p OxmField) (OxmTcpSrc, error) {
-	if !IsOxmTcpSrc(p) {
-		return NewOxmTcpSrcWithBuf(nil), errors.New("Cannot convert to of12.OxmTcpSrc")
+func ToOxmIcmpV6Code(p OxmField) (OxmIcmpV6Code, error) {
+	if !IsOxmIcmpV6Code(p) {
+		return NewOxmIcmpV6CodeWithBuf(nil), errors.New("Cannot convert to of12.OxmIcmpV6Code")
 	}
 
-	return NewOxmTcpSrcWithBuf(p.Buf), nil
+	return NewOxmIcmpV6CodeWithBuf(p.Buf), nil
 }
 
-func IsOxmTcpSrc(p OxmField) bool {
-	return p.OxmClass() == 32768 && p.OxmField() == 26 && p.OxmLength() == 2 && true
+func IsOxmIcmpV6Code(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 60 && p.OxmLength() == 1 && true
 }
 
-func (this OxmTcpSrc) Port() uint16 {
-	offset := this.PortOffset()
-	res := binary.BigEndian.Uint16(this.Buf[offset:])
+func (this OxmIcmpV6Code) Code() uint8 {
+	offset := this.CodeOffset()
+	res := uint8(this.Buf[offset])
 	return res
 }
 
-func (this *OxmTcpSrc) SetPort(p uint16) {
-	offset := this.PortOffset()
-	binary.BigEndian.PutUint16(this.Buf[offset:], p)
-	offset += 2
+func (this *OxmIcmpV6Code) SetCode(c uint8) {
+	offset := this.CodeOffset()
+	this.Buf[offset] = byte(c)
+	offset++
 }
 
-func (this OxmTcpSrc) PortOffset() int {
+func (this OxmIcmpV6Code) CodeOffset() int {
 	offset := 4
 	return offset
 }
 
-func NewOxmTcpDstWithBuf(b []byte) OxmTcpDst {
-	return OxmTcpDst{OxmField{packet.Packet{Buf: b}}}
+func NewOxmMplsLabelWithBuf(b []byte) OxmMplsLabel {
+	return OxmMplsLabel{OxmField{packet.Packet{Buf: b}}}
 }
 
-func NewOxmTcpDst() OxmTcpDst {
-	s := packet.PaddedSize(6, 1, 4)
+func NewOxmMplsLabel() OxmMplsLabel {
+	s := packet.PaddedSize(8, 1, 4)
 	b := make([]byte, s)
-	p := OxmTcpDst{OxmField{packet.Packet{Buf: b}}}
+	p := OxmMplsLabel{OxmField{packet.Packet{Buf: b}}}
 	p.Init()
 	return p
 }
 
-type OxmTcpDst struct {
+type OxmMplsLabel struct {
 	OxmField
 }
 
-func (this OxmTcpDst) minSize() int {
-	return 6
+func (this OxmMplsLabel) minSize() int {
+	return 8
 }
 
-func (this OxmTcpDst) Clone() (OxmTcpDst, error) {
+func (this OxmMplsLabel) Clone() (OxmMplsLabel, error) {
 	var newBuf bytes.Buffer
 	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
 	if err != nil {
-		return NewOxmTcpDst(), err
+		return NewOxmMplsLabel(), err
 	}
 
-	return NewOxmTcpDstWithBuf(newBuf.Bytes()), nil
+	return NewOxmMplsLabelWithBuf(newBuf.Bytes()), nil
 }
 
-type OxmTcpDstConn struct {
+type OxmMplsLabelConn struct {
 	net.Conn
 	w      *bufio.Writer
 	buf    []byte
 	offset int
 }
 
-func NewOxmTcpDstConn(c net.Conn) OxmTcpDstConn {
-	return OxmTcpDstConn{
+func NewOxmMplsLabelConn(c net.Conn) OxmMplsLabelConn {
+	return OxmMplsLabelConn{
 		Conn: c,
 		w:    bufio.NewWriter(c),
 		buf:  make([]byte, packet.DefaultBufSize),
 	}
 }
 
-func (c *OxmTcpDstConn) WriteOxmTcpDst(pkt OxmTcpDst) error {
+func (c *OxmMplsLabelConn) WriteOxmMplsLabel(pkt OxmMplsLabel) error {
 	s := pkt.Size()
 	b := pkt.Buffer()[:s]
 	n := 0
@@ -7576,30 +11916,30 @@ func (c *OxmTcpDstConn) WriteOxmTcpDst(pkt OxmTcpDst) error {
 	return nil
 }
 
-func (c *OxmTcpDstConn) WriteOxmTcpDsts(pkts []OxmTcpDst) error {
+func (c *OxmMplsLabelConn) WriteOxmMplsLabels(pkts []OxmMplsLabel) error {
 	for _, p := range pkts {
-		if err := c.WriteOxmTcpDst(p); err != nil {
+		if err := c.WriteOxmMplsLabel(p); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (c *OxmTcpDstConn) Flush() error {
+func (c *OxmMplsLabelConn) Flush() error {
 	return c.w.Flush()
 }
 
-func (c *OxmTcpDstConn) ReadOxmTcpDst() (OxmTcpDst, error) {
-	pkts := make([]OxmTcpDst, 1)
-	_, err := c.ReadOxmTcpDsts(pkts)
+func (c *OxmMplsLabelConn) ReadOxmMplsLabel() (OxmMplsLabel, error) {
+	pkts := make([]OxmMplsLabel, 1)
+	_, err := c.ReadOxmMplsLabels(pkts)
 	if err != nil {
-		return NewOxmTcpDst(), err
+		return NewOxmMplsLabel(), err
 	}
 
 	return pkts[0], nil
 }
 
-func (c *OxmTcpDstConn) ReadOxmTcpDsts(pkts []OxmTcpDst) (int, error) {
+func (c *OxmMplsLabelConn) ReadOxmMplsLabels(pkts []OxmMplsLabel) (int, error) {
 	if len(c.buf) == c.offset {
 		newSize := packet.DefaultBufSize
 		if newSize < len(c.buf) {
@@ -7621,7 +11961,7 @@ func (c *OxmTcpDstConn) ReadOxmTcpDsts(pkts []OxmTcpDst) (int, error) {
 	s := 0
 	n := 0
 	for i := range pkts {
-		p := NewOxmTcpDstWithBuf(c.buf[s:])
+		p := NewOxmMplsLabelWithBuf(c.buf[s:])
 
 		pSize := p.Size()
 		if pSize == 0 || r < s+pSize {
@@ -7642,16 +11982,16 @@ func (c *OxmTcpDstConn) ReadOxmTcpDsts(pkts []OxmTcpDst) (int, error) {
 	return n, nil
 }
 
-func (this *OxmTcpDst) Init() {
+func (this *OxmMplsLabel) Init() {
 	this.OxmField.Init()
 	this.SetOxmLength(uint8(this.minSize()))
 	// Invariants.
 	this.SetOxmClass(uint16(32768)) // oxm_class
-	this.SetOxmField(uint8(28))     // oxm_field
-	this.SetOxmLength(uint8(2))     // oxm_length
+	this.SetOxmField(uint8(68))     // oxm_field
+	this.SetOxmLength(uint8(4))     // oxm_length
 }
 
-func (this OxmTcpDst) Size() int {
+func (this OxmMplsLabel) Size() int {
 	if len(this.Buf) < this.minSize() {
 		return 0
 	}
@@ -7660,31 +12000,31 @@ func (this OxmTcpDst) Size() int {
 	return packet.PaddedSize(size, 1, 4)
 }
 
-func ToOxmTcpDst(p OxmField) (OxmTcpDst, error) {
-	if !IsOxmTcpDst(p) {
-		return NewOxmTcpDstWithBuf(nil), errors.New("Cannot convert to of12.OxmTcpDst")
+func ToOxmMplsLabel(p OxmField) (OxmMplsLabel, error) {
+	if !IsOxmMplsLabel(p) {
+		return NewOxmMplsLabelWithBuf(nil), errors.New("Cannot convert to of12.OxmMplsLabel")
 	}
 
-	return NewOxmTcpDstWithBuf(p.Buf), nil
+	return NewOxmMplsLabelWithBuf(p.Buf), nil
 }
 
-func IsOxmTcpDst(p OxmField) bool {
-	return p.OxmClass() == 32768 && p.OxmField() == 28 && p.OxmLength() == 2 && true
+func IsOxmMplsLabel(p OxmField) bool {
+	return p.OxmClass() == 32768 && p.OxmField() == 68 && p.OxmLength() == 4 && true
 }
 
-func (this OxmTcpDst) Port() uint16 {
-	offset := this.PortOffset()
-	res := binary.BigEndian.Uint16(this.Buf[offset:])
+func (this OxmMplsLabel) Label() uint32 {
+	offset := this.LabelOffset()
+	res := binary.BigEndian.Uint32(this.Buf[offset:])
 	return res
 }
 
-func (this *OxmTcpDst) SetPort(p uint16) {
-	offset := this.PortOffset()
-	binary.BigEndian.PutUint16(this.Buf[offset:], p)
-	offset += 2
+func (this *OxmMplsLabel) SetLabel(l uint32) {
+	offset := this.LabelOffset()
+	binary.BigEndian.PutUint32(this.Buf[offset:], l)
+	offset += 4
 }
 
-func (this OxmTcpDst) PortOffset() int {
+func (this OxmMplsLabel) LabelOffset() int {
 	offset := 4
 	return offset
 }
@@ -9626,38 +13966,6 @@ func IsFlowRemoved(p Header12) bool {
 	return p.Type() == 11 && true
 }
 
-func (this FlowRemoved) Match() Match {
-	offset := this.MatchOffset()
-	res := NewMatchWithBuf(this.Buf[offset:])
-	return res
-}
-
-func (this *FlowRemoved) SetMatch(m Match) {
-	offset := this.MatchOffset()
-	if this.MatchSize() != 0 {
-		panic("Repeated field match is already set.")
-	}
-	size := m.Size()
-	pSize := this.Size()
-	this.OpenGap(offset, size, pSize)
-	this.SetLength(uint16(pSize + size))
-	copy(this.Buf[offset:], m.Buf[:m.Size()])
-	offset += m.Size()
-}
-
-func (this FlowRemoved) MatchOffset() int {
-	offset := 8
-	return offset
-}
-
-func (this FlowRemoved) MatchSize() int {
-	offset := this.MatchOffset()
-	if offset >= this.Size() {
-		return 0
-	}
-	return this.Match().Size()
-}
-
 func (this FlowRemoved) Cookie() uint64 {
 	offset := this.CookieOffset()
 	res := binary.BigEndian.Uint64(this.Buf[offset:])
@@ -9672,7 +13980,6 @@ func (this *FlowRemoved) SetCookie(c uint64) {
 
 func (this FlowRemoved) CookieOffset() int {
 	offset := 8
-	offset += this.MatchSize()
 	return offset
 }
 
@@ -9690,7 +13997,6 @@ func (this *FlowRemoved) SetPriority(p uint16) {
 
 func (this FlowRemoved) PriorityOffset() int {
 	offset := 16
-	offset += this.MatchSize()
 	return offset
 }
 
@@ -9708,42 +14014,23 @@ func (this *FlowRemoved) SetReason(r uint8) {
 
 func (this FlowRemoved) ReasonOffset() int {
 	offset := 18
-	offset += this.MatchSize()
 	return offset
 }
 
-func (this FlowRemoved) Pad() [1]uint8 {
-	offset := this.PadOffset()
-	packet_size := this.Size()
-	size := packet_size - offset
-	count := 1
-	i := 0
-	var res [1]uint8
-	for size > 0 && count > 0 && packet_size > offset {
-		elem := uint8(this.Buf[offset])
-		if size < 1 {
-			break
-		}
-		size -= 1
-		offset += 1
-		count--
-		res[i] = elem
-		i++
-	}
+func (this FlowRemoved) TableId() uint8 {
+	offset := this.TableIdOffset()
+	res := uint8(this.Buf[offset])
 	return res
 }
 
-func (this *FlowRemoved) SetPad(p [1]uint8) {
-	offset := this.PadOffset()
-	for _, e := range p {
-		this.Buf[offset] = byte(e)
-		offset++
-	}
+func (this *FlowRemoved) SetTableId(t uint8) {
+	offset := this.TableIdOffset()
+	this.Buf[offset] = byte(t)
+	offset++
 }
 
-func (this FlowRemoved) PadOffset() int {
+func (this FlowRemoved) TableIdOffset() int {
 	offset := 19
-	offset += this.MatchSize()
 	return offset
 }
 
@@ -9761,7 +14048,6 @@ func (this *FlowRemoved) SetDurationSec(d uint32) {
 
 func (this FlowRemoved) DurationSecOffset() int {
 	offset := 20
-	offset += this.MatchSize()
 	return offset
 }
 
@@ -9779,7 +14065,6 @@ func (this *FlowRemoved) SetDurationNsec(d uint32) {
 
 func (this FlowRemoved) DurationNsecOffset() int {
 	offset := 24
-	offset += this.MatchSize()
 	return offset
 }
 
@@ -9797,42 +14082,23 @@ func (this *FlowRemoved) SetIdleTimeout(i uint16) {
 
 func (this FlowRemoved) IdleTimeoutOffset() int {
 	offset := 28
-	offset += this.MatchSize()
 	return offset
 }
 
-func (this FlowRemoved) Pad2() [2]uint8 {
-	offset := this.Pad2Offset()
-	packet_size := this.Size()
-	size := packet_size - offset
-	count := 2
-	i := 0
-	var res [2]uint8
-	for size > 0 && count > 0 && packet_size > offset {
-		elem := uint8(this.Buf[offset])
-		if size < 1 {
-			break
-		}
-		size -= 1
-		offset += 1
-		count--
-		res[i] = elem
-		i++
-	}
-	return res
-}
-
-func (this *FlowRemoved) SetPad2(p [2]uint8) {
-	offset := this.Pad2Offset()
-	for _, e := range p {
-		this.Buf[offset] = byte(e)
-		offset++
-	}
+func (this FlowRemoved) HardTimeout() uint16 {
+	offset := this.HardTimeoutOffset()
+	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	return res
+}
+
+func (this *FlowRemoved) SetHardTimeout(h uint16) {
+	offset := this.HardTimeoutOffset()
+	binary.BigEndian.PutUint16(this.Buf[offset:], h)
+	offset += 2
 }
 
-func (this FlowRemoved) Pad2Offset() int {
+func (this FlowRemoved) HardTimeoutOffset() int {
 	offset := 30
-	offset += this.MatchSize()
 	return offset
 }
 
@@ -9850,7 +14116,6 @@ func (this *FlowRemoved) SetPacketCount(p uint64) {
 
 func (this FlowRemoved) PacketCountOffset() int {
 	offset := 32
-	offset += this.MatchSize()
 	return offset
 }
 
@@ -9868,10 +14133,41 @@ func (this *FlowRemoved) SetByteCount(b uint64) {
 
 func (this FlowRemoved) ByteCountOffset() int {
 	offset := 40
-	offset += this.MatchSize()
 	return offset
 }
 
+func (this FlowRemoved) Match() Match {
+	offset := this.MatchOffset()
+	res := NewMatchWithBuf(this.Buf[offset:])
+	return res
+}
+
+func (this *FlowRemoved) SetMatch(m Match) {
+	offset := this.MatchOffset()
+	if this.MatchSize() != 0 {
+		panic("Repeated field match is already set.")
+	}
+	size := m.Size()
+	pSize := this.Size()
+	this.OpenGap(offset, size, pSize)
+	this.SetLength(uint16(pSize + size))
+	copy(this.Buf[offset:], m.Buf[:m.Size()])
+	offset += m.Size()
+}
+
+func (this FlowRemoved) MatchOffset() int {
+	offset := 48
+	return offset
+}
+
+func (this FlowRemoved) MatchSize() int {
+	offset := this.MatchOffset()
+	if offset >= this.Size() {
+		return 0
+	}
+	return this.Match().Size()
+}
+
 func NewErrorMsgWithBuf(b []byte) ErrorMsg {
 	return ErrorMsg{Header12{of.Header{packet.Packet{Buf: b}}}}
 }
@@ -12812,16 +17108,16 @@ func IsPortStatsRequest(p StatsRequest) bool {
 	return p.StatsType() == 4 && true
 }
 
-func (this PortStatsRequest) PortNo() uint16 {
+func (this PortStatsRequest) PortNo() uint32 {
 	offset := this.PortNoOffset()
-	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	res := binary.BigEndian.Uint32(this.Buf[offset:])
 	return res
 }
 
-func (this *PortStatsRequest) SetPortNo(p uint16) {
+func (this *PortStatsRequest) SetPortNo(p uint32) {
 	offset := this.PortNoOffset()
-	binary.BigEndian.PutUint16(this.Buf[offset:], p)
-	offset += 2
+	binary.BigEndian.PutUint32(this.Buf[offset:], p)
+	offset += 4
 }
 
 func (this PortStatsRequest) PortNoOffset() int {
@@ -12829,13 +17125,13 @@ func (this PortStatsRequest) PortNoOffset() int {
 	return offset
 }
 
-func (this PortStatsRequest) Pad() [6]uint8 {
+func (this PortStatsRequest) Pad() [4]uint8 {
 	offset := this.PadOffset()
 	packet_size := this.Size()
 	size := packet_size - offset
-	count := 6
+	count := 4
 	i := 0
-	var res [6]uint8
+	var res [4]uint8
 	for size > 0 && count > 0 && packet_size > offset {
 		elem := uint8(this.Buf[offset])
 		if size < 1 {
@@ -12850,7 +17146,7 @@ func (this PortStatsRequest) Pad() [6]uint8 {
 	return res
 }
 
-func (this *PortStatsRequest) SetPad(p [6]uint8) {
+func (this *PortStatsRequest) SetPad(p [4]uint8) {
 	offset := this.PadOffset()
 	for _, e := range p {
 		this.Buf[offset] = byte(e)
@@ -12859,28 +17155,28 @@ func (this *PortStatsRequest) SetPad(p [6]uint8) {
 }
 
 func (this PortStatsRequest) PadOffset() int {
-	offset := 18
+	offset := 20
 	return offset
 }
 
 func NewPortStatsWithBuf(b []byte) PortStats {
-	return PortStats{StatsReply{Header12{of.Header{packet.Packet{Buf: b}}}}}
+	return PortStats{packet.Packet{Buf: b}}
 }
 
 func NewPortStats() PortStats {
-	s := 120
+	s := 104
 	b := make([]byte, s)
-	p := PortStats{StatsReply{Header12{of.Header{packet.Packet{Buf: b}}}}}
+	p := PortStats{packet.Packet{Buf: b}}
 	p.Init()
 	return p
 }
 
 type PortStats struct {
-	StatsReply
+	packet.Packet
 }
 
 func (this PortStats) minSize() int {
-	return 120
+	return 104
 }
 
 func (this PortStats) Clone() (PortStats, error) {
@@ -12990,24 +17286,14 @@ func (c *PortStatsConn) ReadPortStatss(pkts []PortStats) (int, error) {
 }
 
 func (this *PortStats) Init() {
-	this.StatsReply.Init()
-	this.SetLength(uint16(this.minSize()))
 	// Invariants.
-	this.SetStatsType(uint16(4)) // stats_type
-	this.SetType(uint8(19))      // type
-	this.SetVersion(uint8(3))    // version
 }
 
 func (this PortStats) Size() int {
-	if len(this.Buf) < this.minSize() {
-		return 0
-	}
-
-	size := int(this.Length())
-	return size
+	return 104
 }
 
-func ToPortStats(p StatsReply) (PortStats, error) {
+func ToPortStats(p packet.Packet) (PortStats, error) {
 	if !IsPortStats(p) {
 		return NewPortStatsWithBuf(nil), errors.New("Cannot convert to of12.PortStats")
 	}
@@ -13015,34 +17301,34 @@ func ToPortStats(p StatsReply) (PortStats, error) {
 	return NewPortStatsWithBuf(p.Buf), nil
 }
 
-func IsPortStats(p StatsReply) bool {
-	return p.StatsType() == 4 && true
+func IsPortStats(p packet.Packet) bool {
+	return true
 }
 
-func (this PortStats) PortNo() uint16 {
+func (this PortStats) PortNo() uint32 {
 	offset := this.PortNoOffset()
-	res := binary.BigEndian.Uint16(this.Buf[offset:])
+	res := binary.BigEndian.Uint32(this.Buf[offset:])
 	return res
 }
 
-func (this *PortStats) SetPortNo(p uint16) {
+func (this *PortStats) SetPortNo(p uint32) {
 	offset := this.PortNoOffset()
-	binary.BigEndian.PutUint16(this.Buf[offset:], p)
-	offset += 2
+	binary.BigEndian.PutUint32(this.Buf[offset:], p)
+	offset += 4
 }
 
 func (this PortStats) PortNoOffset() int {
-	offset := 16
+	offset := 0
 	return offset
 }
 
-func (this PortStats) Pad() [6]uint8 {
+func (this PortStats) Pad() [4]uint8 {
 	offset := this.PadOffset()
 	packet_size := this.Size()
 	size := packet_size - offset
-	count := 6
+	count := 4
 	i := 0
-	var res [6]uint8
+	var res [4]uint8
 	for size > 0 && count > 0 && packet_size > offset {
 		elem := uint8(this.Buf[offset])
 		if size < 1 {
@@ -13057,7 +17343,7 @@ func (this PortStats) Pad() [6]uint8 {
 	return res
 }
 
-func (this *PortStats) SetPad(p [6]uint8) {
+func (this *PortStats) SetPad(p [4]uint8) {
 	offset := this.PadOffset()
 	for _, e := range p {
 		this.Buf[offset] = byte(e)
@@ -13066,7 +17352,7 @@ func (this *PortStats) SetPad(p [6]uint8) {
 }
 
 func (this PortStats) PadOffset() int {
-	offset := 18
+	offset := 4
 	return offset
 }
 
@@ -13083,7 +17369,7 @@ func (this *PortStats) SetRxPackets(r uint64) {
 }
 
 func (this PortStats) RxPacketsOffset() int {
-	offset := 24
+	offset := 8
 	return offset
 }
 
@@ -13100,7 +17386,7 @@ func (this *PortStats) SetTxPackets(t uint64) {
 }
 
 func (this PortStats) TxPacketsOffset() int {
-	offset := 32
+	offset := 16
 	return offset
 }
 
@@ -13117,7 +17403,7 @@ func (this *PortStats) SetRxBytes(r uint64) {
 }
 
 func (this PortStats) RxBytesOffset() int {
-	offset := 40
+	offset := 24
 	return offset
 }
 
@@ -13134,7 +17420,7 @@ func (this *PortStats) SetTxBytes(t uint64) {
 }
 
 func (this PortStats) TxBytesOffset() int {
-	offset := 48
+	offset := 32
 	return offset
 }
 
@@ -13151,7 +17437,7 @@ func (this *PortStats) SetRxDropped(r uint64) {
 }
 
 func (this PortStats) RxDroppedOffset() int {
-	offset := 56
+	offset := 40
 	return offset
 }
 
@@ -13168,7 +17454,7 @@ func (this *PortStats) SetTxDropped(t uint64) {
 }
 
 func (this PortStats) TxDroppedOffset() int {
-	offset := 64
+	offset := 48
 	return offset
 }
 
@@ -13185,7 +17471,7 @@ func (this *PortStats) SetRxErrors(r uint64) {
 }
 
 func (this PortStats) RxErrorsOffset() int {
-	offset := 72
+	offset := 56
 	return offset
 }
 
@@ -13202,7 +17488,7 @@ func (this *PortStats) SetTxErrors(t uint64) {
 }
 
 func (this PortStats) TxErrorsOffset() int {
-	offset := 80
+	offset := 64
 	return offset
 }
 
@@ -13219,7 +17505,7 @@ func (this *PortStats) SetRxFrameErr(r uint64) {
 }
 
 func (this PortStats) RxFrameErrOffset() int {
-	offset := 88
+	offset := 72
 	return offset
 }
 
@@ -13236,7 +17522,7 @@ func (this *PortStats) SetRxOverErr(r uint64) {
 }
 
 func (this PortStats) RxOverErrOffset() int {
-	offset := 96
+	offset := 80
 	return offset
 }
 
@@ -13253,7 +17539,7 @@ func (this *PortStats) SetRxCrcErr(r uint64) {
 }
 
 func (this PortStats) RxCrcErrOffset() int {
-	offset := 104
+	offset := 88
 	return offset
 }
 
@@ -13269,11 +17555,208 @@ func (this *PortStats) SetCollisions(c uint64) {
 	offset += 8
 }
 
-func (this PortStats) CollisionsOffset() int {
-	offset := 112
+func (this PortStats) CollisionsOffset() int {
+	offset := 96
+	return offset
+}
+
+func NewPortStatsReplyWithBuf(b []byte) PortStatsReply {
+	return PortStatsReply{StatsReply{Header12{of.Header{packet.Packet{Buf: b}}}}}
+}
+
+func NewPortStatsReply() PortStatsReply {
+	s := 16
+	b := make([]byte, s)
+	p := PortStatsReply{StatsReply{Header12{of.Header{packet.Packet{Buf: b}}}}}
+	p.Init()
+	return p
+}
+
+type PortStatsReply struct {
+	StatsReply
+}
+
+func (this PortStatsReply) minSize() int {
+	return 16
+}
+
+func (this PortStatsReply) Clone() (PortStatsReply, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewPortStatsReply(), err
+	}
+
+	return NewPortStatsReplyWithBuf(newBuf.Bytes()), nil
+}
+
+type PortStatsReplyConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewPortStatsReplyConn(c net.Conn) PortStatsReplyConn {
+	return PortStatsReplyConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *PortStatsReplyConn) WritePortStatsReply(pkt PortStatsReply) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *PortStatsReplyConn) WritePortStatsReplys(pkts []PortStatsReply) error {
+	for _, p := range pkts {
+		if err := c.WritePortStatsReply(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *PortStatsReplyConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *PortStatsReplyConn) ReadPortStatsReply() (PortStatsReply, error) {
+	pkts := make([]PortStatsReply, 1)
+	_, err := c.ReadPortStatsReplys(pkts)
+	if err != nil {
+		return NewPortStatsReply(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *PortStatsReplyConn) ReadPortStatsReplys(pkts []PortStatsReply) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewPortStatsReplyWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *PortStatsReply) Init() {
+	this.StatsReply.Init()
+	this.SetLength(uint16(this.minSize()))
+	// Invariants.
+	this.SetStatsType(uint16(4)) // stats_type
+	this.SetType(uint8(19))      // type
+	this.SetVersion(uint8(3))    // version
+}
+
+func (this PortStatsReply) Size() int {
+	if len(this.Buf) < this.minSize() {
+		return 0
+	}
+
+	size := int(this.Length())
+	return size
+}
+
+func ToPortStatsReply(p StatsReply) (PortStatsReply, error) {
+	if !IsPortStatsReply(p) {
+		return NewPortStatsReplyWithBuf(nil), errors.New("Cannot convert to of12.PortStatsReply")
+	}
+
+	return NewPortStatsReplyWithBuf(p.Buf), nil
+}
+
+func IsPortStatsReply(p StatsReply) bool {
+	return p.StatsType() == 4 && true
+}
+
+func (this PortStatsReply) PortStats() []PortStats {
+	offset := this.PortStatsOffset()
+	packet_size := this.Size()
+	size := packet_size - offset
+	count := this.Size() - offset
+	var res []PortStats
+	for size > 0 && count > 0 && packet_size > offset {
+		elem := NewPortStatsWithBuf(this.Buf[offset:])
+		if elem.Size() > size {
+			break
+		}
+		size -= elem.Size()
+		offset += elem.Size()
+		count--
+		res = append(res, elem)
+	}
+	return res
+}
+
+func (this *PortStatsReply) AddPortStats(p PortStats) {
+	offset := this.PortStatsOffset()
+	offset += this.PortStatsSize()
+	size := p.Size()
+	pSize := this.Size()
+	this.OpenGap(offset, size, pSize)
+	this.SetLength(uint16(pSize + size))
+	copy(this.Buf[offset:], p.Buf[:size])
+	offset += size
+}
+
+func (this PortStatsReply) PortStatsOffset() int {
+	offset := 16
 	return offset
 }
 
+func (this PortStatsReply) PortStatsSize() int {
+	offset := this.PortStatsOffset()
+	size := this.Size()
+	return size - offset
+}
+
 func NewVendorHeaderWithBuf(b []byte) VendorHeader {
 	return VendorHeader{Header12{of.Header{packet.Packet{Buf: b}}}}
 }
@@ -14580,6 +19063,316 @@ func (this QueueGetConfigReply) QueuesSize() int {
 	return size - offset
 }
 
+func NewBarrierRequestWithBuf(b []byte) BarrierRequest {
+	return BarrierRequest{Header12{of.Header{packet.Packet{Buf: b}}}}
+}
+
+func NewBarrierRequest() BarrierRequest {
+	s := 8
+	b := make([]byte, s)
+	p := BarrierRequest{Header12{of.Header{packet.Packet{Buf: b}}}}
+	p.Init()
+	return p
+}
+
+type BarrierRequest struct {
+	Header12
+}
+
+func (this BarrierRequest) minSize() int {
+	return 8
+}
+
+func (this BarrierRequest) Clone() (BarrierRequest, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewBarrierRequest(), err
+	}
+
+	return NewBarrierRequestWithBuf(newBuf.Bytes()), nil
+}
+
+type BarrierRequestConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewBarrierRequestConn(c net.Conn) BarrierRequestConn {
+	return BarrierRequestConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *BarrierRequestConn) WriteBarrierRequest(pkt BarrierRequest) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *BarrierRequestConn) WriteBarrierRequests(pkts []BarrierRequest) error {
+	for _, p := range pkts {
+		if err := c.WriteBarrierRequest(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *BarrierRequestConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *BarrierRequestConn) ReadBarrierRequest() (BarrierRequest, error) {
+	pkts := make([]BarrierRequest, 1)
+	_, err := c.ReadBarrierRequests(pkts)
+	if err != nil {
+		return NewBarrierRequest(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *BarrierRequestConn) ReadBarrierRequests(pkts []BarrierRequest) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewBarrierRequestWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *BarrierRequest) Init() {
+	this.Header12.Init()
+	this.SetLength(uint16(this.minSize()))
+	// Invariants.
+	this.SetType(uint8(20))   // type
+	this.SetVersion(uint8(3)) // version
+}
+
+func (this BarrierRequest) Size() int {
+	if len(this.Buf) < this.minSize() {
+		return 0
+	}
+
+	size := int(this.Length())
+	return size
+}
+
+func ToBarrierRequest(p Header12) (BarrierRequest, error) {
+	if !IsBarrierRequest(p) {
+		return NewBarrierRequestWithBuf(nil), errors.New("Cannot convert to of12.BarrierRequest")
+	}
+
+	return NewBarrierRequestWithBuf(p.Buf), nil
+}
+
+func IsBarrierRequest(p Header12) bool {
+	return p.Type() == 20 && true
+}
+
+func NewBarrierReplyWithBuf(b []byte) BarrierReply {
+	return BarrierReply{Header12{of.Header{packet.Packet{Buf: b}}}}
+}
+
+func NewBarrierReply() BarrierReply {
+	s := 8
+	b := make([]byte, s)
+	p := BarrierReply{Header12{of.Header{packet.Packet{Buf: b}}}}
+	p.Init()
+	return p
+}
+
+type BarrierReply struct {
+	Header12
+}
+
+func (this BarrierReply) minSize() int {
+	return 8
+}
+
+func (this BarrierReply) Clone() (BarrierReply, error) {
+	var newBuf bytes.Buffer
+	_, err := io.CopyN(&newBuf, bytes.NewBuffer(this.Buf), int64(this.Size()))
+	if err != nil {
+		return NewBarrierReply(), err
+	}
+
+	return NewBarrierReplyWithBuf(newBuf.Bytes()), nil
+}
+
+type BarrierReplyConn struct {
+	net.Conn
+	w      *bufio.Writer
+	buf    []byte
+	offset int
+}
+
+func NewBarrierReplyConn(c net.Conn) BarrierReplyConn {
+	return BarrierReplyConn{
+		Conn: c,
+		w:    bufio.NewWriter(c),
+		buf:  make([]byte, packet.DefaultBufSize),
+	}
+}
+
+func (c *BarrierReplyConn) WriteBarrierReply(pkt BarrierReply) error {
+	s := pkt.Size()
+	b := pkt.Buffer()[:s]
+	n := 0
+	for s > 0 {
+		var err error
+		if n, err = c.w.Write(b); err != nil {
+			return fmt.Errorf("Error in write: %v", err)
+		}
+		s -= n
+	}
+
+	return nil
+}
+
+func (c *BarrierReplyConn) WriteBarrierReplys(pkts []BarrierReply) error {
+	for _, p := range pkts {
+		if err := c.WriteBarrierReply(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *BarrierReplyConn) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *BarrierReplyConn) ReadBarrierReply() (BarrierReply, error) {
+	pkts := make([]BarrierReply, 1)
+	_, err := c.ReadBarrierReplys(pkts)
+	if err != nil {
+		return NewBarrierReply(), err
+	}
+
+	return pkts[0], nil
+}
+
+func (c *BarrierReplyConn) ReadBarrierReplys(pkts []BarrierReply) (int, error) {
+	if len(c.buf) == c.offset {
+		newSize := packet.DefaultBufSize
+		if newSize < len(c.buf) {
+			newSize = 2 * len(c.buf)
+		}
+
+		buf := make([]byte, newSize)
+		copy(buf, c.buf[:c.offset])
+		c.buf = buf
+	}
+
+	r, err := c.Conn.Read(c.buf[c.offset:])
+	if err != nil {
+		return 0, err
+	}
+
+	r += c.offset
+
+	s := 0
+	n := 0
+	for i := range pkts {
+		p := NewBarrierReplyWithBuf(c.buf[s:])
+
+		pSize := p.Size()
+		if pSize == 0 || r < s+pSize {
+			break
+		}
+
+		pkts[i] = p
+		s += pSize
+		n++
+	}
+
+	c.offset = r - s
+	if c.offset < 0 {
+		panic("Invalid value for offset")
+	}
+
+	c.buf = c.buf[s:]
+	return n, nil
+}
+
+func (this *BarrierReply) Init() {
+	this.Header12.Init()
+	this.SetLength(uint16(this.minSize()))
+	// Invariants.
+	this.SetType(uint8(21))   // type
+	this.SetVersion(uint8(3)) // version
+}
+
+func (this BarrierReply) Size() int {
+	if len(this.Buf) < this.minSize() {
+		return 0
+	}
+
+	size := int(this.Length())
+	return size
+}
+
+func ToBarrierReply(p Header12) (BarrierReply, error) {
+	if !IsBarrierReply(p) {
+		return NewBarrierReplyWithBuf(nil), errors.New("Cannot convert to of12.BarrierReply")
+	}
+
+	return NewBarrierReplyWithBuf(p.Buf), nil
+}
+
+func IsBarrierReply(p Header12) bool {
+	return p.Type() == 21 && true
+}
+
 func NewQueueStatsRequestWithBuf(b []byte) QueueStatsRequest {
 	return QueueStatsRequest{StatsRequest{Header12{of.Header{packet.Packet{Buf: b}}}}}
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/kandoo/beehive-netctrl/nom"
 	"github.com/kandoo/beehive-netctrl/openflow/of10"
 	"github.com/kandoo/beehive-netctrl/openflow/of12"
+	"github.com/kandoo/beehive-netctrl/openflow/of13"
 	"github.com/kandoo/beehive/Godeps/_workspace/src/github.com/golang/glog"
 )
 
@@ -17,7 +18,7 @@ func (of *of10Driver) handlePacketIn(in of10.PacketIn, c *ofConn) error {
 		return nil
 	}
 
-	port, ok := of.ofPorts[inPort]
+	port, ok := of.ports.ofPort(uint32(inPort))
 	if !ok {
 		return fmt.Errorf("of10Driver: port not found %v", inPort)
 	}
@@ -92,7 +93,7 @@ func (of *of12Driver) handlePacketIn(in of12.PacketIn, c *ofConn) error {
 		return nil
 	}
 
-	port, ok := of.ofPorts[inPort]
+	port, ok := of.ports.ofPort(inPort)
 	if !ok {
 		return fmt.Errorf("of12Driver: port not found %v", inPort)
 	}
@@ -111,3 +112,53 @@ func (of *of12Driver) handlePacketIn(in of12.PacketIn, c *ofConn) error {
 
 	return nil
 }
+
+func (of *of13Driver) handlePacketIn(in of13.PacketIn, c *ofConn) error {
+	m := in.Match()
+	if m.Type() == uint16(of13.PMT_STANDARD) {
+		glog.Warningf("standard matches are not supported")
+		return nil
+	}
+
+	var inPort uint32
+	hasInPort := false
+
+	xm, _ := of13.ToOXMatch(in.Match())
+	for _, f := range xm.Fields() {
+		if of13.IsOxmInPort(f) {
+			xp, _ := of13.ToOxmInPort(f)
+			inPort = xp.InPort()
+			hasInPort = true
+		}
+	}
+
+	if !hasInPort {
+		glog.V(2).Infof("packet in does not have an input port")
+		return nil
+	}
+
+	// Ignore packet-ins on switch specific ports.
+	if inPort > uint32(of13.PP_MAX) {
+		glog.V(2).Infof("ignoring packet-in on %v", inPort)
+		return nil
+	}
+
+	port, ok := of.ports.ofPort(inPort)
+	if !ok {
+		return fmt.Errorf("of13Driver: port not found %v", inPort)
+	}
+
+	if glog.V(2) {
+		glog.Infof("packet received: %v", in)
+	}
+
+	nomIn := nom.PacketIn{
+		Node:     c.node.UID(),
+		InPort:   port.UID(),
+		BufferID: nom.PacketBufferID(in.BufferId()),
+	}
+	nomIn.Packet = nom.Packet(in.Data())
+	c.ctx.Emit(nomIn)
+
+	return nil
+}
@@ -0,0 +1,437 @@
+package openflow
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/kandoo/beehive-netctrl/nom"
+	"github.com/kandoo/beehive-netctrl/openflow/of10"
+	"github.com/kandoo/beehive-netctrl/openflow/of12"
+	"github.com/kandoo/beehive-netctrl/openflow/of13"
+	"github.com/kandoo/beehive/Godeps/_workspace/src/github.com/golang/glog"
+)
+
+func (d *of10Driver) nomPort(p of10.PhysicalPort, c *ofConn) nom.Port {
+	name := p.Name()
+	return nom.Port{
+		ID:      portNoToPortID(uint32(p.PortNo())),
+		Name:    string(name[:]),
+		MACAddr: p.HwAddr(),
+		Node:    c.NodeUID(),
+		State:   of10PortState(of10.PortState(p.State())),
+		Config:  of10PortConfig(of10.PortConfig(p.Config())),
+		Feature: of10PortFeature(of10.PortFeatures(p.Curr())),
+	}
+}
+
+func of10PortState(s of10.PortState) nom.PortState {
+	switch {
+	case s&of10.PPS_LINK_DOWN != 0:
+		return nom.PortStateDown
+	case s&of10.PPS_STP_MASK == of10.PPS_STP_BLOCK:
+		return nom.PortStateBlocked
+	default:
+		return nom.PortStateUp
+	}
+}
+
+func of10PortConfig(c of10.PortConfig) nom.PortConfig {
+	var nc nom.PortConfig
+	if c&of10.PPC_PORT_DOWN != 0 {
+		nc |= nom.PortConfigDown
+	}
+	if c&of10.PPC_NO_STP != 0 {
+		nc |= nom.PortConfigDisableStp
+	}
+	if c&of10.PPC_NO_RECV != 0 {
+		nc |= nom.PortConfigDropPackets
+	}
+	if c&of10.PPC_NO_RECV_STP != 0 {
+		nc |= nom.PortConfigDropStp
+	}
+	if c&of10.PPC_NO_FLOOD != 0 {
+		nc |= nom.PortConfigNoFlood
+	}
+	if c&of10.PPC_NO_FWD != 0 {
+		nc |= nom.PortConfigNoForward
+	}
+	if c&of10.PPC_NO_PACKET_IN != 0 {
+		nc |= nom.PortConfigNoPacketIn
+	}
+	return nc
+}
+
+// of10NOMPortConfig converts a NOM port configuration to its OpenFlow 1.0
+// equivalent.
+func of10NOMPortConfig(nc nom.PortConfig) of10.PortConfig {
+	var c of10.PortConfig
+	if nc&nom.PortConfigDown != 0 {
+		c |= of10.PPC_PORT_DOWN
+	}
+	if nc&nom.PortConfigDisableStp != 0 {
+		c |= of10.PPC_NO_STP
+	}
+	if nc&nom.PortConfigDropPackets != 0 {
+		c |= of10.PPC_NO_RECV
+	}
+	if nc&nom.PortConfigDropStp != 0 {
+		c |= of10.PPC_NO_RECV_STP
+	}
+	if nc&nom.PortConfigNoFlood != 0 {
+		c |= of10.PPC_NO_FLOOD
+	}
+	if nc&nom.PortConfigNoForward != 0 {
+		c |= of10.PPC_NO_FWD
+	}
+	if nc&nom.PortConfigNoPacketIn != 0 {
+		c |= of10.PPC_NO_PACKET_IN
+	}
+	return c
+}
+
+func of10PortFeature(f of10.PortFeatures) nom.PortFeature {
+	var nf nom.PortFeature
+	features := []struct {
+		of  of10.PortFeatures
+		nom nom.PortFeature
+	}{
+		{of10.PPF_10MB_HD, nom.PortFeature10MBHD},
+		{of10.PPF_10MB_FD, nom.PortFeature10MBFD},
+		{of10.PPF_100MB_HD, nom.PortFeature100MBHD},
+		{of10.PPF_100MB_FD, nom.PortFeature100MBFD},
+		{of10.PPF_1GB_HD, nom.PortFeature1GBHD},
+		{of10.PPF_1GB_FD, nom.PortFeature1GBFD},
+		{of10.PPF_10GB_FD, nom.PortFeature10GBFD},
+		{of10.PPF_COPPER, nom.PortFeatureCopper},
+		{of10.PPF_FIBER, nom.PortFeatureFiber},
+		{of10.PPF_AUTONEG, nom.PortFeatureAutoneg},
+		{of10.PPF_PAUSE, nom.PortPause},
+		{of10.PPF_PAUSE_ASYM, nom.PortPauseAsym},
+	}
+	for _, e := range features {
+		if f&e.of != 0 {
+			nf |= e.nom
+		}
+	}
+	return nf
+}
+
+func (d *of12Driver) nomPort(p of12.Port, c *ofConn) nom.Port {
+	name := p.Name()
+	return nom.Port{
+		ID:      portNoToPortID(p.PortNo()),
+		Name:    string(name[:]),
+		MACAddr: p.HwAddr(),
+		Node:    c.NodeUID(),
+		State:   of12PortState(of12.PortState(p.State())),
+		Config:  of12PortConfig(of12.PortConfig(p.Config())),
+		Feature: of12PortFeature(of12.PortFeatures(p.Curr())),
+	}
+}
+
+func of12PortState(s of12.PortState) nom.PortState {
+	switch {
+	case s&of12.PPS_LINK_DOWN != 0:
+		return nom.PortStateDown
+	case s&of12.PPS_BLOCKED != 0:
+		return nom.PortStateBlocked
+	default:
+		return nom.PortStateUp
+	}
+}
+
+func of12PortConfig(c of12.PortConfig) nom.PortConfig {
+	var nc nom.PortConfig
+	if c&of12.PPC_PORT_DOWN != 0 {
+		nc |= nom.PortConfigDown
+	}
+	if c&of12.PPC_NO_RECV != 0 {
+		nc |= nom.PortConfigDropPackets
+	}
+	if c&of12.PPC_NO_FWD != 0 {
+		nc |= nom.PortConfigNoForward
+	}
+	if c&of12.PPC_NO_PACKET_IN != 0 {
+		nc |= nom.PortConfigNoPacketIn
+	}
+	return nc
+}
+
+// of12NOMPortConfig converts a NOM port configuration to its OpenFlow 1.2
+// equivalent. OpenFlow 1.2 and 1.3 have no flood and STP configurations.
+// PortConfigNoFlood is emulated by the drivers when flooding packets, and is
+// ignored here.
+func of12NOMPortConfig(nc nom.PortConfig) (of12.PortConfig, error) {
+	var unsupported nom.PortConfig = nom.PortConfigDisableStp |
+		nom.PortConfigDropStp
+	if nc&unsupported != 0 {
+		return 0, fmt.Errorf("port config %#x is not supported",
+			nc&unsupported)
+	}
+
+	var c of12.PortConfig
+	if nc&nom.PortConfigDown != 0 {
+		c |= of12.PPC_PORT_DOWN
+	}
+	if nc&nom.PortConfigDropPackets != 0 {
+		c |= of12.PPC_NO_RECV
+	}
+	if nc&nom.PortConfigNoForward != 0 {
+		c |= of12.PPC_NO_FWD
+	}
+	if nc&nom.PortConfigNoPacketIn != 0 {
+		c |= of12.PPC_NO_PACKET_IN
+	}
+	return c, nil
+}
+
+func of12PortFeature(f of12.PortFeatures) nom.PortFeature {
+	var nf nom.PortFeature
+	features := []struct {
+		of  of12.PortFeatures
+		nom nom.PortFeature
+	}{
+		{of12.PPF_10MB_HD, nom.PortFeature10MBHD},
+		{of12.PPF_10MB_FD, nom.PortFeature10MBFD},
+		{of12.PPF_100MB_HD, nom.PortFeature100MBHD},
+		{of12.PPF_100MB_FD, nom.PortFeature100MBFD},
+		{of12.PPF_1GB_HD, nom.PortFeature1GBHD},
+		{of12.PPF_1GB_FD, nom.PortFeature1GBFD},
+		{of12.PPF_10GB_FD, nom.PortFeature10GBFD},
+		{of12.PPF_COPPER, nom.PortFeatureCopper},
+		{of12.PPF_FIBER, nom.PortFeatureFiber},
+		{of12.PPF_AUTONEG, nom.PortFeatureAutoneg},
+		{of12.PPF_PAUSE, nom.PortPause},
+		{of12.PPF_PAUSE_ASYM, nom.PortPauseAsym},
+	}
+	for _, e := range features {
+		if f&e.of != 0 {
+			nf |= e.nom
+		}
+	}
+	return nf
+}
+
+func (d *of13Driver) nomPort(p of13.Port, c *ofConn) nom.Port {
+	// Port states, configurations, and features are the same in OpenFlow 1.2
+	// and 1.3.
+	name := p.Name()
+	return nom.Port{
+		ID:      portNoToPortID(p.PortNo()),
+		Name:    string(name[:]),
+		MACAddr: p.HwAddr(),
+		Node:    c.NodeUID(),
+		State:   of12PortState(of12.PortState(p.State())),
+		Config:  of12PortConfig(of12.PortConfig(p.Config())),
+		Feature: of12PortFeature(of12.PortFeatures(p.Curr())),
+	}
+}
+
+func nomPortStatusReason(r uint8) nom.PortStatusReason {
+	// Port reasons are the same in all OpenFlow versions.
+	switch of10.PortReason(r) {
+	case of10.PPR_ADD:
+		return nom.PortStatusAdded
+	case of10.PPR_DELETE:
+		return nom.PortStatusDeleted
+	default:
+		return nom.PortStatusModified
+	}
+}
+
+func (d *of10Driver) handlePortStatus(ps of10.PortStatus, c *ofConn) error {
+	desc := ps.Desc()
+	if desc.PortNo() > uint16(of10.PP_MAX) {
+		return nil
+	}
+
+	port := d.nomPort(desc, c)
+	reason := nomPortStatusReason(ps.Reason())
+	port, changed := d.ports.update(uint32(desc.PortNo()), port, reason, 0)
+	if !changed {
+		// The change has been already reported by handlePortModified.
+		return nil
+	}
+
+	glog.V(2).Infof("%v status changed (reason=%v)", port, reason)
+	emitPortStatusChanged(port, reason, c)
+	return nil
+}
+
+func (d *of12Driver) handlePortStatus(ps of12.PortStatus, c *ofConn) error {
+	desc := ps.Desc()
+	if desc.PortNo() > uint32(of12.PP_MAX) {
+		return nil
+	}
+
+	port := d.nomPort(desc, c)
+	reason := nomPortStatusReason(ps.Reason())
+	// PortConfigNoFlood is emulated by the driver.
+	port, changed := d.ports.update(desc.PortNo(), port, reason,
+		nom.PortConfigNoFlood)
+	if !changed {
+		// The change has been already reported by handlePortModified.
+		return nil
+	}
+
+	glog.V(2).Infof("%v status changed (reason=%v)", port, reason)
+	emitPortStatusChanged(port, reason, c)
+	return nil
+}
+
+func (d *of13Driver) handlePortStatus(ps of13.PortStatus, c *ofConn) error {
+	desc := ps.Desc()
+	if desc.PortNo() > uint32(of13.PP_MAX) {
+		return nil
+	}
+
+	port := d.nomPort(desc, c)
+	reason := nomPortStatusReason(ps.Reason())
+	// PortConfigNoFlood is emulated by the driver.
+	port, changed := d.ports.update(desc.PortNo(), port, reason,
+		nom.PortConfigNoFlood)
+	if !changed {
+		// The change has been already reported by handlePortModified.
+		return nil
+	}
+
+	glog.V(2).Infof("%v status changed (reason=%v)", port, reason)
+	emitPortStatusChanged(port, reason, c)
+	return nil
+}
+
+// portMap maps the OpenFlow port numbers of a node to their NOM ports, and
+// vice versa. Ports are updated by the reader of the connection while NOM
+// messages are converted by its writer, so both maps are guarded by the same
+// lock. Port numbers are stored as uint32, which also covers OpenFlow 1.0.
+type portMap struct {
+	sync.RWMutex
+	ofPorts  map[uint32]nom.Port
+	nomPorts map[nom.UID]uint32
+}
+
+// ofPort returns the NOM port of the OpenFlow port number.
+func (m *portMap) ofPort(no uint32) (nom.Port, bool) {
+	m.RLock()
+	defer m.RUnlock()
+	p, ok := m.ofPorts[no]
+	return p, ok
+}
+
+// portNo returns the OpenFlow port number of the NOM port.
+func (m *portMap) portNo(id nom.UID) (uint32, bool) {
+	m.RLock()
+	defer m.RUnlock()
+	no, ok := m.nomPorts[id]
+	return no, ok
+}
+
+// nomPort returns the OpenFlow port number and the NOM port with the given
+// ID.
+func (m *portMap) nomPort(id nom.UID) (uint32, nom.Port, bool) {
+	m.RLock()
+	defer m.RUnlock()
+	no, ok := m.nomPorts[id]
+	if !ok {
+		return 0, nom.Port{}, false
+	}
+	p, ok := m.ofPorts[no]
+	return no, p, ok
+}
+
+// ports returns a copy of the ports keyed by their OpenFlow port numbers.
+func (m *portMap) ports() map[uint32]nom.Port {
+	m.RLock()
+	defer m.RUnlock()
+	ports := make(map[uint32]nom.Port, len(m.ofPorts))
+	for no, p := range m.ofPorts {
+		ports[no] = p
+	}
+	return ports
+}
+
+// reset removes all the ports.
+func (m *portMap) reset() {
+	m.Lock()
+	defer m.Unlock()
+	m.ofPorts = make(map[uint32]nom.Port)
+	m.nomPorts = make(map[nom.UID]uint32)
+}
+
+// update updates the port based on the port status. The bits of keep in the
+// configuration of the current port are preserved. update returns the updated
+// port and false if the port is modified but has not changed.
+func (m *portMap) update(no uint32, port nom.Port, reason nom.PortStatusReason,
+	keep nom.PortConfig) (nom.Port, bool) {
+
+	m.Lock()
+	defer m.Unlock()
+	if m.ofPorts == nil {
+		m.ofPorts = make(map[uint32]nom.Port)
+		m.nomPorts = make(map[nom.UID]uint32)
+	}
+	if reason == nom.PortStatusDeleted {
+		delete(m.ofPorts, no)
+		delete(m.nomPorts, port.UID())
+		return port, true
+	}
+	if p, ok := m.ofPorts[no]; ok {
+		port.Config |= p.Config & keep
+		if reason == nom.PortStatusModified && p == port {
+			return port, false
+		}
+	}
+	m.ofPorts[no] = port
+	m.nomPorts[port.UID()] = no
+	return port, true
+}
+
+// modify applies mod to its port and returns the modified port, if the port
+// exists.
+func (m *portMap) modify(mod nom.ModifyPort) (nom.Port, bool) {
+	m.Lock()
+	defer m.Unlock()
+	no, ok := m.nomPorts[mod.Port]
+	if !ok {
+		return nom.Port{}, false
+	}
+	port := modifiedPort(m.ofPorts[no], mod)
+	m.ofPorts[no] = port
+	return port, true
+}
+
+// modifiedPort returns port with the configuration changed by mod.
+func modifiedPort(port nom.Port, mod nom.ModifyPort) nom.Port {
+	port.Config = port.Config&^mod.Mask | mod.Config&mod.Mask
+	return port
+}
+
+func (d *of10Driver) handlePortModified(mod nom.ModifyPort, c *ofConn) {
+	if port, ok := d.ports.modify(mod); ok {
+		emitPortStatusChanged(port, nom.PortStatusModified, c)
+	}
+}
+
+func (d *of12Driver) handlePortModified(mod nom.ModifyPort, c *ofConn) {
+	if port, ok := d.ports.modify(mod); ok {
+		emitPortStatusChanged(port, nom.PortStatusModified, c)
+	}
+}
+
+func (d *of13Driver) handlePortModified(mod nom.ModifyPort, c *ofConn) {
+	if port, ok := d.ports.modify(mod); ok {
+		emitPortStatusChanged(port, nom.PortStatusModified, c)
+	}
+}
+
+func emitPortStatusChanged(port nom.Port, reason nom.PortStatusReason,
+	c *ofConn) {
+
+	c.ctx.Emit(nom.PortStatusChanged{
+		Port:   port,
+		Reason: reason,
+		Driver: nom.Driver{
+			BeeID: c.ctx.ID(),
+		},
+	})
+}
@@ -0,0 +1,165 @@
+package openflow
+
+import (
+	"testing"
+
+	bh "github.com/kandoo/beehive"
+	"github.com/kandoo/beehive-netctrl/nom"
+	"github.com/kandoo/beehive-netctrl/openflow/of10"
+	"github.com/kandoo/beehive-netctrl/openflow/of12"
+	"github.com/kandoo/beehive-netctrl/openflow/of13"
+)
+
+func TestOF10PortState(t *testing.T) {
+	states := map[of10.PortState]nom.PortState{
+		0:                                       nom.PortStateUp,
+		of10.PPS_STP_FORWARD:                    nom.PortStateUp,
+		of10.PPS_LINK_DOWN:                      nom.PortStateDown,
+		of10.PPS_STP_BLOCK:                      nom.PortStateBlocked,
+		of10.PPS_LINK_DOWN | of10.PPS_STP_BLOCK: nom.PortStateDown,
+	}
+	for s, want := range states {
+		if actual := of10PortState(s); actual != want {
+			t.Errorf("invalid port state for %v: actual=%v want=%v", s, actual,
+				want)
+		}
+	}
+}
+
+func TestOF10PortConfig(t *testing.T) {
+	c := of10.PPC_PORT_DOWN | of10.PPC_NO_FLOOD | of10.PPC_NO_PACKET_IN
+	want := nom.PortConfigDown | nom.PortConfigNoFlood | nom.PortConfigNoPacketIn
+	if actual := of10PortConfig(c); actual != want {
+		t.Errorf("invalid port config: actual=%v want=%v", actual, want)
+	}
+}
+
+func TestOF10PortMod(t *testing.T) {
+	port := nom.Port{ID: "2", Node: "n1", MACAddr: nom.MACAddr{1, 2, 3, 4, 5, 6}}
+	driver := of10Driver{}
+	addTestPorts(&driver.ports, map[uint32]nom.Port{2: port})
+	mod := nom.ModifyPort{
+		Port:   port.UID(),
+		Config: nom.PortConfigNoFlood,
+		Mask:   nom.PortConfigNoFlood | nom.PortConfigDown,
+	}
+	h, err := driver.convToOF(&bh.MockMsg{MsgData: mod}, nil)
+	if err != nil {
+		t.Fatalf("cannot convert port mod: %v", err)
+	}
+	ofmod := of10.NewPortModWithBuf(h.Buf)
+	if ofmod.PortNo() != 2 || ofmod.HwAddr() != [6]uint8(port.MACAddr) ||
+		ofmod.Config() != uint32(of10.PPC_NO_FLOOD) ||
+		ofmod.Mask() != uint32(of10.PPC_NO_FLOOD|of10.PPC_PORT_DOWN) {
+
+		t.Errorf("invalid port mod: port=%v addr=%v config=%v mask=%v",
+			ofmod.PortNo(), ofmod.HwAddr(), ofmod.Config(), ofmod.Mask())
+	}
+
+	mod.Port = "n1$$3"
+	if _, err := driver.convToOF(&bh.MockMsg{MsgData: mod}, nil); err == nil {
+		t.Errorf("port mod for an unknown port is converted")
+	}
+}
+
+func TestOF13PortMod(t *testing.T) {
+	port := nom.Port{ID: "2", Node: "n1", MACAddr: nom.MACAddr{1, 2, 3, 4, 5, 6}}
+	driver := of13Driver{}
+	addTestPorts(&driver.ports, map[uint32]nom.Port{2: port})
+	mod := nom.ModifyPort{
+		Port:   port.UID(),
+		Config: nom.PortConfigDown,
+		Mask:   nom.PortConfigDown,
+	}
+	h, err := driver.convToOF(&bh.MockMsg{MsgData: mod}, nil)
+	if err != nil {
+		t.Fatalf("cannot convert port mod: %v", err)
+	}
+	ofmod := of13.NewPortModWithBuf(h.Buf)
+	if ofmod.PortNo() != 2 || ofmod.HwAddr() != [6]uint8(port.MACAddr) ||
+		ofmod.Config() != uint32(of13.PPC_PORT_DOWN) ||
+		ofmod.Mask() != uint32(of13.PPC_PORT_DOWN) {
+
+		t.Errorf("invalid port mod: port=%v addr=%v config=%v mask=%v",
+			ofmod.PortNo(), ofmod.HwAddr(), ofmod.Config(), ofmod.Mask())
+	}
+
+	// PortConfigNoFlood is emulated by the driver and is not sent to the switch.
+	mod.Mask |= nom.PortConfigNoFlood
+	h, err = driver.convToOF(&bh.MockMsg{MsgData: mod}, nil)
+	if err != nil {
+		t.Fatalf("cannot convert port mod: %v", err)
+	}
+	ofmod = of13.NewPortModWithBuf(h.Buf)
+	if ofmod.Mask() != uint32(of13.PPC_PORT_DOWN) {
+		t.Errorf("invalid port mod mask: actual=%v want=%v", ofmod.Mask(),
+			of13.PPC_PORT_DOWN)
+	}
+
+	mod.Mask |= nom.PortConfigDisableStp
+	if _, err := driver.convToOF(&bh.MockMsg{MsgData: mod}, nil); err == nil {
+		t.Errorf("unsupported port config is converted")
+	}
+}
+
+func TestModifiedPort(t *testing.T) {
+	port := nom.Port{Config: nom.PortConfigDown | nom.PortConfigNoPacketIn}
+	mod := nom.ModifyPort{
+		Config: nom.PortConfigNoFlood,
+		Mask:   nom.PortConfigNoFlood | nom.PortConfigDown,
+	}
+	want := nom.PortConfig(nom.PortConfigNoFlood | nom.PortConfigNoPacketIn)
+	if actual := modifiedPort(port, mod).Config; actual != want {
+		t.Errorf("invalid port config: actual=%v want=%v", actual, want)
+	}
+}
+
+func TestOF12PortState(t *testing.T) {
+	states := map[of12.PortState]nom.PortState{
+		0:                  nom.PortStateUp,
+		of12.PPS_LIVE:      nom.PortStateUp,
+		of12.PPS_LINK_DOWN: nom.PortStateDown,
+		of12.PPS_BLOCKED:   nom.PortStateBlocked,
+	}
+	for s, want := range states {
+		if actual := of12PortState(s); actual != want {
+			t.Errorf("invalid port state for %v: actual=%v want=%v", s, actual,
+				want)
+		}
+	}
+}
+
+func TestOF12PortFeature(t *testing.T) {
+	f := of12.PPF_10MB_HD | of12.PPF_1GB_FD | of12.PPF_COPPER
+	want := nom.PortFeature10MBHD | nom.PortFeature1GBFD | nom.PortFeatureCopper
+	if actual := of12PortFeature(f); actual != want {
+		t.Errorf("invalid port features: actual=%v want=%v", actual, want)
+	}
+}
+
+func TestPortMapConcurrentUpdate(t *testing.T) {
+	var m portMap
+	port := nom.Port{ID: "1", Node: "n1"}
+	m.update(1, port, nom.PortStatusAdded, 0)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			m.update(1, port, nom.PortStatusDeleted, 0)
+			m.update(1, port, nom.PortStatusAdded, 0)
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		if no, p, ok := m.nomPort(port.UID()); ok && (no != 1 || p != port) {
+			t.Errorf("invalid port: actual=%v,%v want=1,%v", no, p, port)
+		}
+	}
+	<-done
+}
+
+// addTestPorts adds the ports to m keyed by their OpenFlow port numbers.
+func addTestPorts(m *portMap, ports map[uint32]nom.Port) {
+	for no, p := range ports {
+		m.update(no, p, nom.PortStatusAdded, 0)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/kandoo/beehive-netctrl/nom"
 	"github.com/kandoo/beehive-netctrl/openflow/of10"
 	"github.com/kandoo/beehive-netctrl/openflow/of12"
+	"github.com/kandoo/beehive-netctrl/openflow/of13"
 )
 
 func (d *of10Driver) handleStatsReply(reply of10.StatsReply,
@@ -15,6 +16,8 @@ func (d *of10Driver) handleStatsReply(reply of10.StatsReply,
 	switch {
 	case of10.IsFlowStatsReply(reply):
 		return d.handleFlowStatsReply(of10.NewFlowStatsReplyWithBuf(reply.Buf), c)
+	case of10.IsPortStatsReply(reply):
+		return d.handlePortStatsReply(of10.NewPortStatsReplyWithBuf(reply.Buf), c)
 	default:
 		return fmt.Errorf("of10Driver: unsupported stats type %v",
 			reply.StatsType())
@@ -24,24 +27,27 @@ func (d *of10Driver) handleStatsReply(reply of10.StatsReply,
 func (d *of10Driver) handleFlowStatsReply(reply of10.FlowStatsReply,
 	c *ofConn) error {
 
-	nomReply := nom.FlowStatsQueryResult{
-		Node: c.node.UID(),
-	}
+	var stats []nom.FlowStats
 	for _, stat := range reply.FlowStats() {
 		m, err := d.nomMatch(stat.Match())
 		if err != nil {
 			return err
 		}
 		stat := nom.FlowStats{
-			Match: m,
+			Match:    m,
+			Priority: stat.Priority(),
+			Cookie:   stat.Cookie(),
 			Duration: time.Duration(stat.DurationSec())*time.Second +
 				time.Duration(stat.DurationNsec()),
 			Packets: stat.PacketCount(),
 			Bytes:   stat.ByteCount(),
 		}
-		nomReply.Stats = append(nomReply.Stats, stat)
+		stats = append(stats, stat)
+	}
+	more := reply.Flags()&uint16(of10.PSF_REPLY_MORE) != 0
+	if res, ok := c.addFlowStats(reply.Xid(), stats, more); ok {
+		c.ctx.Emit(res)
 	}
-	c.ctx.Emit(nomReply)
 	return nil
 }
 
@@ -51,6 +57,8 @@ func (d *of12Driver) handleStatsReply(reply of12.StatsReply,
 	switch {
 	case of12.IsFlowStatsReply(reply):
 		return d.handleFlowStatsReply(of12.NewFlowStatsReplyWithBuf(reply.Buf), c)
+	case of12.IsPortStatsReply(reply):
+		return d.handlePortStatsReply(of12.NewPortStatsReplyWithBuf(reply.Buf), c)
 	default:
 		return fmt.Errorf("of12Driver: unsupported stats type %v",
 			reply.StatsType())
@@ -60,22 +68,147 @@ func (d *of12Driver) handleStatsReply(reply of12.StatsReply,
 func (d *of12Driver) handleFlowStatsReply(reply of12.FlowStatsReply,
 	c *ofConn) error {
 
-	nomReply := nom.FlowStatsQueryResult{
-		Node: c.node.UID(),
+	var stats []nom.FlowStats
+	for _, stat := range reply.FlowStats() {
+		m, err := d.nomMatch(stat.Match())
+		if err != nil {
+			return err
+		}
+		stat := nom.FlowStats{
+			Match:    m,
+			Priority: stat.Priority(),
+			Cookie:   stat.Cookie(),
+			Duration: time.Duration(stat.DurationSec())*time.Second +
+				time.Duration(stat.DurationNsec()),
+			Packets: stat.PacketCount(),
+			Bytes:   stat.ByteCount(),
+		}
+		stats = append(stats, stat)
+	}
+	more := reply.Flags()&uint16(of12.PSF_REPLY_MORE) != 0
+	if res, ok := c.addFlowStats(reply.Xid(), stats, more); ok {
+		c.ctx.Emit(res)
 	}
+	return nil
+}
+
+func (d *of13Driver) handleMultipartReply(reply of13.MultipartReply,
+	c *ofConn) error {
+
+	switch {
+	case of13.IsFlowStatsReply(reply):
+		return d.handleFlowStatsReply(of13.NewFlowStatsReplyWithBuf(reply.Buf), c)
+	case of13.IsPortStatsReply(reply):
+		return d.handlePortStatsReply(of13.NewPortStatsReplyWithBuf(reply.Buf), c)
+	default:
+		return fmt.Errorf("of13Driver: unsupported multipart type %v",
+			reply.MpType())
+	}
+}
+
+func (d *of13Driver) handleFlowStatsReply(reply of13.FlowStatsReply,
+	c *ofConn) error {
+
+	var stats []nom.FlowStats
 	for _, stat := range reply.FlowStats() {
 		m, err := d.nomMatch(stat.Match())
 		if err != nil {
 			return err
 		}
 		stat := nom.FlowStats{
-			Match: m,
+			Match:    m,
+			Priority: stat.Priority(),
+			Cookie:   stat.Cookie(),
 			Duration: time.Duration(stat.DurationSec())*time.Second +
 				time.Duration(stat.DurationNsec()),
 			Packets: stat.PacketCount(),
 			Bytes:   stat.ByteCount(),
 		}
-		nomReply.Stats = append(nomReply.Stats, stat)
+		stats = append(stats, stat)
+	}
+	more := reply.Flags()&uint16(of13.PMPF_REPLY_MORE) != 0
+	if res, ok := c.addFlowStats(reply.Xid(), stats, more); ok {
+		c.ctx.Emit(res)
+	}
+	return nil
+}
+
+func (d *of10Driver) handlePortStatsReply(reply of10.PortStatsReply,
+	c *ofConn) error {
+
+	nomReply := nom.PortStatsQueryResult{
+		Node: c.node.UID(),
+	}
+	for _, stat := range reply.PortStats() {
+		p, ok := d.ports.ofPort(uint32(stat.PortNo()))
+		if !ok {
+			continue
+		}
+		nomReply.Stats = append(nomReply.Stats, nom.PortStats{
+			Port:      p.UID(),
+			RxPackets: stat.RxPackets(),
+			TxPackets: stat.TxPackets(),
+			RxBytes:   stat.RxBytes(),
+			TxBytes:   stat.TxBytes(),
+			RxDropped: stat.RxDropped(),
+			TxDropped: stat.TxDropped(),
+			RxErrors:  stat.RxErrors(),
+			TxErrors:  stat.TxErrors(),
+		})
+	}
+	c.ctx.Emit(nomReply)
+	return nil
+}
+
+func (d *of12Driver) handlePortStatsReply(reply of12.PortStatsReply,
+	c *ofConn) error {
+
+	nomReply := nom.PortStatsQueryResult{
+		Node: c.node.UID(),
+	}
+	for _, stat := range reply.PortStats() {
+		p, ok := d.ports.ofPort(stat.PortNo())
+		if !ok {
+			continue
+		}
+		nomReply.Stats = append(nomReply.Stats, nom.PortStats{
+			Port:      p.UID(),
+			RxPackets: stat.RxPackets(),
+			TxPackets: stat.TxPackets(),
+			RxBytes:   stat.RxBytes(),
+			TxBytes:   stat.TxBytes(),
+			RxDropped: stat.RxDropped(),
+			TxDropped: stat.TxDropped(),
+			RxErrors:  stat.RxErrors(),
+			TxErrors:  stat.TxErrors(),
+		})
+	}
+	c.ctx.Emit(nomReply)
+	return nil
+}
+
+func (d *of13Driver) handlePortStatsReply(reply of13.PortStatsReply,
+	c *ofConn) error {
+
+	nomReply := nom.PortStatsQueryResult{
+		Node: c.node.UID(),
+	}
+	for _, stat := range reply.PortStats() {
+		p, ok := d.ports.ofPort(stat.PortNo())
+		if !ok {
+			continue
+		}
+		nomReply.Stats = append(nomReply.Stats, nom.PortStats{
+			Port:      p.UID(),
+			RxPackets: stat.RxPackets(),
+			TxPackets: stat.TxPackets(),
+			RxBytes:   stat.RxBytes(),
+			TxBytes:   stat.TxBytes(),
+			RxDropped: stat.RxDropped(),
+			TxDropped: stat.TxDropped(),
+			RxErrors:  stat.RxErrors(),
+			TxErrors:  stat.TxErrors(),
+		})
 	}
 	c.ctx.Emit(nomReply)
 	return nil
@@ -21,15 +21,16 @@ type pathAndFlows struct {
 type flowAndStatus struct {
 	Flow      nom.FlowEntry
 	Installed bool
+	Cookie    uint64 // Cookie assigned by the controller, once installed.
 }
 
 func addFlowEntriesForPath(sub bh.AppCellKey, path nom.Path,
 	flows []nom.FlowEntry, ctx bh.RcvContext) {
 
 	fs := make([]flowAndStatus, 0, len(flows))
-	path.ID = strconv.FormatUint(reservePathID(ctx), 16)
+	id := strconv.FormatUint(reservePathID(ctx), 16)
 	for i := range flows {
-		flows[i].ID = path.ID
+		flows[i].ID = id
 		fs = append(fs, flowAndStatus{Flow: flows[i]})
 	}
 
@@ -40,7 +41,7 @@ func addFlowEntriesForPath(sub bh.AppCellKey, path nom.Path,
 		Timestamp:  time.Now(),
 	}
 	d := ctx.Dict(dictPath)
-	if err := d.Put(path.ID, pf); err != nil {
+	if err := d.Put(id, pf); err != nil {
 		glog.Fatalf("error in storing path entry: %v", err)
 	}
 
@@ -54,37 +55,117 @@ func addFlowEntriesForPath(sub bh.AppCellKey, path nom.Path,
 	}
 }
 
+// pathsOfFlow returns the IDs of the paths that include flow. A flow entry can
+// be shared by several paths, since the controller installs equal flows only
+// once and keeps the ID of the first one.
+func pathsOfFlow(flow nom.FlowEntry, ctx bh.RcvContext) (ids []string) {
+	ctx.Dict(dictPath).ForEach(func(k string, v interface{}) bool {
+		for _, f := range v.(pathAndFlows).Flows {
+			if f.Flow.Equals(flow) {
+				ids = append(ids, k)
+				break
+			}
+		}
+		return true
+	})
+	return ids
+}
+
 func confirmFlowEntryForPath(flow nom.FlowEntry, ctx bh.RcvContext) error {
 	d := ctx.Dict(dictPath)
-
-	v, err := d.Get(flow.ID)
-	if err != nil {
-		return fmt.Errorf("path: flow not found: %v", err)
+	ids := pathsOfFlow(flow, ctx)
+	if len(ids) == 0 {
+		// The paths of the flow are deleted before the flow is installed.
+		ctx.Emit(nom.DelFlowEntry{Node: flow.Node, Cookie: flow.Cookie})
+		return nil
 	}
+	for _, id := range ids {
+		v, err := d.Get(id)
+		if err != nil {
+			return err
+		}
 
-	pf := v.(pathAndFlows)
-
-	for i := range pf.Flows {
-		if pf.Flows[i].Flow.Equals(flow) {
-			if pf.Flows[i].Installed {
-				return fmt.Errorf("%v is already installed", flow)
+		pf := v.(pathAndFlows)
+		for i := range pf.Flows {
+			if pf.Flows[i].Flow.Equals(flow) && !pf.Flows[i].Installed {
+				pf.Flows[i].Installed = true
+				pf.Flows[i].Cookie = flow.Cookie
+				pf.Installed++
+				break
 			}
-			pf.Flows[i].Installed = true
-			pf.Installed++
-			break
+		}
+
+		if pf.Installed == len(pf.Flows) {
+			ctx.SendToCell(nom.PathAdded{Path: pf.Path}, pf.Subscriber.App,
+				pf.Subscriber.Cell())
+		}
+		if err := d.Put(id, pf); err != nil {
+			return err
 		}
 	}
+	return nil
+}
 
-	if pf.Installed == len(pf.Flows) {
-		ctx.SendToCell(nom.PathAdded{Path: pf.Path}, pf.Subscriber.App,
-			pf.Subscriber.Cell())
+// failFlowEntryForPath removes the paths of the flow that the node has failed
+// to install.
+func failFlowEntryForPath(flow nom.FlowEntry, ctx bh.RcvContext) error {
+	for _, id := range pathsOfFlow(flow, ctx) {
+		if err := delPath(id, flow, nom.PathDelInfeasible, ctx); err != nil {
+			return err
+		}
 	}
-	return d.Put(flow.ID, pf)
+	return nil
 }
 
+// delFlowEntryFromPath removes the paths of the flow that is deleted from its
+// node, since the paths cannot forward packets anymore.
 func delFlowEntryFromPath(flow nom.FlowEntry, ctx bh.RcvContext) error {
-	// TODO(soheil): Add deleted flow entries.
-	panic("TODO not implemented yet")
+	for _, id := range pathsOfFlow(flow, ctx) {
+		if err := delPath(id, flow, nom.PathDelInfeasible, ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// delPath removes the path, deletes its flow entries from the nodes except
+// gone, which is already removed, and notifies the subscriber of the path
+// using PathDeleted. Flow entries that are shared with other paths are kept.
+// Flow entries are deleted using the cookies that the controller has assigned
+// to them, so that the flows of other applications with the same match are
+// not deleted. Flow entries that are not installed yet are deleted once they
+// are installed.
+func delPath(id string, gone nom.FlowEntry, reason nom.PathDelReason,
+	ctx bh.RcvContext) error {
+
+	d := ctx.Dict(dictPath)
+	v, err := d.Get(id)
+	if err != nil {
+		// The path is already removed because of another flow.
+		return nil
+	}
+	pf := v.(pathAndFlows)
+	if err := d.Del(id); err != nil {
+		return err
+	}
+
+	for _, f := range pf.Flows {
+		if !f.Installed || f.Flow.Equals(gone) ||
+			len(pathsOfFlow(f.Flow, ctx)) != 0 {
+
+			continue
+		}
+		ctx.Emit(nom.DelFlowEntry{
+			Node:   f.Flow.Node,
+			Cookie: f.Cookie,
+		})
+	}
+
+	ctx.SendToCell(nom.PathDeleted{
+		Path:   pf.Path,
+		Reason: reason,
+	}, pf.Subscriber.App, pf.Subscriber.Cell())
+	return nil
 }
 
 type flowHandler struct{}
@@ -93,12 +174,17 @@ func (h flowHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
 	switch data := msg.Data().(type) {
 	case nom.FlowEntryAdded:
 		return confirmFlowEntryForPath(nom.FlowEntry(data.Flow), ctx)
+	case nom.FlowEntryFailed:
+		glog.Warningf("path: cannot install %v: %v", data.Flow, data.Err)
+		return failFlowEntryForPath(data.Flow, ctx)
 	case nom.FlowEntryDeleted:
 		return delFlowEntryFromPath(nom.FlowEntry(data.Flow), ctx)
 	}
 	return fmt.Errorf("flowHandler: unsupported message %v", msg.Type())
 }
 
+// Map drops the broadcasted flow messages. The controller sends the messages of
+// the flows of the paths directly to the cell of the path manager.
 func (h flowHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
 	return nil
 }
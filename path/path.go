@@ -9,6 +9,28 @@ import (
 	"github.com/kandoo/beehive-netctrl/nom"
 )
 
+// RegisterPath registers the path manager on the hive. The path manager
+// installs the paths requested by AddPath as flow entries along the shortest
+// paths of the network, and notifies the subscriber using PathAdded once all
+// the flow entries are installed. If a flow entry of a path fails or is
+// deleted, the other flow entries of the path are deleted and the subscriber
+// is notified using PathDeleted.
+//
+// The actions of a pathlet other than forwarding, such as rewriting the
+// fields of packets, are applied only on the nodes that forward the packets
+// out of the pathlet. The nodes in between merely forward the packets towards
+// them.
+func RegisterPath(h bh.Hive, opts ...bh.AppOption) {
+	app := h.NewApp("Path", opts...)
+	app.Handle(nom.AddPath{}, addHandler{})
+	app.Handle(nom.DelPath{}, delHandler{})
+	app.Handle(nom.LinkAdded{}, graphHandler{})
+	app.Handle(nom.LinkDeleted{}, graphHandler{})
+	app.Handle(nom.FlowEntryAdded{}, flowHandler{})
+	app.Handle(nom.FlowEntryFailed{}, flowHandler{})
+	app.Handle(nom.FlowEntryDeleted{}, flowHandler{})
+}
+
 type addHandler struct{}
 
 func (h addHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
@@ -18,6 +40,16 @@ func (h addHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
 		return errors.New("path: path has no pathlets")
 	}
 
+	pathlets := make([]nom.Pathlet, len(add.Path.Pathlets))
+	for i, p := range add.Path.Pathlets {
+		if err := p.Match.Validate(); err != nil {
+			return fmt.Errorf("path: invalid match in pathlet %d: %v", i, err)
+		}
+		p.Match = p.Match.Normalize()
+		pathlets[i] = p
+	}
+	add.Path.Pathlets = pathlets
+
 	flows := make([]nom.FlowEntry, 0, len(add.Path.Pathlets))
 	// TODO(soheil): maybe detect loops in pathlets?
 	var outports []nom.UID
@@ -74,6 +106,32 @@ func (h addHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
 	return centralizedMap
 }
 
+type delHandler struct{}
+
+func (h delHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
+	del := msg.Data().(nom.DelPath)
+	var ids []string
+	ctx.Dict(dictPath).ForEach(func(k string, v interface{}) bool {
+		pf := v.(pathAndFlows)
+		if pf.Subscriber == del.Subscriber && pf.Path.ID == del.Path.ID {
+			ids = append(ids, k)
+		}
+		return true
+	})
+	for _, id := range ids {
+		if err := delPath(id, nom.FlowEntry{}, nom.PathDelExplicit,
+			ctx); err != nil {
+
+			return err
+		}
+	}
+	return nil
+}
+
+func (h delHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
+	return centralizedMap
+}
+
 func genFlowsForPathlet(p nom.Pathlet, inport nom.UID, priority uint16,
 	ctx bh.RcvContext) (flows []nom.FlowEntry, outports []nom.UID, err error) {
 
@@ -109,16 +167,6 @@ func genFlowsForPathlet(p nom.Pathlet, inport nom.UID, priority uint16,
 		}
 	}
 
-	nofwdActions := make([]nom.Action, 0, len(p.Actions))
-	for _, a := range p.Actions {
-		switch a.(type) {
-		case nom.ActionForward, nom.ActionFlood:
-			continue
-		default:
-			nofwdActions = append(nofwdActions, a)
-		}
-	}
-
 	var innodes []nom.UID
 	if inport != nom.Nil {
 		innodes = []nom.UID{nom.NodeFromPortUID(inport)}
@@ -160,14 +208,12 @@ func genFlowsForPathlet(p nom.Pathlet, inport nom.UID, priority uint16,
 					m.Fields = append(m.Fields, nom.InPort(lastInPort))
 				}
 
-				var a []nom.Action
-				a = append(a, nofwdActions...)
-				a = append(a, nom.ActionForward{Ports: []nom.UID{link.From}})
-
 				flow := nom.FlowEntry{
-					Node:     nom.NodeFromPortUID(link.From),
-					Match:    m,
-					Actions:  a,
+					Node:  nom.NodeFromPortUID(link.From),
+					Match: m,
+					Actions: []nom.Action{
+						nom.ActionForward{Ports: []nom.UID{link.From}},
+					},
 					Priority: priority,
 				}
 				flows = append(flows, flow)
@@ -159,3 +159,265 @@ func TestAddL2Path(t *testing.T) {
 		t.Errorf("no flow installed on port %v", p)
 	}
 }
+
+func TestAddRoutePath(t *testing.T) {
+	ctx := buildTopologyForTest()
+	dst := nom.IPv4Dst(nom.MaskedIPv4Addr{
+		Addr: nom.IPv4Addr{10, 0, 1, 2},
+		Mask: nom.MaskNoneIPV4,
+	})
+	rewrite := nom.ActionWriteFields{
+		Fields: []nom.Field{
+			nom.EthDst{Addr: nom.MACAddr{1, 2, 3, 4, 5, 6}, Mask: nom.MaskNoneMAC},
+		},
+	}
+	msg := &bh.MockMsg{
+		MsgData: nom.AddPath{
+			Subscriber: bh.AppCellKey{App: "Router", Dict: "D", Key: "0"},
+			Path: nom.Path{
+				ID: "10.0.1.2/32",
+				Pathlets: []nom.Pathlet{
+					{
+						Match: nom.Match{
+							Fields: []nom.Field{nom.EthType(nom.EthTypeIPv4), dst},
+						},
+						Actions: []nom.Action{
+							rewrite,
+							nom.ActionForward{Ports: []nom.UID{"n6$$3"}},
+						},
+					},
+				},
+				Priority: 132,
+			},
+		},
+	}
+	if err := (addHandler{}).Rcv(msg, ctx); err != nil {
+		t.Fatalf("cannot install flows for path: %v", err)
+	}
+
+	out := map[nom.UID]nom.UID{
+		"n1": "n1$$1",
+		"n2": "n2$$2",
+		"n3": "n3$$2",
+		"n4": "n4$$2",
+		"n5": "n5$$2",
+		"n6": "n6$$3",
+	}
+	for _, msg := range ctx.CtxMsgs {
+		add, ok := msg.Data().(nom.AddFlowEntry)
+		if !ok {
+			t.Fatalf("unexpected message: %v", msg.Data())
+		}
+		f := add.Flow
+		if f.Priority != 132 || !f.Match.Subsumes(nom.Match{
+			Fields: []nom.Field{nom.EthType(nom.EthTypeIPv4), dst},
+		}) {
+
+			t.Errorf("invalid flow: %v", f)
+		}
+		acts := f.Actions
+		if f.Node == "n6" {
+			if len(acts) != 2 || !acts[0].Equals(rewrite) {
+				t.Errorf("flow does not rewrite the destination: %v", f)
+				continue
+			}
+			acts = acts[1:]
+		}
+		if len(acts) != 1 {
+			t.Errorf("invalid actions of flow on %v: %v", f.Node, f.Actions)
+			continue
+		}
+		fwd, ok := acts[0].(nom.ActionForward)
+		if !ok || len(fwd.Ports) != 1 || fwd.Ports[0] != out[f.Node] {
+			t.Errorf("invalid output of flow on %v: actual=%v want=%v", f.Node,
+				acts[0], out[f.Node])
+		}
+		delete(out, f.Node)
+	}
+	for n := range out {
+		t.Errorf("no flow installed on %v", n)
+	}
+}
+
+func addPathForTest(t *testing.T) (*bh.MockRcvContext, []nom.FlowEntry) {
+	ctx := buildTopologyForTest()
+	msg := &bh.MockMsg{
+		MsgData: nom.AddPath{
+			Subscriber: bh.AppCellKey{App: "app", Dict: "d", Key: "k"},
+			Path: nom.Path{
+				ID: "p1",
+				Pathlets: []nom.Pathlet{
+					{
+						Match: nom.Match{
+							Fields: []nom.Field{
+								nom.InPort("n1$$0"),
+							},
+						},
+						Actions: []nom.Action{
+							nom.ActionForward{
+								Ports: []nom.UID{"n6$$3"},
+							},
+						},
+					},
+				},
+				Priority: 1,
+			},
+		},
+	}
+	if err := (addHandler{}).Rcv(msg, ctx); err != nil {
+		t.Fatalf("cannot install flows for path: %v", err)
+	}
+	var flows []nom.FlowEntry
+	for _, msg := range ctx.CtxMsgs {
+		flows = append(flows, msg.Data().(nom.AddFlowEntry).Flow)
+	}
+	ctx.CtxMsgs = nil
+	return ctx, flows
+}
+
+func TestPathAdded(t *testing.T) {
+	ctx, flows := addPathForTest(t)
+	h := flowHandler{}
+	for i, f := range flows {
+		msg := &bh.MockMsg{MsgData: nom.FlowEntryAdded{Flow: f}}
+		if err := h.Rcv(msg, ctx); err != nil {
+			t.Fatal(err)
+		}
+		if i != len(flows)-1 && len(ctx.CtxMsgs) != 0 {
+			t.Errorf("path added before all flows are installed: %v",
+				ctx.CtxMsgs[0].Data())
+		}
+	}
+	if len(ctx.CtxMsgs) != 1 {
+		t.Fatalf("invalid number of messages: actual=%v want=1",
+			len(ctx.CtxMsgs))
+	}
+	added, ok := ctx.CtxMsgs[0].Data().(nom.PathAdded)
+	if !ok || added.Path.ID != "p1" {
+		t.Errorf("invalid path added message: %v", ctx.CtxMsgs[0].Data())
+	}
+}
+
+func TestPathRollback(t *testing.T) {
+	ctx, flows := addPathForTest(t)
+	for i := range flows {
+		flows[i].Cookie = uint64(i + 1)
+	}
+	h := flowHandler{}
+	for _, i := range []int{0, 2} {
+		msg := &bh.MockMsg{MsgData: nom.FlowEntryAdded{Flow: flows[i]}}
+		if err := h.Rcv(msg, ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	msg := &bh.MockMsg{MsgData: nom.FlowEntryFailed{Flow: flows[1]}}
+	if err := h.Rcv(msg, ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	dels := make(map[uint64]nom.UID)
+	deleted := false
+	for _, msg := range ctx.CtxMsgs {
+		switch data := msg.Data().(type) {
+		case nom.DelFlowEntry:
+			dels[data.Cookie] = data.Node
+		case nom.PathDeleted:
+			deleted = true
+			if data.Reason != nom.PathDelInfeasible {
+				t.Errorf("invalid reason: actual=%v want=%v", data.Reason,
+					nom.PathDelInfeasible)
+			}
+		default:
+			t.Errorf("unexpected message: %v", data)
+		}
+	}
+	if !deleted {
+		t.Error("the subscriber is not notified")
+	}
+	if len(dels) != 2 || dels[1] != flows[0].Node || dels[3] != flows[2].Node {
+		t.Errorf("invalid deletions: actual=%v want=[1:%v 3:%v]", dels,
+			flows[0].Node, flows[2].Node)
+	}
+
+	ctx.CtxMsgs = nil
+	msg = &bh.MockMsg{MsgData: nom.FlowEntryDeleted{Flow: flows[2]}}
+	if err := h.Rcv(msg, ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(ctx.CtxMsgs) != 0 {
+		t.Errorf("messages for a removed path: %v", ctx.CtxMsgs)
+	}
+
+	msg = &bh.MockMsg{MsgData: nom.FlowEntryAdded{Flow: flows[3]}}
+	if err := h.Rcv(msg, ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(ctx.CtxMsgs) != 1 {
+		t.Fatalf("invalid number of messages: actual=%v want=1",
+			len(ctx.CtxMsgs))
+	}
+	del, ok := ctx.CtxMsgs[0].Data().(nom.DelFlowEntry)
+	if !ok || del.Node != flows[3].Node || del.Cookie != flows[3].Cookie {
+		t.Errorf("flow of a removed path is not deleted: %v",
+			ctx.CtxMsgs[0].Data())
+	}
+}
+
+func TestDelPath(t *testing.T) {
+	ctx, flows := addPathForTest(t)
+	for i := range flows {
+		flows[i].Cookie = uint64(i + 1)
+		msg := &bh.MockMsg{MsgData: nom.FlowEntryAdded{Flow: flows[i]}}
+		if err := (flowHandler{}).Rcv(msg, ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ctx.CtxMsgs = nil
+	h := delHandler{}
+	msg := &bh.MockMsg{
+		MsgData: nom.DelPath{
+			Subscriber: bh.AppCellKey{App: "other", Dict: "d", Key: "k"},
+			Path:       nom.Path{ID: "p1"},
+		},
+	}
+	if err := h.Rcv(msg, ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(ctx.CtxMsgs) != 0 {
+		t.Errorf("path of another subscriber is deleted: %v", ctx.CtxMsgs)
+	}
+
+	msg.MsgData = nom.DelPath{
+		Subscriber: bh.AppCellKey{App: "app", Dict: "d", Key: "k"},
+		Path:       nom.Path{ID: "p1"},
+	}
+	if err := h.Rcv(msg, ctx); err != nil {
+		t.Fatal(err)
+	}
+	dels := 0
+	deleted := false
+	for _, msg := range ctx.CtxMsgs {
+		switch data := msg.Data().(type) {
+		case nom.DelFlowEntry:
+			if data.Cookie == 0 {
+				t.Errorf("flow is not deleted by its cookie: %v", data)
+			}
+			dels++
+		case nom.PathDeleted:
+			deleted = true
+			if data.Reason != nom.PathDelExplicit {
+				t.Errorf("invalid reason: actual=%v want=%v", data.Reason,
+					nom.PathDelExplicit)
+			}
+		default:
+			t.Errorf("unexpected message: %v", data)
+		}
+	}
+	if !deleted {
+		t.Error("the subscriber is not notified")
+	}
+	if dels != len(flows) {
+		t.Errorf("invalid number of deleted flows: actual=%v want=%v", dels,
+			len(flows))
+	}
+}
@@ -7,6 +7,18 @@ import (
 	"github.com/kandoo/beehive/Godeps/_workspace/src/github.com/golang/glog"
 )
 
+// graphHandler builds the graph of the network in the same cell as the paths,
+// so that the shortest paths can be calculated when paths are added.
+type graphHandler struct{}
+
+func (h graphHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
+	return discovery.GraphBuilderCentralized{}.Rcv(msg, ctx)
+}
+
+func (h graphHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
+	return centralizedMap
+}
+
 func inPortsFromOutPorts(outport []nom.UID, ctx bh.RcvContext) (
 	inports []nom.UID) {
 
@@ -0,0 +1,34 @@
+package routing
+
+import (
+	"github.com/kandoo/beehive-netctrl/net/arp"
+	"github.com/kandoo/beehive-netctrl/nom"
+)
+
+// arpRequest returns an ARP request of the gateway with address gw for ip.
+func arpRequest(mac nom.MACAddr, gw, ip nom.IPv4Addr) nom.Packet {
+	return arpPacket(arp.ARP_REQUEST, mac, gw, nom.BroadcastMAC, ip)
+}
+
+// arpReply returns the ARP reply of the gateway with address gw to the request
+// req.
+func arpReply(mac nom.MACAddr, gw nom.IPv4Addr, req nom.ARPHeader) nom.Packet {
+	return arpPacket(arp.ARP_REPLY, mac, gw, req.SHA(), req.SPA())
+}
+
+func arpPacket(op arp.ARPOp, sha nom.MACAddr, spa nom.IPv4Addr,
+	tha nom.MACAddr, tpa nom.IPv4Addr) nom.Packet {
+
+	p := arp.NewARP()
+	p.SetSrcMac(sha)
+	p.SetDstMac(tha)
+	p.SetEthernetIPv4()
+	p.SetOp(uint16(op))
+	p.SetSenderHwAddr(sha)
+	p.SetSenderProtoAddr(spa)
+	if op == arp.ARP_REPLY {
+		p.SetTargetHwAddr(tha)
+	}
+	p.SetTargetProtoAddr(tpa)
+	return nom.Packet(p.Buf)
+}
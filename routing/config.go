@@ -0,0 +1,196 @@
+package routing
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+
+	"github.com/kandoo/beehive-netctrl/nom"
+)
+
+// Config is the configuration of the router, which is usually loaded from a
+// JSON file using LoadConfig. For example:
+//
+//	{
+//		"MAC": "02:00:00:00:00:01",
+//		"Subnets": [
+//			{"Prefix": "10.0.1.0/24", "Gateway": "10.0.1.1"},
+//			{"Prefix": "10.0.2.0/24", "Gateway": "10.0.2.1"}
+//		],
+//		"Routes": [
+//			{"Prefix": "0.0.0.0/0", "NextHop": "10.0.1.254"}
+//		]
+//	}
+type Config struct {
+	MAC     string   // Hardware address of the gateways.
+	Subnets []Subnet // Subnets directly attached to the router.
+	Routes  []Route  // Static routes through the hosts of the subnets.
+}
+
+// Subnet is an IP version 4 subnet attached to the router.
+type Subnet struct {
+	Prefix  string // Prefix of the subnet in CIDR notation.
+	Gateway string // Address of the router in the subnet.
+}
+
+// Route is a static route.
+type Route struct {
+	Prefix  string // Destination prefix in CIDR notation.
+	NextHop string // Address of the next hop, that must be in a subnet.
+}
+
+// LoadConfig loads the router configuration from a JSON file.
+func LoadConfig(path string) (Config, error) {
+	var c Config
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return c, err
+	}
+	if err := json.Unmarshal(b, &c); err != nil {
+		return c, fmt.Errorf("routing: invalid config %v: %v", path, err)
+	}
+	return c, nil
+}
+
+type subnet struct {
+	Prefix  nom.MaskedIPv4Addr
+	Gateway nom.IPv4Addr
+}
+
+type route struct {
+	Prefix  nom.MaskedIPv4Addr
+	NextHop nom.IPv4Addr
+}
+
+// config is the parsed form of Config.
+type config struct {
+	mac     nom.MACAddr
+	subnets []subnet
+	routes  []route
+}
+
+func (c Config) parse() (config, error) {
+	var cfg config
+	mac, err := net.ParseMAC(c.MAC)
+	if err != nil || len(mac) != len(cfg.mac) {
+		return cfg, fmt.Errorf("routing: invalid MAC address %q", c.MAC)
+	}
+	copy(cfg.mac[:], mac)
+
+	for _, s := range c.Subnets {
+		p, err := parsePrefix(s.Prefix)
+		if err != nil {
+			return cfg, err
+		}
+		gw, err := parseIPv4(s.Gateway)
+		if err != nil {
+			return cfg, err
+		}
+		if !p.Match(gw) {
+			return cfg, fmt.Errorf("routing: gateway %v is not in %v", gw, p)
+		}
+		cfg.subnets = append(cfg.subnets, subnet{Prefix: p, Gateway: gw})
+	}
+
+	for _, r := range c.Routes {
+		p, err := parsePrefix(r.Prefix)
+		if err != nil {
+			return cfg, err
+		}
+		nh, err := parseIPv4(r.NextHop)
+		if err != nil {
+			return cfg, err
+		}
+		if _, ok := cfg.subnet(nh); !ok {
+			return cfg, fmt.Errorf("routing: next hop %v is not in any subnet", nh)
+		}
+		cfg.routes = append(cfg.routes, route{Prefix: p, NextHop: nh})
+	}
+	return cfg, nil
+}
+
+func parsePrefix(s string) (nom.MaskedIPv4Addr, error) {
+	var p nom.MaskedIPv4Addr
+	_, n, err := net.ParseCIDR(s)
+	if err != nil || n.IP.To4() == nil {
+		return p, fmt.Errorf("routing: invalid IPv4 prefix %q", s)
+	}
+	copy(p.Addr[:], n.IP.To4())
+	copy(p.Mask[:], n.Mask)
+	return p, nil
+}
+
+func parseIPv4(s string) (nom.IPv4Addr, error) {
+	var a nom.IPv4Addr
+	ip := net.ParseIP(s).To4()
+	if ip == nil {
+		return a, fmt.Errorf("routing: invalid IPv4 address %q", s)
+	}
+	copy(a[:], ip)
+	return a, nil
+}
+
+// subnet returns the subnet of ip.
+func (c config) subnet(ip nom.IPv4Addr) (subnet, bool) {
+	for _, s := range c.subnets {
+		if s.Prefix.Match(ip) {
+			return s, true
+		}
+	}
+	return subnet{}, false
+}
+
+// isGateway returns whether ip is the address of a gateway.
+func (c config) isGateway(ip nom.IPv4Addr) bool {
+	for _, s := range c.subnets {
+		if s.Gateway == ip {
+			return true
+		}
+	}
+	return false
+}
+
+// nextHop returns the next hop of the longest prefix route matching ip.
+func (c config) nextHop(ip nom.IPv4Addr) (nom.IPv4Addr, bool) {
+	var nh nom.IPv4Addr
+	found := false
+	var l uint32
+	for _, r := range c.routes {
+		if !r.Prefix.Match(ip) {
+			continue
+		}
+		if rl := r.Prefix.Mask.PopCount(); !found || rl > l {
+			nh, l, found = r.NextHop, rl, true
+		}
+	}
+	return nh, found
+}
+
+// prefixes returns the prefixes of the routes towards the host of ip, i.e., the
+// prefix of the host itself and the prefixes of the routes whose next hop is
+// the host.
+func (c config) prefixes(ip nom.IPv4Addr) []nom.MaskedIPv4Addr {
+	prefixes := []nom.MaskedIPv4Addr{{Addr: ip, Mask: nom.MaskNoneIPV4}}
+	for _, r := range c.routes {
+		if r.NextHop == ip {
+			prefixes = append(prefixes, r.Prefix)
+		}
+	}
+	return prefixes
+}
+
+// hostOfRoute returns the address of the host that the route with the given
+// path ID is forwarded to.
+func (c config) hostOfRoute(id string) (nom.IPv4Addr, bool) {
+	for _, r := range c.routes {
+		if r.Prefix.String() == id {
+			return r.NextHop, true
+		}
+	}
+	p, err := parsePrefix(id)
+	if err != nil || p.Mask != nom.MaskNoneIPV4 {
+		return nom.IPv4Addr{}, false
+	}
+	return p.Addr, true
+}
@@ -0,0 +1,382 @@
+// Package routing implements a router for the IP version 4 subnets of the
+// network. The router answers the ARP requests for the gateways of the subnets,
+// learns the hosts from their ARP packets, and installs the routes towards the
+// hosts as paths. Routes have a priority proportional to the length of their
+// prefix, which results in longest prefix matching in the nodes.
+//
+// The paths are installed by the path manager. The nodes on the way to a host
+// merely forward the packets, and the node of the host rewrites the Ethernet
+// addresses of the packets, so that packets leave the last hop with the
+// address of the router as the source and the address of the host as the
+// destination. When a host moves, its routes are deleted and installed
+// towards its new port, and when a route cannot be installed the host is
+// forgotten so that it is resolved again.
+//
+// To resolve the address of a host, the router sends an ARP request on the edge
+// ports of the network, i.e., the ports that have no link to another node.
+package routing
+
+import (
+	"fmt"
+	"sort"
+
+	bh "github.com/kandoo/beehive"
+	"github.com/kandoo/beehive-netctrl/net/arp"
+	"github.com/kandoo/beehive-netctrl/nom"
+	"github.com/kandoo/beehive/Godeps/_workspace/src/github.com/golang/glog"
+)
+
+const (
+	centralizedD = "D"
+	centralizedK = "0"
+
+	hostDict = "HostDict"
+	linkDict = "LinkDict"
+	nodeDict = "NodeDict"
+	portDict = "PortDict"
+
+	// basePriority is the priority of a route with an empty prefix.
+	basePriority = 100
+)
+
+var centralizedMap = bh.MappedCells{{Dict: centralizedD, Key: centralizedK}}
+
+// RegisterRouter registers the router on the hive using the given
+// configuration. The router installs its routes using AddPath, and requires the
+// path manager (see path.RegisterPath) to be registered on the same hive.
+func RegisterRouter(h bh.Hive, c Config) error {
+	cfg, err := c.parse()
+	if err != nil {
+		return err
+	}
+
+	app := h.NewApp("Router")
+	app.Handle(nom.PacketIn{}, pktInHandler{cfg: cfg})
+	app.Handle(nom.LinkAdded{}, linkHandler{})
+	app.Handle(nom.LinkDeleted{}, linkHandler{})
+	app.Handle(nom.NodeJoined{}, nodeHandler{})
+	app.Handle(nom.NodeLeft{}, nodeHandler{})
+	app.Handle(nom.PortUpdated{}, portHandler{})
+	app.Handle(nom.PortRemoved{}, portHandler{})
+	app.Handle(nom.PathAdded{}, pathHandler{cfg: cfg})
+	app.Handle(nom.PathDeleted{}, pathHandler{cfg: cfg})
+	return nil
+}
+
+// host is a host learned by the router.
+type host struct {
+	IP   nom.IPv4Addr
+	MAC  nom.MACAddr
+	Port nom.UID
+}
+
+func (h host) String() string {
+	return fmt.Sprintf("host %v (mac=%v, port=%v)", h.IP, h.MAC, h.Port)
+}
+
+type pktInHandler struct {
+	cfg config
+}
+
+func (h pktInHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
+	in := msg.Data().(nom.PacketIn)
+	if len(in.Packet) < 14 {
+		return nil
+	}
+	if req, ok := in.Packet.ARP(); ok {
+		return h.handleARP(in, req, ctx)
+	}
+	if ip, ok := in.Packet.IPv4(); ok && in.Packet.DstMAC() == h.cfg.mac {
+		h.resolve(ip.Dst(), ctx)
+	}
+	return nil
+}
+
+func (h pktInHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
+	return centralizedMap
+}
+
+func (h pktInHandler) handleARP(in nom.PacketIn, a nom.ARPHeader,
+	ctx bh.RcvContext) error {
+
+	if _, err := ctx.Dict(linkDict).Get(string(in.InPort)); err == nil {
+		// ARP packets received on inter-switch ports are flooded copies.
+		return nil
+	}
+
+	spa := a.SPA()
+	if _, ok := h.cfg.subnet(spa); ok && !h.cfg.isGateway(spa) {
+		learned := host{IP: spa, MAC: a.SHA(), Port: in.InPort}
+		if err := h.learn(learned, ctx); err != nil {
+			return err
+		}
+	}
+
+	tpa := a.TPA()
+	if a.Op() != uint16(arp.ARP_REQUEST) || !h.cfg.isGateway(tpa) {
+		return nil
+	}
+	ctx.Emit(nom.PacketOut{
+		Node:     in.Node,
+		BufferID: 0xFFFFFFFF,
+		Packet:   arpReply(h.cfg.mac, tpa, a),
+		Actions: []nom.Action{
+			nom.ActionForward{
+				Ports: []nom.UID{in.InPort},
+			},
+		},
+	})
+	return nil
+}
+
+// learn stores the host and installs the routes towards it, if the host is
+// new or has moved.
+func (h pktInHandler) learn(learned host, ctx bh.RcvContext) error {
+	d := ctx.Dict(hostDict)
+	k := learned.IP.String()
+	if v, err := d.Get(k); err == nil {
+		if v.(host) == learned {
+			return nil
+		}
+		glog.V(2).Infof("router withdraws the routes of %v", v)
+		for _, prefix := range h.cfg.prefixes(learned.IP) {
+			delRoute(prefix, ctx)
+		}
+	}
+	glog.V(2).Infof("router learned %v", learned)
+	if err := d.Put(k, learned); err != nil {
+		return err
+	}
+
+	for _, prefix := range h.cfg.prefixes(learned.IP) {
+		addRoute(prefix, learned, h.cfg.mac, ctx)
+	}
+	return nil
+}
+
+// resolve sends an ARP request for the host or the next hop of ip on the edge
+// ports of the network, if it is not learned yet.
+func (h pktInHandler) resolve(ip nom.IPv4Addr, ctx bh.RcvContext) {
+	if h.cfg.isGateway(ip) {
+		return
+	}
+	if _, ok := h.cfg.subnet(ip); !ok {
+		nh, ok := h.cfg.nextHop(ip)
+		if !ok {
+			glog.V(2).Infof("router has no route to %v", ip)
+			return
+		}
+		ip = nh
+	}
+	if _, err := ctx.Dict(hostDict).Get(ip.String()); err == nil {
+		// The route is being installed.
+		return
+	}
+
+	s, _ := h.cfg.subnet(ip)
+	edges := edgePorts(ctx)
+	nodes := make([]string, 0, len(edges))
+	for n := range edges {
+		nodes = append(nodes, string(n))
+	}
+	sort.Strings(nodes)
+	for _, n := range nodes {
+		ctx.Emit(nom.PacketOut{
+			Node:     nom.UID(n),
+			BufferID: 0xFFFFFFFF,
+			Packet:   arpRequest(h.cfg.mac, s.Gateway, ip),
+			Actions: []nom.Action{
+				nom.ActionForward{Ports: edges[nom.UID(n)]},
+			},
+		})
+	}
+}
+
+// edgePorts returns the ports of the nodes that have no link to another node,
+// keyed by their node.
+func edgePorts(ctx bh.RcvContext) map[nom.UID][]nom.UID {
+	links := ctx.Dict(linkDict)
+	nodes := ctx.Dict(nodeDict)
+	edges := make(map[nom.UID][]nom.UID)
+	ctx.Dict(portDict).ForEach(func(k string, v interface{}) bool {
+		if _, err := links.Get(k); err == nil {
+			return true
+		}
+		n := nom.NodeFromPortUID(nom.UID(k))
+		if _, err := nodes.Get(string(n)); err != nil {
+			return true
+		}
+		edges[n] = append(edges[n], nom.UID(k))
+		return true
+	})
+	for _, ports := range edges {
+		sort.Sort(portUIDs(ports))
+	}
+	return edges
+}
+
+// routerAppCellKey returns the cell of the router that subscribes to its
+// paths.
+func routerAppCellKey(ctx bh.RcvContext) bh.AppCellKey {
+	return bh.AppCellKey{
+		App:  ctx.App(),
+		Dict: centralizedD,
+		Key:  centralizedK,
+	}
+}
+
+// addRoute emits a path that forwards the packets destined to prefix towards
+// the host.
+func addRoute(prefix nom.MaskedIPv4Addr, to host, mac nom.MACAddr,
+	ctx bh.RcvContext) {
+
+	p := nom.Path{
+		ID:       prefix.String(),
+		Priority: basePriority + uint16(prefix.Mask.PopCount()),
+		Pathlets: []nom.Pathlet{
+			{
+				Match: nom.Match{
+					Fields: []nom.Field{
+						nom.EthType(nom.EthTypeIPv4),
+						nom.IPv4Dst(prefix),
+					},
+				},
+				Actions: []nom.Action{
+					nom.ActionWriteFields{
+						Fields: []nom.Field{
+							nom.EthSrc{Addr: mac, Mask: nom.MaskNoneMAC},
+							nom.EthDst{Addr: to.MAC, Mask: nom.MaskNoneMAC},
+						},
+					},
+					nom.ActionForward{
+						Ports: []nom.UID{to.Port},
+					},
+				},
+			},
+		},
+	}
+	ctx.Emit(nom.AddPath{
+		Subscriber: routerAppCellKey(ctx),
+		Path:       p,
+	})
+}
+
+// delRoute emits a DelPath for the route of prefix.
+func delRoute(prefix nom.MaskedIPv4Addr, ctx bh.RcvContext) {
+	ctx.Emit(nom.DelPath{
+		Subscriber: routerAppCellKey(ctx),
+		Path:       nom.Path{ID: prefix.String()},
+	})
+}
+
+type linkHandler struct{}
+
+func (h linkHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
+	d := ctx.Dict(linkDict)
+	switch l := msg.Data().(type) {
+	case nom.LinkAdded:
+		return d.Put(string(l.From), true)
+	case nom.LinkDeleted:
+		d.Del(string(l.From))
+	}
+	return nil
+}
+
+func (h linkHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
+	return centralizedMap
+}
+
+type nodeHandler struct{}
+
+func (h nodeHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
+	d := ctx.Dict(nodeDict)
+	switch n := msg.Data().(type) {
+	case nom.NodeJoined:
+		return d.Put(string(nom.Node(n).UID()), true)
+	case nom.NodeLeft:
+		id := nom.Node(n).UID()
+		d.Del(string(id))
+		var ports []string
+		ctx.Dict(portDict).ForEach(func(k string, v interface{}) bool {
+			if nom.NodeFromPortUID(nom.UID(k)) == id {
+				ports = append(ports, k)
+			}
+			return true
+		})
+		for _, k := range ports {
+			ctx.Dict(portDict).Del(k)
+		}
+		var left []string
+		ctx.Dict(hostDict).ForEach(func(k string, v interface{}) bool {
+			if nom.NodeFromPortUID(v.(host).Port) == id {
+				left = append(left, k)
+			}
+			return true
+		})
+		for _, k := range left {
+			ctx.Dict(hostDict).Del(k)
+		}
+	}
+	return nil
+}
+
+func (h nodeHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
+	return centralizedMap
+}
+
+type portUIDs []nom.UID
+
+func (p portUIDs) Len() int           { return len(p) }
+func (p portUIDs) Less(i, j int) bool { return p[i] < p[j] }
+func (p portUIDs) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+
+// portHandler keeps track of the ports that are up.
+type portHandler struct{}
+
+func (h portHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
+	d := ctx.Dict(portDict)
+	switch p := msg.Data().(type) {
+	case nom.PortUpdated:
+		port := nom.Port(p)
+		if port.State != nom.PortStateDown &&
+			port.Config&nom.PortConfigDown == 0 {
+
+			return d.Put(string(port.UID()), true)
+		}
+		d.Del(string(port.UID()))
+	case nom.PortRemoved:
+		d.Del(string(nom.Port(p).UID()))
+	}
+	return nil
+}
+
+func (h portHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
+	return centralizedMap
+}
+
+// pathHandler forgets the hosts whose routes are deleted by the path manager,
+// so that they are learned and resolved again.
+type pathHandler struct {
+	cfg config
+}
+
+func (h pathHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
+	switch p := msg.Data().(type) {
+	case nom.PathAdded:
+		glog.V(2).Infof("router installed %v", p.Path)
+	case nom.PathDeleted:
+		if p.Reason == nom.PathDelExplicit {
+			// The route is withdrawn by the router.
+			return nil
+		}
+		glog.Warningf("router cannot install %v (reason=%v)", p.Path, p.Reason)
+		if ip, ok := h.cfg.hostOfRoute(p.Path.ID); ok {
+			ctx.Dict(hostDict).Del(ip.String())
+		}
+	}
+	return nil
+}
+
+func (h pathHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
+	return centralizedMap
+}
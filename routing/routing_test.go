@@ -0,0 +1,280 @@
+package routing
+
+import (
+	"testing"
+
+	bh "github.com/kandoo/beehive"
+	"github.com/kandoo/beehive-netctrl/net/arp"
+	"github.com/kandoo/beehive-netctrl/nom"
+)
+
+var (
+	testRouterMAC = nom.MACAddr{0x02, 0, 0, 0, 0, 0x01}
+	testHostMAC   = nom.MACAddr{0, 0, 0, 0, 0, 0x01}
+	testHostIP    = nom.IPv4Addr{10, 0, 1, 2}
+	testGateway   = nom.IPv4Addr{10, 0, 1, 1}
+)
+
+func testConfig(t *testing.T) config {
+	c := Config{
+		MAC: "02:00:00:00:00:01",
+		Subnets: []Subnet{
+			{Prefix: "10.0.1.0/24", Gateway: "10.0.1.1"},
+			{Prefix: "10.0.2.0/24", Gateway: "10.0.2.1"},
+		},
+		Routes: []Route{
+			{Prefix: "0.0.0.0/0", NextHop: "10.0.1.254"},
+			{Prefix: "192.168.0.0/16", NextHop: "10.0.1.2"},
+		},
+	}
+	cfg, err := c.parse()
+	if err != nil {
+		t.Fatalf("cannot parse config: %v", err)
+	}
+	return cfg
+}
+
+func testARPRequest(sha nom.MACAddr, spa, tpa nom.IPv4Addr) bh.Msg {
+	return &bh.MockMsg{
+		MsgData: nom.PacketIn{
+			Node:   "n1",
+			InPort: "n1$$1",
+			Packet: arpPacket(arp.ARP_REQUEST, sha, spa, nom.BroadcastMAC, tpa),
+		},
+	}
+}
+
+func TestConfigParse(t *testing.T) {
+	cfg := testConfig(t)
+	if cfg.mac != testRouterMAC {
+		t.Errorf("invalid router MAC: actual=%v want=%v", cfg.mac, testRouterMAC)
+	}
+	if !cfg.isGateway(testGateway) || cfg.isGateway(testHostIP) {
+		t.Errorf("invalid gateways: %v", cfg.subnets)
+	}
+	nh, ok := cfg.nextHop(nom.IPv4Addr{192, 168, 1, 1})
+	if !ok || nh != testHostIP {
+		t.Errorf("invalid next hop: actual=%v want=%v", nh, testHostIP)
+	}
+	nh, ok = cfg.nextHop(nom.IPv4Addr{8, 8, 8, 8})
+	if want := (nom.IPv4Addr{10, 0, 1, 254}); !ok || nh != want {
+		t.Errorf("invalid next hop: actual=%v want=%v", nh, want)
+	}
+
+	invalid := []Config{
+		{MAC: "02:00:00:00:00"},
+		{
+			MAC:     "02:00:00:00:00:01",
+			Subnets: []Subnet{{Prefix: "10.0.1.0/24", Gateway: "10.0.2.1"}},
+		},
+		{
+			MAC:    "02:00:00:00:00:01",
+			Routes: []Route{{Prefix: "0.0.0.0/0", NextHop: "10.0.1.254"}},
+		},
+	}
+	for _, c := range invalid {
+		if _, err := c.parse(); err == nil {
+			t.Errorf("invalid config is parsed: %+v", c)
+		}
+	}
+}
+
+func TestGatewayARPReply(t *testing.T) {
+	ctx := &bh.MockRcvContext{}
+	h := pktInHandler{cfg: testConfig(t)}
+	msg := testARPRequest(testHostMAC, testHostIP, testGateway)
+	if err := h.Rcv(msg, ctx); err != nil {
+		t.Fatalf("error in packet in handler: %v", err)
+	}
+
+	var out nom.PacketOut
+	found := false
+	for _, m := range ctx.CtxMsgs {
+		if out, found = m.Data().(nom.PacketOut); found {
+			break
+		}
+	}
+	if !found {
+		t.Fatalf("no ARP reply for the gateway")
+	}
+	rep, ok := out.Packet.ARP()
+	if !ok || rep.Op() != uint16(arp.ARP_REPLY) || rep.SHA() != testRouterMAC ||
+		rep.SPA() != testGateway || rep.TPA() != testHostIP ||
+		out.Packet.DstMAC() != testHostMAC {
+
+		t.Errorf("invalid ARP reply: %v", out.Packet)
+	}
+	fwd, ok := out.Actions[0].(nom.ActionForward)
+	if !ok || len(fwd.Ports) != 1 || fwd.Ports[0] != "n1$$1" {
+		t.Errorf("invalid ARP reply actions: %v", out.Actions)
+	}
+}
+
+func TestLearnHostRoutes(t *testing.T) {
+	ctx := &bh.MockRcvContext{}
+	h := pktInHandler{cfg: testConfig(t)}
+	msg := testARPRequest(testHostMAC, testHostIP, nom.IPv4Addr{10, 0, 1, 3})
+	h.Rcv(msg, ctx)
+
+	var paths []nom.Path
+	for _, m := range ctx.CtxMsgs {
+		if add, ok := m.Data().(nom.AddPath); ok {
+			paths = append(paths, add.Path)
+		}
+	}
+	if len(paths) != 2 {
+		t.Fatalf("invalid number of routes: actual=%d want=2", len(paths))
+	}
+	if paths[0].Priority != basePriority+32 ||
+		paths[1].Priority != basePriority+16 {
+
+		t.Errorf("invalid route priorities: %v and %v", paths[0].Priority,
+			paths[1].Priority)
+	}
+	acts := paths[0].Pathlets[0].Actions
+	wf, ok := acts[0].(nom.ActionWriteFields)
+	if !ok || !wf.Fields[1].Equals(nom.EthDst{
+		Addr: testHostMAC,
+		Mask: nom.MaskNoneMAC,
+	}) {
+
+		t.Errorf("invalid rewrite: %v", acts[0])
+	}
+	if fwd, ok := acts[1].(nom.ActionForward); !ok || fwd.Ports[0] != "n1$$1" {
+		t.Errorf("invalid forward: %v", acts[1])
+	}
+
+	ctx.CtxMsgs = nil
+	h.Rcv(msg, ctx)
+	if len(ctx.CtxMsgs) != 0 {
+		t.Errorf("routes are installed again: %v", ctx.CtxMsgs)
+	}
+}
+
+func TestResolveUnknownHost(t *testing.T) {
+	ctx := &bh.MockRcvContext{}
+	h := pktInHandler{cfg: testConfig(t)}
+	for _, n := range []nom.NodeID{"n1", "n2"} {
+		nodeHandler{}.Rcv(&bh.MockMsg{MsgData: nom.NodeJoined{ID: n}}, ctx)
+	}
+	ports := []nom.Port{
+		{ID: "1", Node: "n1"},
+		{ID: "2", Node: "n1"},
+		{ID: "1", Node: "n2"},
+		{ID: "2", Node: "n2", State: nom.PortStateDown},
+	}
+	for _, p := range ports {
+		portHandler{}.Rcv(&bh.MockMsg{MsgData: nom.PortUpdated(p)}, ctx)
+	}
+	link := nom.LinkAdded{From: "n1$$2", To: "n2$$1"}
+	linkHandler{}.Rcv(&bh.MockMsg{MsgData: link}, ctx)
+	link = nom.LinkAdded{From: "n2$$1", To: "n1$$2"}
+	linkHandler{}.Rcv(&bh.MockMsg{MsgData: link}, ctx)
+
+	ip := []byte{
+		0x45, 0, 0, 20, 0, 0, 0, 0, 64, 17, 0, 0, // header
+		10, 0, 1, 2, // src
+		10, 0, 2, 5, // dst
+	}
+	pkt := append([]byte{}, testRouterMAC[:]...)
+	pkt = append(pkt, testHostMAC[:]...)
+	pkt = append(pkt, 0x08, 0x00)
+	pkt = append(pkt, ip...)
+	h.Rcv(&bh.MockMsg{
+		MsgData: nom.PacketIn{Node: "n1", InPort: "n1$$1", Packet: pkt},
+	}, ctx)
+
+	if len(ctx.CtxMsgs) != 1 {
+		t.Fatalf("invalid number of messages: actual=%d want=1",
+			len(ctx.CtxMsgs))
+	}
+	out := ctx.CtxMsgs[0].Data().(nom.PacketOut)
+	req, ok := out.Packet.ARP()
+	if !ok || req.Op() != uint16(arp.ARP_REQUEST) ||
+		req.SPA() != (nom.IPv4Addr{10, 0, 2, 1}) ||
+		req.TPA() != (nom.IPv4Addr{10, 0, 2, 5}) {
+
+		t.Errorf("invalid ARP request: %v", out.Packet)
+	}
+	fwd, ok := out.Actions[0].(nom.ActionForward)
+	if out.Node != "n1" || !ok || len(fwd.Ports) != 1 ||
+		fwd.Ports[0] != "n1$$1" {
+
+		t.Errorf("ARP request is not sent on the edge ports: node=%v actions=%v",
+			out.Node, out.Actions)
+	}
+}
+
+func TestHostMove(t *testing.T) {
+	ctx := &bh.MockRcvContext{}
+	h := pktInHandler{cfg: testConfig(t)}
+	h.Rcv(testARPRequest(testHostMAC, testHostIP, testGateway), ctx)
+
+	ctx.CtxMsgs = nil
+	msg := testARPRequest(testHostMAC, testHostIP, testGateway).(*bh.MockMsg)
+	in := msg.MsgData.(nom.PacketIn)
+	in.InPort = "n1$$3"
+	msg.MsgData = in
+	h.Rcv(msg, ctx)
+
+	var dels, adds []string
+	for _, m := range ctx.CtxMsgs {
+		switch data := m.Data().(type) {
+		case nom.DelPath:
+			if len(adds) != 0 {
+				t.Errorf("route is withdrawn after the new route: %v", data.Path.ID)
+			}
+			dels = append(dels, data.Path.ID)
+		case nom.AddPath:
+			fwd := data.Path.Pathlets[0].Actions[1].(nom.ActionForward)
+			if fwd.Ports[0] != "n1$$3" {
+				t.Errorf("route is not towards the new port: %v", fwd)
+			}
+			adds = append(adds, data.Path.ID)
+		}
+	}
+	want := []string{"10.0.1.2/32", "192.168.0.0/16"}
+	if len(dels) != len(want) || len(adds) != len(want) {
+		t.Fatalf("invalid routes: deleted=%v added=%v want=%v", dels, adds, want)
+	}
+	for i := range want {
+		if dels[i] != want[i] || adds[i] != want[i] {
+			t.Errorf("invalid route #%d: deleted=%v added=%v want=%v", i, dels[i],
+				adds[i], want[i])
+		}
+	}
+}
+
+func TestForgetHostOfDeletedRoute(t *testing.T) {
+	ctx := &bh.MockRcvContext{}
+	cfg := testConfig(t)
+	h := pktInHandler{cfg: cfg}
+	h.Rcv(testARPRequest(testHostMAC, testHostIP, testGateway), ctx)
+
+	del := nom.PathDeleted{
+		Path:   nom.Path{ID: "192.168.0.0/16"},
+		Reason: nom.PathDelExplicit,
+	}
+	pathHandler{cfg: cfg}.Rcv(&bh.MockMsg{MsgData: del}, ctx)
+	if _, err := ctx.Dict(hostDict).Get(testHostIP.String()); err != nil {
+		t.Errorf("host is removed for a withdrawn route")
+	}
+
+	del.Reason = nom.PathDelInfeasible
+	pathHandler{cfg: cfg}.Rcv(&bh.MockMsg{MsgData: del}, ctx)
+	if _, err := ctx.Dict(hostDict).Get(testHostIP.String()); err == nil {
+		t.Errorf("host is not removed when its route is deleted")
+	}
+
+	ctx.CtxMsgs = nil
+	h.Rcv(testARPRequest(testHostMAC, testHostIP, testGateway), ctx)
+	adds := 0
+	for _, m := range ctx.CtxMsgs {
+		if _, ok := m.Data().(nom.AddPath); ok {
+			adds++
+		}
+	}
+	if adds != 2 {
+		t.Errorf("routes are not installed again: actual=%d want=2", adds)
+	}
+}
@@ -0,0 +1,139 @@
+// Package spanningtree prevents flooding loops by computing a spanning tree of
+// the network topology and disabling flooding on the inter-switch ports that
+// are not in the tree.
+//
+// OpenFlow 1.2 and 1.3 have no port configuration for flooding. Their drivers
+// keep the configuration and expand flooding into outputs on the ports that
+// are not blocked. The controller installs the flooding flows of a node again
+// whenever its ports are added, removed, or blocked, so that the installed
+// flows follow the spanning tree.
+package spanningtree
+
+import (
+	"sort"
+
+	bh "github.com/kandoo/beehive"
+	"github.com/kandoo/beehive-netctrl/discovery"
+	"github.com/kandoo/beehive-netctrl/nom"
+	"github.com/kandoo/beehive/Godeps/_workspace/src/github.com/golang/glog"
+)
+
+const (
+	centralizedD = "D"
+	centralizedK = "0"
+
+	blockedDict = "BlockedDict"
+)
+
+var centralizedMap = bh.MappedCells{{Dict: centralizedD, Key: centralizedK}}
+
+// RegisterSpanningTree registers the spanning tree application on the hive.
+func RegisterSpanningTree(h bh.Hive) {
+	app := h.NewApp("SpanningTree")
+	app.Handle(nom.LinkAdded{}, linkHandler{})
+	app.Handle(nom.LinkDeleted{}, linkHandler{})
+}
+
+type linkHandler struct{}
+
+func (h linkHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
+	if err := (discovery.GraphBuilderCentralized{}).Rcv(msg, ctx); err != nil {
+		return err
+	}
+	updateBlockedPorts(ctx)
+	return nil
+}
+
+func (h linkHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
+	return centralizedMap
+}
+
+// updateBlockedPorts recomputes the spanning tree and emits ModifyPort for the
+// ports that should be blocked or unblocked.
+func updateBlockedPorts(ctx bh.RcvContext) {
+	blocked := nonTreePorts(ctx)
+	d := ctx.Dict(blockedDict)
+	var unblocked []nom.UID
+	d.ForEach(func(k string, v interface{}) bool {
+		if !blocked[nom.UID(k)] {
+			unblocked = append(unblocked, nom.UID(k))
+		}
+		return true
+	})
+	for _, p := range unblocked {
+		glog.V(2).Infof("enabling flood on %v", p)
+		d.Del(string(p))
+		ctx.Emit(nom.ModifyPort{Port: p, Mask: nom.PortConfigNoFlood})
+	}
+
+	for p := range blocked {
+		if _, err := d.Get(string(p)); err == nil {
+			continue
+		}
+		glog.V(2).Infof("disabling flood on %v", p)
+		d.Put(string(p), true)
+		ctx.Emit(nom.ModifyPort{
+			Port:   p,
+			Config: nom.PortConfigNoFlood,
+			Mask:   nom.PortConfigNoFlood,
+		})
+	}
+}
+
+// nonTreePorts returns the inter-switch ports that are not on the spanning
+// tree of the network. The tree is built in breadth-first order from the node
+// with the smallest ID, so that all controllers compute the same tree.
+func nonTreePorts(ctx bh.RcvContext) map[nom.UID]bool {
+	adj := make(map[nom.UID][]nom.Link)
+	ports := make(map[nom.UID]bool)
+	for _, n := range discovery.NodesCentralized(ctx) {
+		links := discovery.LinksCentralized(n, ctx)
+		sort.Sort(linksByPorts(links))
+		adj[n] = links
+		for _, l := range links {
+			ports[l.From] = true
+			ports[l.To] = true
+		}
+	}
+
+	nodes := make([]string, 0, len(adj))
+	for n := range adj {
+		nodes = append(nodes, string(n))
+	}
+	sort.Strings(nodes)
+
+	visited := make(map[nom.UID]bool)
+	for _, root := range nodes {
+		if visited[nom.UID(root)] {
+			continue
+		}
+		visited[nom.UID(root)] = true
+		queue := []nom.UID{nom.UID(root)}
+		for len(queue) != 0 {
+			n := queue[0]
+			queue = queue[1:]
+			for _, l := range adj[n] {
+				to := nom.NodeFromPortUID(l.To)
+				if visited[to] {
+					continue
+				}
+				visited[to] = true
+				queue = append(queue, to)
+				delete(ports, l.From)
+				delete(ports, l.To)
+			}
+		}
+	}
+	return ports
+}
+
+type linksByPorts []nom.Link
+
+func (s linksByPorts) Len() int      { return len(s) }
+func (s linksByPorts) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s linksByPorts) Less(i, j int) bool {
+	if s[i].From != s[j].From {
+		return s[i].From < s[j].From
+	}
+	return s[i].To < s[j].To
+}
@@ -0,0 +1,68 @@
+package spanningtree
+
+import (
+	"testing"
+
+	bh "github.com/kandoo/beehive"
+	"github.com/kandoo/beehive-netctrl/nom"
+)
+
+func triangleLinks() []nom.Link {
+	return []nom.Link{
+		{From: "n1$$1", To: "n2$$1"},
+		{From: "n2$$1", To: "n1$$1"},
+		{From: "n2$$2", To: "n3$$1"},
+		{From: "n3$$1", To: "n2$$2"},
+		{From: "n3$$2", To: "n1$$2"},
+		{From: "n1$$2", To: "n3$$2"},
+	}
+}
+
+func portMods(ctx *bh.MockRcvContext) map[nom.UID]nom.ModifyPort {
+	mods := make(map[nom.UID]nom.ModifyPort)
+	for _, msg := range ctx.CtxMsgs {
+		if m, ok := msg.Data().(nom.ModifyPort); ok {
+			mods[m.Port] = m
+		}
+	}
+	return mods
+}
+
+func TestSpanningTreeBlocksLoop(t *testing.T) {
+	ctx := &bh.MockRcvContext{}
+	h := linkHandler{}
+	for _, l := range triangleLinks() {
+		if err := h.Rcv(&bh.MockMsg{MsgData: nom.LinkAdded(l)}, ctx); err != nil {
+			t.Fatalf("error in link handler: %v", err)
+		}
+	}
+
+	// Ports may be blocked and unblocked while the links are added, but only
+	// the link between n2 and n3 must be blocked at the end.
+	mods := portMods(ctx)
+	for _, p := range []nom.UID{"n2$$2", "n3$$1"} {
+		m, ok := mods[p]
+		if !ok || m.Config&nom.PortConfigNoFlood == 0 ||
+			m.Mask != nom.PortConfigNoFlood {
+
+			t.Errorf("port %v is not blocked: %v", p, mods)
+		}
+	}
+	for _, p := range []nom.UID{"n1$$1", "n1$$2", "n2$$1", "n3$$2"} {
+		if m, ok := mods[p]; ok && m.Config&nom.PortConfigNoFlood != 0 {
+			t.Errorf("port %v is blocked: %v", p, mods)
+		}
+	}
+
+	ctx.CtxMsgs = nil
+	for _, l := range triangleLinks()[:2] {
+		h.Rcv(&bh.MockMsg{MsgData: nom.LinkDeleted(l)}, ctx)
+	}
+	mods = portMods(ctx)
+	for _, p := range []nom.UID{"n2$$2", "n3$$1"} {
+		m, ok := mods[p]
+		if !ok || m.Config&nom.PortConfigNoFlood != 0 {
+			t.Errorf("port %v is not unblocked: %v", p, mods)
+		}
+	}
+}
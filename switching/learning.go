@@ -1,13 +1,59 @@
 package switching
 
 import (
+	"fmt"
+	"time"
+
 	bh "github.com/kandoo/beehive"
 	"github.com/kandoo/beehive-netctrl/nom"
 	"github.com/kandoo/beehive/Godeps/_workspace/src/github.com/golang/glog"
 )
 
+const (
+	macDict = "mac2port"
+
+	// DefaultAgingTime is the default duration after which an idle MAC entry is
+	// expired.
+	DefaultAgingTime = 5 * time.Minute
+)
+
+// macEntry is a MAC address learned on a port of a node.
+type macEntry struct {
+	Node     nom.UID
+	VLAN     nom.VLANID // nom.VLANNone for the untagged packets.
+	MAC      nom.MACAddr
+	Port     nom.UID
+	LastSeen time.Time
+}
+
+// key returns the key of the entry in the MAC table.
+func (e macEntry) key() string {
+	return macKey(e.Node, e.VLAN, e.MAC)
+}
+
+// match returns the match of the flow entries installed for the entry.
+func (e macEntry) match() nom.Match {
+	m := nom.Match{
+		Fields: []nom.Field{
+			nom.EthDst{
+				Addr: e.MAC,
+				Mask: nom.MaskNoneMAC,
+			},
+			e.VLAN,
+		},
+	}
+	return m
+}
+
+func macKey(node nom.UID, vlan nom.VLANID, mac nom.MACAddr) string {
+	return fmt.Sprintf("%v$$%d$$%v", node, vlan, mac)
+}
+
 type LearningSwitch struct {
 	Hub
+	// AgingTime is the duration after which an idle MAC entry is expired. The
+	// flows installed for an entry use AgingTime as their idle timeout.
+	AgingTime time.Duration
 }
 
 func (h LearningSwitch) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
@@ -21,53 +67,61 @@ func (h LearningSwitch) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
 		return nil
 	}
 
-	if dst.IsBroadcast() || dst.IsMulticast() {
-		return h.Hub.Rcv(msg, ctx)
+	vlan, _, tagged := in.Packet.VLAN()
+	if !tagged {
+		vlan = nom.VLANNone
 	}
-
-	d := ctx.Dict("mac2port")
-	srck := src.Key()
-	update := false
-	if v, err := d.Get(srck); err == nil {
-		p := v.(nom.UID)
-		if p != in.InPort {
-			update = true
+	now := time.Now()
+	d := ctx.Dict(macDict)
+	srce := macEntry{
+		Node:     in.Node,
+		VLAN:     vlan,
+		MAC:      src,
+		Port:     in.InPort,
+		LastSeen: now,
+	}
+	if v, err := d.Get(srce.key()); err == nil {
+		if olde := v.(macEntry); olde.Port != in.InPort {
 			// TODO(soheil): maybe add support for multi ports.
-			glog.Infof("%v is moved from port %v to port %v", src, p, in.InPort)
+			glog.Infof("%v is moved from port %v to port %v", src, olde.Port,
+				in.InPort)
+			ctx.Emit(nom.DelFlowEntry{
+				Node:  olde.Node,
+				Match: olde.match(),
+				Exact: true,
+			})
 		}
-	} else {
-		update = true
+	}
+	if err := d.Put(srce.key(), srce); err != nil {
+		glog.Fatalf("cannot serialize mac entry: %v", err)
 	}
 
-	if update {
-		if err := d.Put(srck, in.InPort); err != nil {
-			glog.Fatalf("cannot serialize port: %v", err)
-		}
+	if dst.IsBroadcast() || dst.IsMulticast() {
+		return h.Hub.Rcv(msg, ctx)
 	}
 
-	dstk := dst.Key()
+	dstk := macKey(in.Node, vlan, dst)
 	v, err := d.Get(dstk)
 	if err != nil {
 		return h.Hub.Rcv(msg, ctx)
 	}
-	p := v.(nom.UID)
+	dste := v.(macEntry)
+	if h.expired(dste, now) {
+		d.Del(dstk)
+		return h.Hub.Rcv(msg, ctx)
+	}
+	p := dste.Port
 
 	add := nom.AddFlowEntry{
 		Flow: nom.FlowEntry{
-			Node: in.Node,
-			Match: nom.Match{
-				Fields: []nom.Field{
-					nom.EthDst{
-						Addr: dst,
-						Mask: nom.MaskNoneMAC,
-					},
-				},
-			},
+			Node:  in.Node,
+			Match: dste.match(),
 			Actions: []nom.Action{
 				nom.ActionForward{
 					Ports: []nom.UID{p},
 				},
 			},
+			IdleTimeout: h.agingTime(),
 		},
 	}
 	ctx.Reply(msg, add)
@@ -87,9 +141,118 @@ func (h LearningSwitch) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
 	return nil
 }
 
+func (h LearningSwitch) agingTime() time.Duration {
+	if h.AgingTime <= 0 {
+		return DefaultAgingTime
+	}
+	return h.AgingTime
+}
+
+func (h LearningSwitch) expired(e macEntry, now time.Time) bool {
+	return e.LastSeen.Add(h.agingTime()).Before(now)
+}
+
+// flushEntries removes the MAC entries for which flush returns true and, if
+// delFlows is true, deletes the flows installed for them.
+func flushEntries(flush func(e macEntry) bool, delFlows bool,
+	ctx bh.RcvContext) {
+
+	d := ctx.Dict(macDict)
+	var flushed []macEntry
+	d.ForEach(func(k string, v interface{}) bool {
+		if e := v.(macEntry); flush(e) {
+			flushed = append(flushed, e)
+		}
+		return true
+	})
+	for _, e := range flushed {
+		d.Del(e.key())
+		if !delFlows {
+			continue
+		}
+		ctx.Emit(nom.DelFlowEntry{
+			Node:  e.Node,
+			Match: e.match(),
+			Exact: true,
+		})
+	}
+}
+
+type nodeLeftHandler struct{}
+
+func (h nodeLeftHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
+	n := nom.Node(msg.Data().(nom.NodeLeft)).UID()
+	// The flows are removed along with the node.
+	flushEntries(func(e macEntry) bool {
+		return e.Node == n
+	}, false, ctx)
+	return nil
+}
+
+func (h nodeLeftHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
+	n := nom.Node(msg.Data().(nom.NodeLeft)).UID()
+	return bh.MappedCells{{"N", string(n)}}
+}
+
+type portUpdateHandler struct{}
+
+func (h portUpdateHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
+	p := nom.Port(msg.Data().(nom.PortUpdated))
+	if p.State != nom.PortStateDown && p.Config&nom.PortConfigDown == 0 {
+		return nil
+	}
+	id := p.UID()
+	flushEntries(func(e macEntry) bool {
+		return e.Port == id
+	}, true, ctx)
+	return nil
+}
+
+func (h portUpdateHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
+	return bh.MappedCells{{"N", string(msg.Data().(nom.PortUpdated).Node)}}
+}
+
+// ageEntries is emitted periodically to expire the idle MAC entries.
+type ageEntries struct{}
+
+type agingHandler struct {
+	LearningSwitch
+}
+
+func (h agingHandler) Rcv(msg bh.Msg, ctx bh.RcvContext) error {
+	now := time.Now()
+	flushEntries(func(e macEntry) bool {
+		return h.expired(e, now)
+	}, false, ctx)
+	return nil
+}
+
+func (h agingHandler) Map(msg bh.Msg, ctx bh.MapContext) bh.MappedCells {
+	return bh.MappedCells{}
+}
+
 // RegisterSwitch registers the learning switch application on the given
 // hive with the provided options.
 func RegisterSwitch(h bh.Hive, opts ...bh.AppOption) {
+	RegisterSwitchWithAging(h, DefaultAgingTime, opts...)
+}
+
+// RegisterSwitchWithAging registers the learning switch application on the
+// given hive, which expires the MAC entries that are idle for aging. If aging
+// is not positive, DefaultAgingTime is used.
+func RegisterSwitchWithAging(h bh.Hive, aging time.Duration,
+	opts ...bh.AppOption) {
+
+	if aging <= 0 {
+		aging = DefaultAgingTime
+	}
+	s := LearningSwitch{AgingTime: aging}
 	app := h.NewApp("Switch", opts...)
-	app.Handle(nom.PacketIn{}, LearningSwitch{})
+	app.Handle(nom.PacketIn{}, s)
+	app.Handle(nom.NodeLeft{}, nodeLeftHandler{})
+	app.Handle(nom.PortUpdated{}, portUpdateHandler{})
+	app.Handle(ageEntries{}, agingHandler{s})
+	app.Detached(bh.NewTimer(aging, func() {
+		h.Emit(ageEntries{})
+	}))
 }
@@ -0,0 +1,126 @@
+package switching
+
+import (
+	"testing"
+
+	bh "github.com/kandoo/beehive"
+	"github.com/kandoo/beehive-netctrl/nom"
+)
+
+var (
+	testMAC1 = nom.MACAddr{0, 0, 0, 0, 0, 1}
+	testMAC2 = nom.MACAddr{0, 0, 0, 0, 0, 2}
+)
+
+func testPacketIn(src, dst nom.MACAddr, port nom.UID) bh.Msg {
+	pkt := append([]byte{}, dst[:]...)
+	pkt = append(pkt, src[:]...)
+	pkt = append(pkt, 0x08, 0x00)
+	return &bh.MockMsg{
+		MsgFrom: 1,
+		MsgData: nom.PacketIn{
+			Node:     "n1",
+			InPort:   port,
+			BufferID: 0xFFFFFFFF,
+			Packet:   pkt,
+		},
+	}
+}
+
+func TestLearningSwitchForward(t *testing.T) {
+	ctx := &bh.MockRcvContext{}
+	h := LearningSwitch{}
+	if err := h.Rcv(testPacketIn(testMAC1, testMAC2, "n1$$1"), ctx); err != nil {
+		t.Fatalf("error in learning switch: %v", err)
+	}
+	if len(ctx.CtxMsgs) != 1 {
+		t.Fatalf("invalid number of messages: actual=%d want=1",
+			len(ctx.CtxMsgs))
+	}
+	out := ctx.CtxMsgs[0].Data().(nom.PacketOut)
+	if _, ok := out.Actions[0].(nom.ActionFlood); !ok {
+		t.Errorf("packet to an unknown host is not flooded: %v", out.Actions)
+	}
+
+	ctx.CtxMsgs = nil
+	if err := h.Rcv(testPacketIn(testMAC2, testMAC1, "n1$$2"), ctx); err != nil {
+		t.Fatalf("error in learning switch: %v", err)
+	}
+	if len(ctx.CtxMsgs) != 2 {
+		t.Fatalf("invalid number of messages: actual=%d want=2",
+			len(ctx.CtxMsgs))
+	}
+	add := ctx.CtxMsgs[0].Data().(nom.AddFlowEntry)
+	want := nom.Match{
+		Fields: []nom.Field{
+			nom.EthDst{Addr: testMAC1, Mask: nom.MaskNoneMAC},
+			nom.VLANNone,
+		},
+	}
+	if !add.Flow.Match.Equals(want) {
+		t.Errorf("invalid match: actual=%v want=%v", add.Flow.Match, want)
+	}
+	fwd := nom.ActionForward{Ports: []nom.UID{"n1$$1"}}
+	if !add.Flow.Actions[0].Equals(fwd) {
+		t.Errorf("invalid flow actions: actual=%v want=%v", add.Flow.Actions, fwd)
+	}
+	if add.Flow.IdleTimeout != DefaultAgingTime {
+		t.Errorf("invalid idle timeout: actual=%v want=%v", add.Flow.IdleTimeout,
+			DefaultAgingTime)
+	}
+	out = ctx.CtxMsgs[1].Data().(nom.PacketOut)
+	if !out.Actions[0].Equals(fwd) {
+		t.Errorf("invalid packet out actions: actual=%v want=%v", out.Actions,
+			fwd)
+	}
+}
+
+func TestLearningSwitchMove(t *testing.T) {
+	ctx := &bh.MockRcvContext{}
+	h := LearningSwitch{}
+	h.Rcv(testPacketIn(testMAC1, nom.BroadcastMAC, "n1$$1"), ctx)
+
+	ctx.CtxMsgs = nil
+	h.Rcv(testPacketIn(testMAC1, nom.BroadcastMAC, "n1$$3"), ctx)
+	var dels []nom.DelFlowEntry
+	for _, m := range ctx.CtxMsgs {
+		if del, ok := m.Data().(nom.DelFlowEntry); ok {
+			dels = append(dels, del)
+		}
+	}
+	want := macEntry{Node: "n1", VLAN: nom.VLANNone, MAC: testMAC1}.match()
+	if len(dels) != 1 || !dels[0].Exact || !dels[0].Match.Equals(want) {
+		t.Fatalf("the flow of the moved host is not deleted: %v", dels)
+	}
+
+	ctx.CtxMsgs = nil
+	h.Rcv(testPacketIn(testMAC2, testMAC1, "n1$$2"), ctx)
+	add := ctx.CtxMsgs[0].Data().(nom.AddFlowEntry)
+	fwd := nom.ActionForward{Ports: []nom.UID{"n1$$3"}}
+	if !add.Flow.Actions[0].Equals(fwd) {
+		t.Errorf("invalid flow actions: actual=%v want=%v", add.Flow.Actions, fwd)
+	}
+}
+
+func TestLearningSwitchPortDown(t *testing.T) {
+	ctx := &bh.MockRcvContext{}
+	LearningSwitch{}.Rcv(testPacketIn(testMAC1, testMAC2, "n1$$1"), ctx)
+
+	ctx.CtxMsgs = nil
+	down := nom.Port{ID: "1", Node: "n1", State: nom.PortStateDown}
+	msg := &bh.MockMsg{MsgData: nom.PortUpdated(down)}
+	if err := (portUpdateHandler{}).Rcv(msg, ctx); err != nil {
+		t.Fatalf("error in port update handler: %v", err)
+	}
+	if len(ctx.CtxMsgs) != 1 {
+		t.Fatalf("invalid number of messages: actual=%d want=1",
+			len(ctx.CtxMsgs))
+	}
+	if _, ok := ctx.CtxMsgs[0].Data().(nom.DelFlowEntry); !ok {
+		t.Errorf("invalid message: %v", ctx.CtxMsgs[0].Data())
+	}
+	k := macKey("n1", nom.VLANNone, testMAC1)
+	if _, err := ctx.Dict(macDict).Get(k); err == nil {
+		t.Errorf("the entry of %v is not flushed", testMAC1)
+	}
+}